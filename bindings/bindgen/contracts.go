@@ -0,0 +1,61 @@
+package main
+
+// bindingContracts are the contracts to generate the Go bindings for, by the fully qualified
+// names of the hardhat artifacts.
+var bindingContracts = []string{
+	"contracts/L1/Colosseum.sol:Colosseum",
+	"@openzeppelin/contracts/token/ERC20/ERC20.sol:ERC20",
+	"contracts/L2/GasPriceOracle.sol:GasPriceOracle",
+	"contracts/universal/KromaMintableERC20.sol:KromaMintableERC20",
+	"contracts/universal/KromaMintableERC20Factory.sol:KromaMintableERC20Factory",
+	"contracts/universal/KromaMintableERC721Factory.sol:KromaMintableERC721Factory",
+	"contracts/L1/KromaPortal.sol:KromaPortal",
+	"contracts/L2/L1Block.sol:L1Block",
+	"contracts/L1/L1CrossDomainMessenger.sol:L1CrossDomainMessenger",
+	"contracts/L1/L1ERC721Bridge.sol:L1ERC721Bridge",
+	"contracts/L1/L1StandardBridge.sol:L1StandardBridge",
+	"contracts/L2/L2CrossDomainMessenger.sol:L2CrossDomainMessenger",
+	"contracts/L2/L2ERC721Bridge.sol:L2ERC721Bridge",
+	"contracts/L1/L2OutputOracle.sol:L2OutputOracle",
+	"contracts/L2/L2StandardBridge.sol:L2StandardBridge",
+	"contracts/L2/L2ToL1MessagePasser.sol:L2ToL1MessagePasser",
+	"contracts/L2/ProposerRewardVault.sol:ProposerRewardVault",
+	"contracts/L2/ProtocolVault.sol:ProtocolVault",
+	"contracts/universal/Proxy.sol:Proxy",
+	"contracts/universal/ProxyAdmin.sol:ProxyAdmin",
+	"contracts/L1/SecurityCouncil.sol:SecurityCouncil",
+	"contracts/governance/SecurityCouncilToken.sol:SecurityCouncilToken",
+	"contracts/L1/SystemConfig.sol:SystemConfig",
+	"contracts/governance/TimeLock.sol:TimeLock",
+	"contracts/governance/UpgradeGovernor.sol:UpgradeGovernor",
+	"contracts/L1/ValidatorPool.sol:ValidatorPool",
+	"contracts/L2/ValidatorRewardVault.sol:ValidatorRewardVault",
+	"contracts/vendor/WETH9.sol:WETH9",
+	"contracts/L1/ZKMerkleTrie.sol:ZKMerkleTrie",
+	"contracts/L1/ZKVerifier.sol:ZKVerifier",
+}
+
+// moreContracts are the contracts to generate the deployed bytecode and the storage layout for,
+// to set them in the genesis state.
+var moreContracts = []string{
+	"Colosseum",
+	"KromaMintableERC20Factory",
+	"KromaMintableERC721Factory",
+	"KromaPortal",
+	"L1Block",
+	"L1CrossDomainMessenger",
+	"L2CrossDomainMessenger",
+	"L2ERC721Bridge",
+	"L2StandardBridge",
+	"L2ToL1MessagePasser",
+	"Proxy",
+	"ProxyAdmin",
+	"SecurityCouncil",
+	"SecurityCouncilToken",
+	"SystemConfig",
+	"TimeLock",
+	"UpgradeGovernor",
+	"ValidatorPool",
+	"ValidatorRewardVault",
+	"WETH9",
+}
@@ -0,0 +1,212 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"go/format"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+
+	"github.com/kroma-network/kroma/bindings/ast"
+	"github.com/kroma-network/kroma/bindings/hardhat"
+)
+
+// typesFile is the file that the Types structures of all bindings are moved to,
+// to prevent the duplication of the Types structures used by several contracts.
+const typesFile = "types.go"
+
+var typesRegex = regexp.MustCompile(`(?s)(?:\/\/[^\n]*\n|\/\*.*?\*\/)*\s*type\s+Types\w+\s+struct\s*\{.*?\}`)
+
+var typesNameRegex = regexp.MustCompile(`Types\w+`)
+
+// rawArtifact is the part of a hardhat artifact that the bindings are generated from,
+// with the ABI kept as is.
+type rawArtifact struct {
+	Abi      json.RawMessage `json:"abi"`
+	Bytecode string          `json:"bytecode"`
+}
+
+// generate generates the bindings of the contracts from the artifacts, and returns
+// the contents of the generated files by the file names.
+func generate(artifactsDir string, pkg string) (map[string][]byte, error) {
+	hh, err := hardhat.New("dummy", []string{artifactsDir}, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error reading artifacts: %w", err)
+	}
+
+	files := make(map[string][]byte)
+	types := make(map[string]string)
+	for _, fqn := range bindingContracts {
+		name, source, err := generateBinding(hh, artifactsDir, fqn, pkg)
+		if err != nil {
+			return nil, err
+		}
+		source, blocks := extractTypes(source)
+		for _, block := range blocks {
+			types[typesNameRegex.FindString(block)] = block
+		}
+		formatted, err := format.Source([]byte(source))
+		if err != nil {
+			return nil, fmt.Errorf("error formatting binding %s: %w", name, err)
+		}
+		files[strings.ToLower(name)+".go"] = formatted
+	}
+
+	typesSource, err := typesBinding(types, pkg)
+	if err != nil {
+		return nil, err
+	}
+	files[typesFile] = typesSource
+
+	t := template.Must(template.New("artifact").Parse(moreTmpl))
+	for _, name := range moreContracts {
+		more, err := generateMore(hh, t, name, pkg)
+		if err != nil {
+			return nil, err
+		}
+		files[strings.ToLower(name)+"_more.go"] = more
+	}
+	return files, nil
+}
+
+// generateBinding generates the binding of the contract with the ABI and the bytecode of its artifact.
+func generateBinding(hh *hardhat.Hardhat, artifactsDir string, fqn string, pkg string) (string, string, error) {
+	art, err := hh.GetArtifact(fqn)
+	if err != nil {
+		return "", "", fmt.Errorf("error reading artifact %s: %w", fqn, err)
+	}
+	file, err := os.ReadFile(filepath.Join(artifactsDir, art.SourceName, art.ContractName+".json"))
+	if err != nil {
+		return "", "", fmt.Errorf("error reading artifact %s: %w", fqn, err)
+	}
+	var raw rawArtifact
+	if err := json.Unmarshal(file, &raw); err != nil {
+		return "", "", fmt.Errorf("error parsing artifact %s: %w", fqn, err)
+	}
+
+	source, err := bind.Bind(
+		[]string{art.ContractName},
+		[]string{string(raw.Abi)},
+		[]string{raw.Bytecode},
+		nil, pkg, bind.LangGo, nil, nil,
+	)
+	if err != nil {
+		return "", "", fmt.Errorf("error generating binding %s: %w", fqn, err)
+	}
+	return art.ContractName, source, nil
+}
+
+// extractTypes removes the Types structures from the binding, and returns them.
+func extractTypes(source string) (string, []string) {
+	blocks := typesRegex.FindAllString(source, -1)
+	for _, block := range blocks {
+		source = strings.Replace(source, block+"\n\n", "", -1)
+	}
+	return source, blocks
+}
+
+// typesBinding generates the binding of the Types structures, in the order of the names.
+func typesBinding(types map[string]string, pkg string) ([]byte, error) {
+	names := make([]string, 0, len(types))
+	for name := range types {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var b strings.Builder
+	b.WriteString(strings.Replace(typesTmpl, "{{.Package}}", pkg, 1))
+	for _, name := range names {
+		b.WriteString(types[name])
+		b.WriteString("\n\n")
+	}
+	out, err := format.Source([]byte(b.String()))
+	if err != nil {
+		return nil, fmt.Errorf("error formatting types binding: %w", err)
+	}
+	return out, nil
+}
+
+// generateMore generates the deployed bytecode and the storage layout of the contract.
+func generateMore(hh *hardhat.Hardhat, t *template.Template, name string, pkg string) ([]byte, error) {
+	art, err := hh.GetArtifact(name)
+	if err != nil {
+		return nil, fmt.Errorf("error reading artifact %s: %w", name, err)
+	}
+
+	storage, err := hh.GetStorageLayout(name)
+	if err != nil {
+		return nil, fmt.Errorf("error reading storage layout %s: %w", name, err)
+	}
+	canonicalStorage := ast.CanonicalizeASTIDs(storage)
+
+	ser, err := json.Marshal(canonicalStorage)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling storage: %w", err)
+	}
+	serStr := strings.Replace(string(ser), "\"", "\\\"", -1)
+
+	var b strings.Builder
+	err = t.Execute(&b, moreData{
+		Name:          name,
+		StorageLayout: serStr,
+		DeployedBin:   art.DeployedBytecode.String(),
+		Package:       pkg,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error writing template %s: %w", name, err)
+	}
+	return []byte(b.String()), nil
+}
+
+type moreData struct {
+	Name          string
+	StorageLayout string
+	DeployedBin   string
+	Package       string
+}
+
+var typesTmpl = `// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package {{.Package}}
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+`
+
+var moreTmpl = `// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package {{.Package}}
+
+import (
+	"encoding/json"
+
+	"github.com/kroma-network/kroma/bindings/solc"
+)
+
+const {{.Name}}StorageLayoutJSON = "{{.StorageLayout}}"
+
+var {{.Name}}StorageLayout = new(solc.StorageLayout)
+
+var {{.Name}}DeployedBin = "{{.DeployedBin}}"
+
+func init() {
+	if err := json.Unmarshal([]byte({{.Name}}StorageLayoutJSON), {{.Name}}StorageLayout); err != nil {
+		panic(err)
+	}
+
+	layouts["{{.Name}}"] = {{.Name}}StorageLayout
+	deployedBytecodes["{{.Name}}"] = {{.Name}}DeployedBin
+}
+`
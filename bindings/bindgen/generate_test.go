@@ -0,0 +1,51 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const bindingSource = `package bindings
+
+// FooMetaData contains all meta data concerning the Foo contract.
+var FooMetaData = "foo"
+
+// TypesOutputProposal is an auto generated low-level Go binding around an user-defined struct.
+type TypesOutputProposal struct {
+	OutputRoot [32]byte
+	Timestamp  *big.Int
+}
+
+// Foo is an auto generated Go binding around an Ethereum contract.
+type Foo struct{}
+`
+
+func TestExtractTypes(t *testing.T) {
+	source, blocks := extractTypes(bindingSource)
+	require.Len(t, blocks, 1)
+	require.Contains(t, blocks[0], "type TypesOutputProposal struct")
+	require.NotContains(t, source, "TypesOutputProposal")
+	require.Contains(t, source, "type Foo struct{}")
+
+	types, err := typesBinding(map[string]string{"TypesOutputProposal": blocks[0]}, "bindings")
+	require.NoError(t, err)
+	require.Contains(t, string(types), "package bindings")
+	require.Contains(t, string(types), "type TypesOutputProposal struct")
+}
+
+func TestDrift(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "foo.go"), []byte("foo"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "bar.go"), []byte("old"), 0o600))
+
+	drifted, err := drift(dir, map[string][]byte{
+		"foo.go": []byte("foo"),
+		"bar.go": []byte("new"),
+		"baz.go": []byte("baz"),
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"bar.go", "baz.go"}, drifted)
+}
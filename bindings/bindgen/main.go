@@ -0,0 +1,112 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+)
+
+// bindgen compiles the contracts, and generates the Go bindings, the deployed bytecodes and the storage layouts
+// of the contracts from the compiled artifacts. With -check, the generated files are compared with the files
+// in the output directory instead of written, and bindgen fails if they drift from the contract sources.
+
+type flags struct {
+	ContractsDir string
+	OutDir       string
+	Package      string
+	SkipCompile  bool
+	Check        bool
+}
+
+func main() {
+	var f flags
+	flag.StringVar(&f.ContractsDir, "contracts-dir", "../packages/contracts", "Directory of the contracts package")
+	flag.StringVar(&f.OutDir, "out", "./bindings", "Output directory to put code in")
+	flag.StringVar(&f.Package, "package", "bindings", "Go package name")
+	flag.BoolVar(&f.SkipCompile, "skip-compile", false, "Generate the bindings from the existing artifacts without compiling the contracts")
+	flag.BoolVar(&f.Check, "check", false, "Fail if the bindings in the output directory differ from the generated bindings, without writing them")
+	flag.Parse()
+
+	if !f.SkipCompile {
+		if err := compile(f.ContractsDir); err != nil {
+			log.Fatalln("error compiling contracts:", err)
+		}
+	}
+
+	files, err := generate(filepath.Join(f.ContractsDir, "artifacts"), f.Package)
+	if err != nil {
+		log.Fatalln(err)
+	}
+
+	if f.Check {
+		drifted, err := drift(f.OutDir, files)
+		if err != nil {
+			log.Fatalln("error checking bindings:", err)
+		}
+		if len(drifted) > 0 {
+			for _, name := range drifted {
+				log.Printf("%s is out of date\n", filepath.Join(f.OutDir, name))
+			}
+			log.Fatalf("%d bindings drifted from the contract sources, regenerate them with `make -C bindings`", len(drifted))
+		}
+		log.Println("bindings are up to date")
+		return
+	}
+
+	if err := os.MkdirAll(f.OutDir, 0o755); err != nil {
+		log.Fatalln(err)
+	}
+	for _, name := range sortedNames(files) {
+		path := filepath.Join(f.OutDir, name)
+		if err := os.WriteFile(path, files[name], 0o600); err != nil {
+			log.Fatalf("error writing %s: %v\n", path, err)
+		}
+		log.Printf("wrote file %s\n", path)
+	}
+}
+
+// compile compiles the contracts with hardhat, which writes the artifacts and the build info.
+func compile(contractsDir string) error {
+	for _, args := range [][]string{{"yarn", "clean"}, {"npx", "hardhat", "compile"}} {
+		cmd := exec.Command(args[0], args[1:]...)
+		cmd.Dir = contractsDir
+		cmd.Stdout = os.Stdout
+		cmd.Stderr = os.Stderr
+		if err := cmd.Run(); err != nil {
+			return fmt.Errorf("%v: %w", args, err)
+		}
+	}
+	return nil
+}
+
+// drift returns the names of the generated files that are missing in the output directory or differ from it.
+func drift(outDir string, files map[string][]byte) ([]string, error) {
+	var drifted []string
+	for _, name := range sortedNames(files) {
+		committed, err := os.ReadFile(filepath.Join(outDir, name))
+		if os.IsNotExist(err) {
+			drifted = append(drifted, name)
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+		if !bytes.Equal(committed, files[name]) {
+			drifted = append(drifted, name)
+		}
+	}
+	return drifted, nil
+}
+
+func sortedNames(files map[string][]byte) []string {
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
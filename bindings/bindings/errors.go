@@ -0,0 +1,157 @@
+package bindings
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// errorMetaDatas are the bound contracts that the custom errors are decoded with.
+// A contract added to the bindings should be added here as well.
+var errorMetaDatas = []*bind.MetaData{
+	ColosseumMetaData,
+	ERC20MetaData,
+	GasPriceOracleMetaData,
+	KromaGovernorMetaData,
+	KromaGuardianTokenMetaData,
+	KromaMintableERC20MetaData,
+	KromaMintableERC20FactoryMetaData,
+	KromaMintableERC721FactoryMetaData,
+	KromaPortalMetaData,
+	KromaTimeLockMetaData,
+	L1BlockMetaData,
+	L1CrossDomainMessengerMetaData,
+	L1ERC721BridgeMetaData,
+	L1StandardBridgeMetaData,
+	L2CrossDomainMessengerMetaData,
+	L2ERC721BridgeMetaData,
+	L2OutputOracleMetaData,
+	L2StandardBridgeMetaData,
+	L2ToL1MessagePasserMetaData,
+	ProposerRewardVaultMetaData,
+	ProtocolVaultMetaData,
+	ProxyMetaData,
+	ProxyAdminMetaData,
+	SecurityCouncilMetaData,
+	SecurityCouncilTokenMetaData,
+	SystemConfigMetaData,
+	TimeLockMetaData,
+	UpgradeGovernorMetaData,
+	ValidatorPoolMetaData,
+	ValidatorRewardVaultMetaData,
+	WETH9MetaData,
+	ZKMerkleTrieMetaData,
+	ZKVerifierMetaData,
+}
+
+var (
+	// errorSelector is the selector of the Error(string) of require and revert with a reason
+	errorSelector = []byte{0x08, 0xc3, 0x79, 0xa0}
+	// panicSelector is the selector of the Panic(uint256) of failed assertions and arithmetic errors
+	panicSelector = []byte{0x4e, 0x48, 0x7b, 0x71}
+)
+
+var (
+	customErrorsOnce sync.Once
+	customErrors     map[[4]byte]abi.Error
+)
+
+// ErrUnknownRevert is returned when the revert data does not match any known error.
+var ErrUnknownRevert = errors.New("unknown revert data")
+
+// loadCustomErrors collects the custom errors of the bound contracts by their selectors.
+func loadCustomErrors() {
+	customErrors = make(map[[4]byte]abi.Error)
+	for _, metaData := range errorMetaDatas {
+		parsed, err := metaData.GetAbi()
+		if err != nil {
+			panic(err)
+		}
+		for _, e := range parsed.Errors {
+			var selector [4]byte
+			copy(selector[:], e.ID[:4])
+			customErrors[selector] = e
+		}
+	}
+}
+
+// DecodeRevert decodes the revert data of a call into a readable form, like
+// `ValidatorNotInTree(0x...)` for the custom errors of the bound contracts,
+// `Error("reason")` for a revert reason and `Panic(0x11)` for a panic.
+func DecodeRevert(data []byte) (string, error) {
+	if len(data) < 4 {
+		return "", ErrUnknownRevert
+	}
+
+	switch {
+	case bytes.Equal(data[:4], errorSelector):
+		reason, err := abi.UnpackRevert(data)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("Error(%q)", reason), nil
+	case bytes.Equal(data[:4], panicSelector):
+		if len(data) != 36 {
+			return "", fmt.Errorf("invalid panic data: %x", data)
+		}
+		return fmt.Sprintf("Panic(0x%x)", new(big.Int).SetBytes(data[4:])), nil
+	}
+
+	customErrorsOnce.Do(loadCustomErrors)
+	var selector [4]byte
+	copy(selector[:], data[:4])
+	e, ok := customErrors[selector]
+	if !ok {
+		return "", fmt.Errorf("%w: %x", ErrUnknownRevert, data)
+	}
+	values, err := e.Inputs.Unpack(data[4:])
+	if err != nil {
+		return "", fmt.Errorf("failed to unpack %s: %w", e.Name, err)
+	}
+	args := make([]string, len(values))
+	for i, value := range values {
+		args[i] = fmt.Sprintf("%v", value)
+	}
+	return fmt.Sprintf("%s(%s)", e.Name, strings.Join(args, ", ")), nil
+}
+
+// RevertData returns the revert data attached to the error of a call or a gas
+// estimation by the RPC, if any.
+func RevertData(err error) ([]byte, bool) {
+	var dataErr rpc.DataError
+	if !errors.As(err, &dataErr) {
+		return nil, false
+	}
+	hexData, ok := dataErr.ErrorData().(string)
+	if !ok {
+		return nil, false
+	}
+	data, err := hexutil.Decode(hexData)
+	if err != nil || len(data) == 0 {
+		return nil, false
+	}
+	return data, true
+}
+
+// WrapRevertError appends the decoded revert of the error to its message, so
+// that the logs show the error of the contract instead of raw revert data. The
+// error is returned as is if it has no revert data that can be decoded.
+func WrapRevertError(err error) error {
+	data, ok := RevertData(err)
+	if !ok {
+		return err
+	}
+	decoded, decodeErr := DecodeRevert(data)
+	if decodeErr != nil {
+		return err
+	}
+	return fmt.Errorf("%w: %s", err, decoded)
+}
@@ -0,0 +1,68 @@
+package bindings
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+type testDataError struct {
+	data string
+}
+
+func (e *testDataError) Error() string          { return "execution reverted" }
+func (e *testDataError) ErrorData() interface{} { return e.data }
+
+func TestDecodeRevert(t *testing.T) {
+	tests := []struct {
+		name     string
+		data     string
+		expected string
+	}{
+		{
+			name:     "reason",
+			data:     "0x08c379a00000000000000000000000000000000000000000000000000000000000000020000000000000000000000000000000000000000000000000000000000000000b6e6f742061206f776e6572000000000000000000000000000000000000000000",
+			expected: `Error("not a owner")`,
+		},
+		{
+			name:     "panic",
+			data:     "0x4e487b710000000000000000000000000000000000000000000000000000000000000011",
+			expected: "Panic(0x11)",
+		},
+		{
+			name:     "custom error",
+			data:     hexutil.Encode(crypto.Keccak256([]byte("ErrLocked()"))[:4]),
+			expected: "ErrLocked()",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			decoded, err := DecodeRevert(hexutil.MustDecode(test.data))
+			require.NoError(t, err)
+			require.Equal(t, test.expected, decoded)
+		})
+	}
+
+	_, err := DecodeRevert([]byte{0xde, 0xad, 0xbe, 0xef})
+	require.ErrorIs(t, err, ErrUnknownRevert)
+}
+
+func TestWrapRevertError(t *testing.T) {
+	custom := hexutil.Encode(crypto.Keccak256([]byte("ErrLocked()"))[:4])
+	err := fmt.Errorf("failed to estimate gas: %w", &testDataError{data: custom})
+
+	wrapped := WrapRevertError(err)
+	require.ErrorIs(t, wrapped, err)
+	require.Equal(t, "failed to estimate gas: execution reverted: ErrLocked()", wrapped.Error())
+
+	// errors without decodable revert data are returned as is
+	unknown := &testDataError{data: "0xdeadbeef"}
+	require.Equal(t, error(unknown), WrapRevertError(unknown))
+	plain := errors.New("plain")
+	require.Equal(t, plain, WrapRevertError(plain))
+}
@@ -4,7 +4,7 @@ The contracts package provides Go bindings for our contracts.
 
 
 To regenerate the bindings, run `make`
-The following programs are required: `jq`, `abigen`, and `solc` (at version 0.8.10)
+To check that the bindings are up to date with the contracts, run `make check`
 */
 
 package bindings
@@ -149,6 +149,7 @@ func (b *BatchSubmitter) recordConfirmedTx(id txID, receipt *types.Receipt) {
 
 // l1Tip gets the current L1 tip as a L1BlockRef. The passed context is assumed
 // to be a lifetime context, so it is internally wrapped with a network timeout.
+// The basefee of the tip is recorded for the auto-tuning of the channel size.
 func (b *BatchSubmitter) l1Tip(ctx context.Context) (eth.L1BlockRef, error) {
 	tctx, cancel := context.WithTimeout(ctx, b.NetworkTimeout)
 	defer cancel()
@@ -156,5 +157,9 @@ func (b *BatchSubmitter) l1Tip(ctx context.Context) (eth.L1BlockRef, error) {
 	if err != nil {
 		return eth.L1BlockRef{}, fmt.Errorf("getting latest L1 block: %w", err)
 	}
-	return eth.InfoToL1BlockRef(eth.HeaderBlockInfo(head)), nil
+	l1tip := eth.InfoToL1BlockRef(eth.HeaderBlockInfo(head))
+	if head.BaseFee != nil {
+		b.state.ObserveL1BaseFee(l1tip.ID(), head.BaseFee)
+	}
+	return l1tip, nil
 }
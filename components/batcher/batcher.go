@@ -5,15 +5,21 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"os"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/log"
+	gethrpc "github.com/ethereum/go-ethereum/rpc"
 	"github.com/urfave/cli"
 
 	"github.com/kroma-network/kroma/components/batcher/metrics"
+	"github.com/kroma-network/kroma/components/batcher/rpc"
+	"github.com/kroma-network/kroma/components/node/eth"
+	"github.com/kroma-network/kroma/components/node/rollup/derive"
 	"github.com/kroma-network/kroma/utils"
 	"github.com/kroma-network/kroma/utils/monitoring"
 	klog "github.com/kroma-network/kroma/utils/service/log"
@@ -43,7 +49,21 @@ func Main(version string, cliCtx *cli.Context) error {
 
 	monitoring.MaybeStartPprof(ctx, cliCfg.PprofConfig, l)
 	monitoring.MaybeStartMetrics(ctx, cliCfg.MetricsConfig, l, m, batcherCfg.L1Client, batcherCfg.TxManager.From())
-	server, err := monitoring.StartRPC(cliCfg.RPCConfig.ToServiceCLIConfig(), version, krpc.WithLogger(l))
+
+	batcher, err := NewBatcher(ctx, *batcherCfg, l, m)
+	if err != nil {
+		l.Error("Unable to create batcher", "err", err)
+		return err
+	}
+
+	rpcOpts := []krpc.ServerOption{krpc.WithLogger(l)}
+	if cliCfg.RPCConfig.EnableAdmin {
+		rpcOpts = append(rpcOpts, krpc.WithAPIs([]gethrpc.API{{
+			Namespace: "admin",
+			Service:   rpc.NewAdminAPI(batcher),
+		}}))
+	}
+	server, err := monitoring.StartRPC(cliCfg.RPCConfig.ToServiceCLIConfig(), version, rpcOpts...)
 	if err != nil {
 		return err
 	}
@@ -56,18 +76,16 @@ func Main(version string, cliCtx *cli.Context) error {
 	m.RecordInfo(version)
 	m.RecordUp()
 
-	batcher, err := NewBatcher(ctx, *batcherCfg, l, m)
-	if err != nil {
-		l.Error("Unable to create batcher", "err", err)
-		return err
-	}
-
 	if err := batcher.Start(); err != nil {
 		l.Error("Unable to start batcher", "err", err)
 		return err
 	}
 	<-utils.WaitInterrupt()
-	batcher.Stop(context.Background())
+
+	// The remaining L2 blocks are submitted on shutdown, until the shutdown timeout.
+	stopCtx, stopCancel := context.WithTimeout(context.Background(), cliCfg.ShutdownTimeout)
+	defer stopCancel()
+	batcher.Stop(stopCtx)
 
 	return nil
 }
@@ -78,11 +96,20 @@ type Batcher struct {
 	killCtx           context.Context
 	cancelKillCtx     context.CancelFunc
 	running           bool
+	// paused is set while the submission of batcher transactions is paused through the admin API
+	paused atomic.Bool
+	// deferredSince is the time since when the submission is deferred because of L1 congestion, zero if not deferred
+	deferredSince time.Time
 
 	cfg            Config
 	l              log.Logger
+	metr           metrics.Metricer
 	batchSubmitter *BatchSubmitter
 
+	// senders are the tx managers of the sender accounts, transactions are assigned to them in round-robin
+	senders    []txmgr.TxManager
+	nextSender int
+
 	wg sync.WaitGroup
 }
 
@@ -103,11 +130,24 @@ func NewBatcher(parentCtx context.Context, cfg Config, l log.Logger, m metrics.M
 	}
 
 	l.Info("creating batcher", "batcher_addr", cfg.TxManager.From(), "batcher_bal", balance)
+	if cfg.DryRun {
+		l.Warn("Running in dry-run mode, batcher transactions are not submitted")
+	}
+
+	for _, extra := range cfg.ExtraTxManagers {
+		balance, err := cfg.L1Client.BalanceAt(parentCtx, extra.From(), nil)
+		if err != nil {
+			return nil, err
+		}
+		l.Info("using extra batcher sender account", "sender_addr", extra.From(), "sender_bal", balance)
+	}
 
 	return &Batcher{
 		cfg:            cfg,
 		l:              l,
+		metr:           m,
 		batchSubmitter: batchSubmitter,
+		senders:        append([]txmgr.TxManager{cfg.TxManager}, cfg.ExtraTxManagers...),
 	}, nil
 }
 
@@ -119,6 +159,11 @@ func (b *Batcher) Start() error {
 	}
 	b.running = true
 
+	if err := b.restoreState(); err != nil {
+		b.running = false
+		return err
+	}
+
 	b.shutdownCtx, b.cancelShutdownCtx = context.WithCancel(context.Background())
 	b.killCtx, b.cancelKillCtx = context.WithCancel(context.Background())
 
@@ -156,6 +201,83 @@ func (b *Batcher) Stop(ctx context.Context) error {
 	return nil
 }
 
+// Pause pauses the submission of batcher transactions. L2 blocks are still loaded into the state.
+func (b *Batcher) Pause() error {
+	if !b.paused.CompareAndSwap(false, true) {
+		return errors.New("batcher is already paused")
+	}
+	b.l.Info("Batcher paused")
+	return nil
+}
+
+// Resume resumes the submission of batcher transactions after a Pause.
+func (b *Batcher) Resume() error {
+	if !b.paused.CompareAndSwap(true, false) {
+		return errors.New("batcher is not paused")
+	}
+	b.l.Info("Batcher resumed")
+	return nil
+}
+
+// Flush closes the current channel with all loaded L2 blocks, so that its frames are submitted right away.
+func (b *Batcher) Flush(ctx context.Context) error {
+	l1tip, err := b.batchSubmitter.l1Tip(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to query L1 tip: %w", err)
+	}
+	return b.batchSubmitter.state.Flush(l1tip.ID())
+}
+
+// Status returns the batch submission progress of the batcher.
+func (b *Batcher) Status() *rpc.BatcherStatus {
+	status := b.batchSubmitter.state.Status()
+	status.Paused = b.paused.Load()
+	return status
+}
+
+// restoreState restores the channel state persisted by a previous run, if any.
+// The state file is removed afterwards, so that the state cannot be restored twice.
+func (b *Batcher) restoreState() error {
+	if b.cfg.ChannelStateFile == "" {
+		return nil
+	}
+	state, err := loadChannelManagerState(b.cfg.ChannelStateFile)
+	if err != nil {
+		return err
+	} else if state == nil {
+		return nil
+	}
+	last, err := b.batchSubmitter.state.Restore(state)
+	if err != nil {
+		return fmt.Errorf("failed to restore channel manager state: %w", err)
+	}
+	if last != (eth.BlockID{}) {
+		b.batchSubmitter.lastStoredBlock = last
+	}
+	return os.Remove(b.cfg.ChannelStateFile)
+}
+
+// saveState closes the channel manager and persists its state, if a channel state file is configured.
+func (b *Batcher) saveState() {
+	if b.cfg.ChannelStateFile == "" {
+		return
+	}
+	if err := b.batchSubmitter.state.Close(); err != nil {
+		b.l.Error("failed to close the channel manager", "err", err)
+		return
+	}
+	state, err := b.batchSubmitter.state.State()
+	if err != nil {
+		b.l.Error("failed to get channel manager state", "err", err)
+		return
+	}
+	if err := saveChannelManagerState(b.cfg.ChannelStateFile, state); err != nil {
+		b.l.Error("failed to save channel manager state", "err", err)
+		return
+	}
+	b.l.Info("Saved channel manager state", "file", b.cfg.ChannelStateFile)
+}
+
 // The following things occur:
 // New L2 block (reorg or not)
 // L1 transaction is confirmed
@@ -181,41 +303,77 @@ func (b *Batcher) loop() {
 				if err != nil {
 					b.l.Error("failed to close the channel manager to handle a L2 reorg", "err", err)
 				}
-				if err := b.submitBatch(b.killCtx); err != nil {
+				if b.paused.Load() {
+					b.l.Warn("Batcher is paused, discarding the channel frames to handle a L2 reorg")
+				} else if err := b.submitBatch(b.killCtx); err != nil {
 					b.l.Error("failed to submit batch channel frame to handle a L2 reorg", "err", err)
 				}
 				b.batchSubmitter.state.Clear()
 				continue
 			}
+			if b.paused.Load() {
+				continue
+			}
 			if err := b.submitBatch(b.killCtx); err != nil {
 				b.l.Error("failed to submit batch channel frame", "err", err)
 			}
 		case <-b.shutdownCtx.Done():
-			if err := b.submitBatch(b.killCtx); err != nil {
-				b.l.Error("failed to submit batch channel frame", "err", err)
+			if b.paused.Load() {
+				b.l.Warn("Batcher is paused, not submitting the remaining channel frames on shutdown")
+			} else {
+				b.flushOnShutdown()
 			}
+			b.saveState()
 			return
 		}
 	}
 }
 
+// flushOnShutdown submits all the loaded L2 blocks before shutting down. The latest L2 blocks are loaded,
+// and the pending channel is flushed and its frames are submitted and confirmed, until no blocks are left.
+// It stops early once the kill context is done, after the shutdown timeout, and the remaining state
+// is persisted then, if a channel state file is configured.
+func (b *Batcher) flushOnShutdown() {
+	if err := b.batchSubmitter.loadBlocksIntoState(b.killCtx); err != nil {
+		b.l.Warn("failed to load the latest L2 blocks on shutdown", "err", err)
+	}
+	var last *eth.BlockID
+	for b.killCtx.Err() == nil {
+		oldest := b.batchSubmitter.state.Status().OldestUnsubmittedBlock
+		if oldest == nil {
+			b.l.Info("Submitted all L2 blocks on shutdown")
+			return
+		}
+		if last != nil && *last == *oldest {
+			b.l.Error("no progress flushing the L2 blocks on shutdown", "oldest_unsubmitted", oldest)
+			return
+		}
+		last = oldest
+		if err := b.Flush(b.killCtx); err != nil {
+			b.l.Error("failed to flush the channel on shutdown", "err", err)
+			return
+		}
+		if err := b.submitBatch(b.killCtx); err != nil {
+			b.l.Error("failed to submit batch channel frame on shutdown", "err", err)
+			return
+		}
+	}
+	b.l.Warn("Shutdown timeout reached before all L2 blocks were submitted")
+}
+
 // submitBatch loops through the block data loaded into `state` and
 // submits the associated data to the L1 in the form of channel frames.
 func (b *Batcher) submitBatch(ctx context.Context) error {
 	for {
 		// Attempt to gracefully terminate the current channel, ensuring that no new frames will be
 		// produced. Any remaining frames must still be published to the L1 to prevent stalling.
+		// On shutdown, the remaining blocks are flushed by flushOnShutdown instead.
 		select {
 		case <-ctx.Done():
 			err := b.batchSubmitter.state.Close()
 			if err != nil {
 				b.l.Error("failed to close the channel manager", "err", err)
 			}
-		case <-b.shutdownCtx.Done():
-			err := b.batchSubmitter.state.Close()
-			if err != nil {
-				b.l.Error("failed to close the channel manager", "err", err)
-			}
 		default:
 		}
 
@@ -226,32 +384,113 @@ func (b *Batcher) submitBatch(ctx context.Context) error {
 		}
 		b.batchSubmitter.recordL1Tip(l1tip)
 
-		// Collect next transaction data
-		txdata, err := b.batchSubmitter.state.TxData(l1tip.ID())
-		if err == io.EOF {
+		// Defer the submission during L1 congestion, the frames are submitted at a later poll.
+		// The submission is not deferred any further once the proposer window is about to expire,
+		// nor on shutdown, which is bounded by the shutdown timeout instead.
+		if err := b.checkThrottle(ctx, l1tip); err != nil && b.shutdownCtx.Err() == nil {
+			windowLeft, ok := b.proposerWindowLeft(l1tip)
+			if !ok || windowLeft > b.cfg.Channel.SubSafetyMargin {
+				b.deferSubmission(err, windowLeft)
+				break
+			}
+			b.l.Warn("Submitting despite L1 congestion, proposer window is about to expire", "reason", err, "window_left", windowLeft)
+		}
+		b.resumeSubmission()
+
+		// Collect next transaction data, up to one for each sender account
+		txdatas, err := b.nextTxDatas(l1tip.ID())
+		if err != nil {
+			b.l.Error("unable to get tx data", "err", err)
+			break
+		} else if len(txdatas) == 0 {
 			b.l.Trace("no transaction data available")
 			break
+		}
+
+		if b.cfg.DryRun {
+			if err := b.dryRunTransactions(ctx, l1tip, txdatas); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if err := b.sendTransactions(ctx, txdatas); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// nextTxDatas collects the next transaction data, up to one for each sender account.
+// It only returns an error if no transaction data could be collected.
+func (b *Batcher) nextTxDatas(l1tip eth.BlockID) ([]txData, error) {
+	var txdatas []txData
+	for len(txdatas) < len(b.senders) {
+		txdata, err := b.batchSubmitter.state.TxData(l1tip)
+		if err == io.EOF {
+			break
 		} else if err != nil {
-			b.l.Error("unable to get tx data", "err", err)
+			if len(txdatas) == 0 {
+				return nil, err
+			}
+			b.l.Warn("unable to get more tx data", "err", err)
 			break
 		}
+		txdatas = append(txdatas, txdata)
+	}
+	return txdatas, nil
+}
 
-		// Record TX Status
-		receipt, err := b.sendTransaction(ctx, txdata.Bytes())
-		if err != nil {
-			b.batchSubmitter.recordFailedTx(txdata.ID(), err)
-			return fmt.Errorf("failed to send batch submit transaction: %w", err)
+// sendTransactions submits the transaction data in parallel, each from the next sender account in round-robin,
+// and records the transaction status. It returns an error if any of the transactions failed.
+func (b *Batcher) sendTransactions(ctx context.Context, txdatas []txData) error {
+	receipts := make([]*types.Receipt, len(txdatas))
+	errs := make([]error, len(txdatas))
+	var wg sync.WaitGroup
+	for i, txdata := range txdatas {
+		sender := b.senders[b.nextSender]
+		b.nextSender = (b.nextSender + 1) % len(b.senders)
+
+		wg.Add(1)
+		go func(i int, data []byte) {
+			defer wg.Done()
+			receipts[i], errs[i] = b.sendTransaction(ctx, sender, data)
+		}(i, txdata.Bytes())
+	}
+	wg.Wait()
+
+	// Record TX Status
+	var failed error
+	for i, txdata := range txdatas {
+		if errs[i] != nil {
+			b.batchSubmitter.recordFailedTx(txdata.ID(), errs[i])
+			failed = errs[i]
+			continue
 		}
-		b.batchSubmitter.recordConfirmedTx(txdata.ID(), receipt)
+		b.batchSubmitter.recordConfirmedTx(txdata.ID(), receipts[i])
+	}
+	if failed != nil {
+		return fmt.Errorf("failed to send batch submit transaction: %w", failed)
 	}
-
 	return nil
 }
 
-// sendTransaction creates & submits a transaction to the batch inbox address with the given `data`.
+// sendTransaction creates & submits a transaction to the batch inbox address with the given `data`
+// from the account of the given sender.
 // It currently uses the underlying `txmgr` to handle transaction sending & price management.
-// This is a blocking method. It should not be called concurrently.
-func (b *Batcher) sendTransaction(ctx context.Context, data []byte) (*types.Receipt, error) {
+// This is a blocking method. It should not be called concurrently for the same sender.
+func (b *Batcher) sendTransaction(ctx context.Context, sender txmgr.TxManager, data []byte) (*types.Receipt, error) {
+	// Store the data on the external DA layer, and only submit the commitment to it to L1.
+	if b.cfg.DAProvider != nil {
+		comm, err := b.cfg.DAProvider.Store(ctx, data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to store data on the DA layer: %w", err)
+		}
+		b.l.Debug("stored batcher tx data on the DA layer", "commitment", comm, "layer", comm.Layer(), "size", len(data))
+		data = append([]byte{derive.DerivationVersionAltDA}, comm...)
+	}
+
 	// Do the gas estimation offline. A value of 0 will cause the [txmgr] to estimate the gas limit.
 	intrinsicGas, err := core.IntrinsicGas(data, nil, false, true, true, false)
 	if err != nil {
@@ -259,7 +498,7 @@ func (b *Batcher) sendTransaction(ctx context.Context, data []byte) (*types.Rece
 	}
 
 	// Send the transaction through the txmgr
-	receipt, err := b.cfg.TxManager.Send(ctx, txmgr.TxCandidate{
+	receipt, err := sender.Send(ctx, txmgr.TxCandidate{
 		To:       &b.batchSubmitter.Rollup.BatchInboxAddress,
 		TxData:   data,
 		GasLimit: intrinsicGas,
@@ -270,6 +509,6 @@ func (b *Batcher) sendTransaction(ctx context.Context, data []byte) (*types.Rece
 	}
 
 	// The transaction was successfully submitted
-	b.l.Info("batcher tx successfully published", "tx_hash", receipt.TxHash)
+	b.l.Info("batcher tx successfully published", "tx_hash", receipt.TxHash, "sender", sender.From())
 	return receipt, nil
 }
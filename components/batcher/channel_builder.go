@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 	"math"
+	"time"
 
 	"github.com/ethereum/go-ethereum/core/types"
 
@@ -69,6 +70,22 @@ type ChannelConfig struct {
 	// average from experiments to avoid the chances of creating a small
 	// additional leftover frame.
 	ApproxComprRatio float64
+	// CompressionAlgo is the algorithm to compress the channel data with. Zlib if empty.
+	// Zstd compressed channels are only accepted by the derivation after the zstd upgrade.
+	CompressionAlgo derive.CompressionAlgo
+	// CompressionLevel is the compression level specific to the algorithm. 0 selects the best compression level.
+	CompressionLevel int
+	// SpanBatch enables the encoding of the blocks of a channel into a single span batch.
+	// Span batches are only accepted by the derivation after the span batch upgrade.
+	SpanBatch bool
+
+	// AutoTuneMaxNumFrames enables the auto-tuning of the target number of frames of new channels
+	// between 1 and AutoTuneMaxNumFrames, based on the L1 basefee and the inclusion delay of recent channels.
+	// TargetNumFrames is the initial target number of frames. Disabled if 0.
+	AutoTuneMaxNumFrames int
+	// AutoTuneTargetInclusionDelay is the target delay from an L2 block to the L1 inclusion of its channel.
+	// The auto-tuning shrinks new channels while it is exceeded. Not targeted if 0.
+	AutoTuneTargetInclusionDelay time.Duration
 }
 
 // Check validates the [ChannelConfig] parameters.
@@ -94,6 +111,14 @@ func (cc *ChannelConfig) Check() error {
 		return fmt.Errorf("max frame size %d is less than the minimum 23", cc.MaxFrameSize)
 	}
 
+	if cc.CompressionAlgo != "" && !derive.ValidCompressionAlgo(cc.CompressionAlgo) {
+		return fmt.Errorf("unknown compression algo %q", cc.CompressionAlgo)
+	}
+
+	if cc.AutoTuneMaxNumFrames != 0 && cc.AutoTuneMaxNumFrames < cc.TargetNumFrames {
+		return fmt.Errorf("auto-tune max number of frames %d is less than the target number of frames %d", cc.AutoTuneMaxNumFrames, cc.TargetNumFrames)
+	}
+
 	return nil
 }
 
@@ -103,6 +128,18 @@ func (c ChannelConfig) InputThreshold() uint64 {
 	return uint64(float64(c.TargetNumFrames) * float64(c.TargetFrameSize) / c.ApproxComprRatio)
 }
 
+// channelOut is the output of the channel builder, which encodes the batches into frames.
+type channelOut interface {
+	ID() derive.ChannelID
+	Reset() error
+	AddBatch(batch *derive.BatchData) (uint64, error)
+	InputBytes() int
+	ReadyBytes() int
+	Flush() error
+	Close() error
+	OutputFrame(w *bytes.Buffer, maxSize uint64) (uint16, error)
+}
+
 type frameID struct {
 	chID        derive.ChannelID
 	frameNumber uint16
@@ -131,7 +168,7 @@ type channelBuilder struct {
 	// guaranteed to be a ChannelFullError wrapping the specific reason.
 	fullErr error
 	// current channel
-	co *derive.ChannelOut
+	co channelOut
 	// list of blocks in the channel. Saved in case the channel must be rebuilt
 	blocks []*types.Block
 	// frames data queue, to be send as txs
@@ -143,7 +180,17 @@ type channelBuilder struct {
 // newChannelBuilder creates a new channel builder or returns an error if the
 // channel out could not be created.
 func newChannelBuilder(cfg ChannelConfig) (*channelBuilder, error) {
-	co, err := derive.NewChannelOut()
+	algo := cfg.CompressionAlgo
+	if algo == "" {
+		algo = derive.Zlib
+	}
+	var co channelOut
+	var err error
+	if cfg.SpanBatch {
+		co, err = derive.NewSpanChannelOut(algo, cfg.CompressionLevel)
+	} else {
+		co, err = derive.NewChannelOutWithCompression(algo, cfg.CompressionLevel)
+	}
 	if err != nil {
 		return nil, err
 	}
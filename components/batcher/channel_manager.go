@@ -5,12 +5,17 @@ import (
 	"fmt"
 	"io"
 	"math"
+	"math/big"
+	"sort"
+	"sync"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/log"
 
 	"github.com/kroma-network/kroma/components/batcher/metrics"
+	"github.com/kroma-network/kroma/components/batcher/rpc"
 	"github.com/kroma-network/kroma/components/node/eth"
 	"github.com/kroma-network/kroma/components/node/rollup/derive"
 )
@@ -23,11 +28,16 @@ var ErrReorg = errors.New("block does not extend existing chain")
 // For simplicity, it only creates a single pending channel at a time & waits for
 // the channel to either successfully be submitted or timeout before creating a new
 // channel.
-// Functions on channelManager are not safe for concurrent access.
+// The exported functions on channelManager are safe for concurrent access,
+// so that the state can be inspected and flushed through the admin API.
 type channelManager struct {
+	mu sync.Mutex
+
 	log  log.Logger
 	metr metrics.Metricer
 	cfg  ChannelConfig
+	// tuner tunes the target number of frames of new channels, nil if the auto-tuning is disabled
+	tuner *channelSizeTuner
 
 	// All blocks since the last request for new tx data.
 	blocks []*types.Block
@@ -49,9 +59,10 @@ type channelManager struct {
 
 func NewChannelManager(log log.Logger, metr metrics.Metricer, cfg ChannelConfig) *channelManager {
 	return &channelManager{
-		log:  log,
-		metr: metr,
-		cfg:  cfg,
+		log:   log,
+		metr:  metr,
+		cfg:   cfg,
+		tuner: newChannelSizeTuner(cfg),
 
 		pendingTransactions:   make(map[txID]txData),
 		confirmedTransactions: make(map[txID]eth.BlockID),
@@ -61,6 +72,9 @@ func NewChannelManager(log log.Logger, metr metrics.Metricer, cfg ChannelConfig)
 // Clear clears the entire state of the channel manager.
 // It is intended to be used after an L2 reorg.
 func (c *channelManager) Clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
 	c.log.Trace("clearing channel manager state")
 	c.blocks = c.blocks[:0]
 	c.tip = common.Hash{}
@@ -71,6 +85,9 @@ func (c *channelManager) Clear() {
 // TxFailed records a transaction as failed. It will attempt to resubmit the data
 // in the failed transaction.
 func (c *channelManager) TxFailed(id txID) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
 	if data, ok := c.pendingTransactions[id]; ok {
 		c.log.Trace("marked transaction as failed", "id", id)
 		// Note: when the batcher is changed to send multiple frames per tx,
@@ -94,14 +111,19 @@ func (c *channelManager) TxFailed(id txID) {
 // resubmitted.
 // This function may reset the pending channel if the pending channel has timed out.
 func (c *channelManager) TxConfirmed(id txID, inclusionBlock eth.BlockID) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
 	c.metr.RecordBatchTxSubmitted()
 	c.log.Debug("marked transaction as confirmed", "id", id, "block", inclusionBlock)
-	if _, ok := c.pendingTransactions[id]; !ok {
+	data, ok := c.pendingTransactions[id]
+	if !ok {
 		c.log.Warn("unknown transaction marked as confirmed", "id", id, "block", inclusionBlock)
 		// TODO: This can occur if we clear the channel while there are still pending transactions
 		// We need to keep track of stale transactions instead
 		return
 	}
+	c.metr.RecordBatchTxFrames(1, len(data.Frame().data), int(c.cfg.MaxFrameSize))
 	delete(c.pendingTransactions, id)
 	c.confirmedTransactions[id] = inclusionBlock
 	c.pendingChannel.FramePublished(inclusionBlock.Number)
@@ -117,6 +139,14 @@ func (c *channelManager) TxConfirmed(id txID, inclusionBlock eth.BlockID) {
 	// If we are done with this channel, record that.
 	if c.pendingChannelIsFullySubmitted() {
 		c.metr.RecordChannelFullySubmitted(c.pendingChannel.ID())
+		// The L1 inclusion time is approximated by the confirmation time of the last transaction.
+		for i, block := range c.pendingChannel.Blocks() {
+			delay := time.Since(time.Unix(int64(block.Time()), 0))
+			c.metr.RecordL2BlockInclusionDelay(delay)
+			if i == 0 && c.tuner != nil {
+				c.tuner.ObserveInclusionDelay(delay)
+			}
+		}
 		c.log.Info("Channel is fully submitted", "id", c.pendingChannel.ID())
 		c.clearPendingChannel()
 	}
@@ -185,6 +215,9 @@ func (c *channelManager) nextTxData() (txData, error) {
 // full, it only returns the remaining frames of this channel until it got
 // successfully fully sent to L1. It returns io.EOF if there's no pending frame.
 func (c *channelManager) TxData(l1Head eth.BlockID) (txData, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
 	dataPending := c.pendingChannel != nil && c.pendingChannel.HasFrame()
 	c.log.Debug("Requested tx data", "l1Head", l1Head, "data_pending", dataPending, "blocks_pending", len(c.blocks))
 
@@ -225,7 +258,11 @@ func (c *channelManager) ensurePendingChannel(l1Head eth.BlockID) error {
 		return nil
 	}
 
-	cb, err := newChannelBuilder(c.cfg)
+	cfg := c.cfg
+	if c.tuner != nil {
+		cfg.TargetNumFrames = c.tuner.NextNumFrames()
+	}
+	cb, err := newChannelBuilder(cfg)
 	if err != nil {
 		return fmt.Errorf("creating new channel: %w", err)
 	}
@@ -233,7 +270,8 @@ func (c *channelManager) ensurePendingChannel(l1Head eth.BlockID) error {
 	c.log.Info("Created channel",
 		"id", cb.ID(),
 		"l1Head", l1Head,
-		"blocks_pending", len(c.blocks))
+		"blocks_pending", len(c.blocks),
+		"target_num_frames", cfg.TargetNumFrames)
 	c.metr.RecordChannelOpened(cb.ID(), len(c.blocks))
 
 	return nil
@@ -313,6 +351,11 @@ func (c *channelManager) outputFrames() error {
 		outBytes,
 		c.pendingChannel.FullErr(),
 	)
+	c.metr.RecordChannelUtilization(
+		len(c.pendingChannel.Blocks()),
+		outBytes,
+		int(c.cfg.TargetFrameSize)*c.cfg.TargetNumFrames,
+	)
 
 	var comprRatio float64
 	if inBytes > 0 {
@@ -334,6 +377,9 @@ func (c *channelManager) outputFrames() error {
 // if the block does not extend the last block loaded into the state. If no
 // blocks were added yet, the parent hash check is skipped.
 func (c *channelManager) AddL2Block(block *types.Block) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
 	if c.tip != (common.Hash{}) && c.tip != block.ParentHash() {
 		return ErrReorg
 	}
@@ -354,18 +400,212 @@ func l2BlockRefFromBlockAndL1Info(block *types.Block, l1info derive.L1BlockInfo)
 	}
 }
 
+// Flush adds all pending blocks to the pending channel, and closes it to output all of its frames right away.
+// Unlike Close, new channels are still created afterwards.
+func (c *channelManager) Flush(l1Head eth.BlockID) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.closed {
+		return errors.New("channel manager is closed")
+	}
+	if c.pendingChannel != nil && c.pendingChannel.IsFull() {
+		// The frames of a full channel are output already. The pending blocks are flushed
+		// into the next channel, once the pending channel is fully submitted.
+		return nil
+	}
+	if len(c.blocks) > 0 {
+		if err := c.ensurePendingChannel(l1Head); err != nil {
+			return err
+		}
+		if err := c.processBlocks(); err != nil {
+			return err
+		}
+		c.registerL1Block(l1Head)
+	}
+	if c.pendingChannel == nil {
+		return nil
+	}
+
+	c.log.Info("Flushing channel", "id", c.pendingChannel.ID(), "blocks_pending", len(c.blocks))
+	c.pendingChannel.Close()
+	return c.outputFrames()
+}
+
+// Status returns the pending state of the channel manager.
+func (c *channelManager) Status() *rpc.BatcherStatus {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	status := &rpc.BatcherStatus{
+		PendingBlocks: len(c.blocks),
+		PendingTxs:    len(c.pendingTransactions),
+	}
+	if c.pendingChannel != nil {
+		status.PendingFrames = c.pendingChannel.NumFrames()
+		for _, frame := range c.pendingChannel.frames {
+			status.PendingBytes += len(frame.data)
+		}
+	}
+	if oldest := c.oldestUnsubmittedBlock(); oldest != nil {
+		id := eth.ToBlockID(oldest)
+		status.OldestUnsubmittedBlock = &id
+	}
+	return status
+}
+
+// ObserveL1BaseFee records the basefee of the given L1 block for the auto-tuning of the channel size.
+func (c *channelManager) ObserveL1BaseFee(l1Block eth.BlockID, baseFee *big.Int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.tuner != nil {
+		c.tuner.ObserveBaseFee(l1Block, baseFee)
+	}
+}
+
+// OldestL1Origin returns the L1 origin number of the oldest L2 block of which the batch is not fully submitted yet.
+// It returns false if there is no such block.
+func (c *channelManager) OldestL1Origin() (uint64, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	oldest := c.oldestUnsubmittedBlock()
+	if oldest == nil || len(oldest.Transactions()) == 0 {
+		return 0, false
+	}
+	l1Info, err := derive.L1InfoDepositTxData(oldest.Transactions()[0].Data())
+	if err != nil {
+		c.log.Warn("failed to parse L1 info of oldest unsubmitted block", "block", eth.ToBlockID(oldest), "err", err)
+		return 0, false
+	}
+	return l1Info.Number, true
+}
+
+// oldestUnsubmittedBlock returns the oldest L2 block of which the batch is not fully submitted yet, if any.
+func (c *channelManager) oldestUnsubmittedBlock() *types.Block {
+	if c.pendingChannel != nil {
+		if blocks := c.pendingChannel.Blocks(); len(blocks) > 0 {
+			return blocks[0]
+		}
+	}
+	if len(c.blocks) > 0 {
+		return c.blocks[0]
+	}
+	return nil
+}
+
+// State returns the state of the channel manager to persist. The pending channel, if any, must be closed,
+// so that all of its frames are output.
+func (c *channelManager) State() (*channelManagerState, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	blocks, err := encodeBlocks(c.blocks)
+	if err != nil {
+		return nil, err
+	}
+	state := &channelManagerState{Blocks: blocks}
+	if c.pendingChannel == nil {
+		return state, nil
+	}
+	if !c.pendingChannel.IsFull() {
+		return nil, errors.New("cannot persist open channel")
+	}
+
+	blocks, err = encodeBlocks(c.pendingChannel.Blocks())
+	if err != nil {
+		return nil, err
+	}
+	ch := &channelState{
+		ID:      c.pendingChannel.ID(),
+		Blocks:  blocks,
+		Timeout: c.pendingChannel.timeout,
+	}
+	// Frames of unconfirmed transactions are persisted as unsubmitted, to be resubmitted after the restart.
+	for _, data := range c.pendingTransactions {
+		ch.Frames = append(ch.Frames, frameState{FrameNumber: data.frame.id.frameNumber, Data: data.frame.data})
+	}
+	for _, frame := range c.pendingChannel.frames {
+		ch.Frames = append(ch.Frames, frameState{FrameNumber: frame.id.frameNumber, Data: frame.data})
+	}
+	sort.Slice(ch.Frames, func(i, j int) bool { return ch.Frames[i].FrameNumber < ch.Frames[j].FrameNumber })
+	for id, inclusionBlock := range c.confirmedTransactions {
+		ch.ConfirmedFrames = append(ch.ConfirmedFrames, frameState{FrameNumber: id.frameNumber, InclusionBlock: inclusionBlock})
+	}
+	sort.Slice(ch.ConfirmedFrames, func(i, j int) bool { return ch.ConfirmedFrames[i].FrameNumber < ch.ConfirmedFrames[j].FrameNumber })
+	state.Channel = ch
+	return state, nil
+}
+
+// Restore replaces the state of the channel manager with the persisted state.
+// It returns the last restored L2 block, which is empty if no blocks were restored.
+func (c *channelManager) Restore(state *channelManagerState) (eth.BlockID, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	blocks, err := decodeBlocks(state.Blocks)
+	if err != nil {
+		return eth.BlockID{}, err
+	}
+	var pendingChannel *channelBuilder
+	pendingTransactions := make(map[txID]txData)
+	confirmedTransactions := make(map[txID]eth.BlockID)
+	var chBlocks []*types.Block
+	if ch := state.Channel; ch != nil {
+		chBlocks, err = decodeBlocks(ch.Blocks)
+		if err != nil {
+			return eth.BlockID{}, err
+		}
+		pendingChannel = &channelBuilder{
+			cfg:     c.cfg,
+			timeout: ch.Timeout,
+			co:      &closedChannelOut{id: ch.ID},
+			blocks:  chBlocks,
+		}
+		pendingChannel.setFullErr(ErrTerminated)
+		for _, frame := range ch.Frames {
+			pendingChannel.PushFrame(frameData{id: frameID{chID: ch.ID, frameNumber: frame.FrameNumber}, data: frame.Data})
+		}
+		for _, frame := range ch.ConfirmedFrames {
+			confirmedTransactions[frameID{chID: ch.ID, frameNumber: frame.FrameNumber}] = frame.InclusionBlock
+		}
+	}
+
+	c.blocks = blocks
+	c.tip = common.Hash{}
+	var last eth.BlockID
+	if all := append(chBlocks, blocks...); len(all) > 0 {
+		last = eth.ToBlockID(all[len(all)-1])
+		c.tip = last.Hash
+	}
+	c.closed = false
+	c.pendingChannel = pendingChannel
+	c.pendingTransactions = pendingTransactions
+	c.confirmedTransactions = confirmedTransactions
+	c.log.Info("Restored channel manager state", "blocks_pending", len(blocks), "tip", last, "channel", pendingChannel != nil)
+	return last, nil
+}
+
 // Close closes the current pending channel, if one exists, outputs any remaining frames,
 // and prevents the creation of any new channels.
 // Any outputted frames still need to be published.
 func (c *channelManager) Close() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
 	if c.closed {
 		return nil
 	}
 
 	c.closed = true
 
-	// Any pending state can be proactively cleared if there are no submitted transactions
+	// Any pending state can be proactively cleared if there are no submitted transactions.
+	// The blocks of the channel are put back, so that they are not discarded when the state is persisted.
 	if len(c.confirmedTransactions) == 0 && len(c.pendingTransactions) == 0 {
+		if c.pendingChannel != nil {
+			c.blocks = append(c.pendingChannel.Blocks(), c.blocks...)
+		}
 		c.clearPendingChannel()
 	}
 
@@ -4,6 +4,7 @@ import (
 	"io"
 	"math/big"
 	"math/rand"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -506,3 +507,105 @@ func TestChannelManagerCloseAllTxsFailed(t *testing.T) {
 	_, err = m.TxData(eth.BlockID{})
 	require.ErrorIs(err, io.EOF, "Expected closed channel manager to produce no more tx data")
 }
+
+// TestChannelManagerFlush ensures that flushing the channel manager closes the
+// pending channel with all pending blocks, while still allowing new channels.
+func TestChannelManagerFlush(t *testing.T) {
+	require := require.New(t)
+	log := testlog.Logger(t, log.LvlCrit)
+	m := NewChannelManager(log, metrics.NoopMetrics,
+		ChannelConfig{
+			TargetNumFrames:  100,
+			TargetFrameSize:  1000,
+			MaxFrameSize:     1000,
+			ApproxComprRatio: 1.0,
+			ChannelTimeout:   1000,
+		})
+
+	a := newMiniL2Block(10)
+	b := newMiniL2BlockWithNumberParent(10, big.NewInt(1), a.Hash())
+
+	require.NoError(m.AddL2Block(a), "Failed to add L2 block")
+	status := m.Status()
+	require.Equal(1, status.PendingBlocks)
+	require.Zero(status.PendingFrames)
+	require.Equal(eth.ToBlockID(a), *status.OldestUnsubmittedBlock)
+
+	require.NoError(m.Flush(eth.BlockID{}))
+	status = m.Status()
+	require.Zero(status.PendingBlocks)
+	require.Equal(1, status.PendingFrames)
+	require.Positive(status.PendingBytes)
+	require.Equal(eth.ToBlockID(a), *status.OldestUnsubmittedBlock)
+
+	txdata, err := m.TxData(eth.BlockID{})
+	require.NoError(err, "Expected channel manager to produce tx data of the flushed channel")
+	require.Equal(1, m.Status().PendingTxs)
+
+	// Blocks are only flushed into the next channel while the flushed channel is submitted.
+	require.NoError(m.AddL2Block(b), "Failed to add L2 block")
+	require.NoError(m.Flush(eth.BlockID{}), "Expected flush of a full channel to be a no-op")
+	require.Equal(1, m.Status().PendingBlocks)
+
+	m.TxConfirmed(txdata.ID(), eth.BlockID{})
+	require.Equal(eth.ToBlockID(b), *m.Status().OldestUnsubmittedBlock, "Expected flushed channel to be fully submitted")
+
+	require.NoError(m.Flush(eth.BlockID{}))
+	require.Zero(m.Status().PendingBlocks)
+	require.Equal(eth.ToBlockID(b), *m.Status().OldestUnsubmittedBlock)
+	_, err = m.TxData(eth.BlockID{})
+	require.NoError(err, "Expected flushed channel manager to create a new channel")
+}
+
+// TestChannelManagerPersistState ensures that the state of a closed channel manager
+// can be persisted and restored, without losing unsubmitted frames and blocks.
+func TestChannelManagerPersistState(t *testing.T) {
+	require := require.New(t)
+	log := testlog.Logger(t, log.LvlCrit)
+	cfg := ChannelConfig{
+		TargetNumFrames:  100,
+		TargetFrameSize:  1000,
+		MaxFrameSize:     1000,
+		ApproxComprRatio: 1.0,
+		ChannelTimeout:   1000,
+	}
+	m := NewChannelManager(log, metrics.NoopMetrics, cfg)
+
+	a := newMiniL2Block(50_000)
+	b := newMiniL2BlockWithNumberParent(10, big.NewInt(1), a.Hash())
+	require.NoError(m.AddL2Block(a), "Failed to add L2 block")
+
+	txdata, err := m.TxData(eth.BlockID{})
+	require.NoError(err, "Expected channel manager to produce valid tx data")
+	m.TxConfirmed(txdata.ID(), eth.BlockID{Number: 1})
+	require.NoError(m.AddL2Block(b), "Failed to add L2 block")
+	require.NoError(m.Close())
+
+	// Leave the next frame unconfirmed, it is resubmitted after the restore.
+	pending, err := m.TxData(eth.BlockID{})
+	require.NoError(err)
+	expected := append([]frameData{pending.Frame()}, m.pendingChannel.frames...)
+
+	state, err := m.State()
+	require.NoError(err)
+	file := filepath.Join(t.TempDir(), "state.json")
+	require.NoError(saveChannelManagerState(file, state))
+	state, err = loadChannelManagerState(file)
+	require.NoError(err)
+
+	restored := NewChannelManager(log, metrics.NoopMetrics, cfg)
+	last, err := restored.Restore(state)
+	require.NoError(err)
+	require.Equal(eth.ToBlockID(b), last)
+	require.Equal(m.Status().OldestUnsubmittedBlock, restored.Status().OldestUnsubmittedBlock)
+
+	for _, exp := range expected {
+		txdata, err := restored.TxData(eth.BlockID{})
+		require.NoError(err)
+		require.Equal(exp, txdata.Frame())
+		restored.TxConfirmed(txdata.ID(), eth.BlockID{Number: 2})
+	}
+	require.Nil(restored.pendingChannel, "Expected restored channel to be fully submitted")
+	require.Len(restored.blocks, 1)
+	require.Equal(b.Hash(), restored.blocks[0].Hash())
+}
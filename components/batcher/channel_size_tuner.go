@@ -0,0 +1,88 @@
+package batcher
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/params"
+
+	"github.com/kroma-network/kroma/components/node/eth"
+)
+
+const (
+	// baseFeeAvgWeight is the weight of a new basefee in the moving average of the basefee.
+	baseFeeAvgWeight = 0.1
+	// baseFeeDeviation is the relative deviation of the basefee from its moving average
+	// that the channel size is tuned at.
+	baseFeeDeviation = 0.1
+)
+
+// channelSizeTuner tunes the target number of frames of new channels to the L1 fee regime.
+// When the L1 basefee rises above its recent average, channels are grown to compress better and to
+// amortize the per-transaction overhead. When the basefee falls below its recent average, or when
+// the L2 blocks of recent channels took longer than targeted to be included on L1, channels are
+// shrunk to submit the L2 blocks sooner.
+type channelSizeTuner struct {
+	maxNumFrames         int
+	targetInclusionDelay time.Duration
+
+	numFrames int
+	// lastL1Block is the L1 block of the last observed basefee, to observe the basefee of a block only once
+	lastL1Block eth.BlockID
+	// baseFee is the last observed basefee in gwei, avgBaseFee the moving average of the observed basefees
+	baseFee    float64
+	avgBaseFee float64
+	// inclusionDelay is the inclusion delay of the oldest L2 block of the last fully submitted channel
+	inclusionDelay time.Duration
+}
+
+// newChannelSizeTuner creates a channelSizeTuner starting from the target number of frames of the config.
+// It returns nil if the auto-tuning is disabled.
+func newChannelSizeTuner(cfg ChannelConfig) *channelSizeTuner {
+	if cfg.AutoTuneMaxNumFrames == 0 {
+		return nil
+	}
+	return &channelSizeTuner{
+		maxNumFrames:         cfg.AutoTuneMaxNumFrames,
+		targetInclusionDelay: cfg.AutoTuneTargetInclusionDelay,
+		numFrames:            cfg.TargetNumFrames,
+	}
+}
+
+// ObserveBaseFee records the basefee of the given L1 block.
+func (t *channelSizeTuner) ObserveBaseFee(l1Block eth.BlockID, baseFee *big.Int) {
+	if t.lastL1Block == l1Block {
+		return
+	}
+	t.lastL1Block = l1Block
+	gwei, _ := new(big.Float).Quo(new(big.Float).SetInt(baseFee), big.NewFloat(params.GWei)).Float64()
+	t.baseFee = gwei
+	if t.avgBaseFee == 0 {
+		t.avgBaseFee = gwei
+	} else {
+		t.avgBaseFee += baseFeeAvgWeight * (gwei - t.avgBaseFee)
+	}
+}
+
+// ObserveInclusionDelay records the inclusion delay of the oldest L2 block of a fully submitted channel.
+func (t *channelSizeTuner) ObserveInclusionDelay(delay time.Duration) {
+	t.inclusionDelay = delay
+}
+
+// NextNumFrames tunes the target number of frames to the observations, and returns it for a new channel.
+func (t *channelSizeTuner) NextNumFrames() int {
+	switch {
+	case t.targetInclusionDelay > 0 && t.inclusionDelay > t.targetInclusionDelay:
+		t.numFrames--
+	case t.baseFee > t.avgBaseFee*(1+baseFeeDeviation):
+		t.numFrames++
+	case t.baseFee < t.avgBaseFee*(1-baseFeeDeviation):
+		t.numFrames--
+	}
+	if t.numFrames < 1 {
+		t.numFrames = 1
+	} else if t.numFrames > t.maxNumFrames {
+		t.numFrames = t.maxNumFrames
+	}
+	return t.numFrames
+}
@@ -0,0 +1,57 @@
+package batcher
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kroma-network/kroma/components/node/eth"
+)
+
+func TestChannelSizeTunerDisabled(t *testing.T) {
+	require.Nil(t, newChannelSizeTuner(ChannelConfig{TargetNumFrames: 2}))
+}
+
+func TestChannelSizeTuner(t *testing.T) {
+	tuner := newChannelSizeTuner(ChannelConfig{
+		TargetNumFrames:              2,
+		AutoTuneMaxNumFrames:         3,
+		AutoTuneTargetInclusionDelay: time.Minute,
+	})
+	var num uint64
+	observe := func(gwei int64) {
+		num++
+		tuner.ObserveBaseFee(eth.BlockID{Number: num, Hash: common.Hash{byte(num)}}, big.NewInt(gwei*params.GWei))
+	}
+
+	// a stable basefee keeps the channel size
+	observe(10)
+	observe(10)
+	require.Equal(t, 2, tuner.NextNumFrames())
+
+	// the same block is only observed once
+	tuner.ObserveBaseFee(eth.BlockID{Number: num, Hash: common.Hash{byte(num)}}, big.NewInt(100*params.GWei))
+	require.Equal(t, 2, tuner.NextNumFrames())
+
+	// a rising basefee grows channels, up to the max
+	observe(20)
+	require.Equal(t, 3, tuner.NextNumFrames())
+	require.Equal(t, 3, tuner.NextNumFrames())
+
+	// a slow inclusion shrinks channels, down to 1
+	tuner.ObserveInclusionDelay(2 * time.Minute)
+	require.Equal(t, 2, tuner.NextNumFrames())
+	require.Equal(t, 1, tuner.NextNumFrames())
+	require.Equal(t, 1, tuner.NextNumFrames())
+
+	// a falling basefee shrinks channels as well
+	tuner.ObserveInclusionDelay(time.Second)
+	observe(20)
+	require.Equal(t, 2, tuner.NextNumFrames())
+	observe(1)
+	require.Equal(t, 1, tuner.NextNumFrames())
+}
@@ -0,0 +1,145 @@
+package batcher
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
+
+	"github.com/kroma-network/kroma/components/node/eth"
+	"github.com/kroma-network/kroma/components/node/rollup/derive"
+)
+
+// channelManagerState is the state of the channel manager that is persisted across restarts,
+// so that a restart does not discard buffered blocks and unsubmitted frames.
+type channelManagerState struct {
+	// Blocks are the RLP encoded L2 blocks that are not added to a channel yet.
+	Blocks []hexutil.Bytes `json:"blocks"`
+	// Channel is the pending channel, if any.
+	Channel *channelState `json:"channel,omitempty"`
+}
+
+// channelState is the persisted state of a pending channel. Channels are closed before they are persisted,
+// so all the frames of the channel are output already.
+type channelState struct {
+	ID derive.ChannelID `json:"id"`
+	// Blocks are the RLP encoded L2 blocks of the channel, to rebuild the channel if it times out.
+	Blocks []hexutil.Bytes `json:"blocks"`
+	// Frames are the frames that are not confirmed on L1 yet.
+	Frames []frameState `json:"frames"`
+	// ConfirmedFrames are the frames that are confirmed on L1, to determine if the channel timed out.
+	ConfirmedFrames []frameState `json:"confirmed_frames"`
+	// Timeout is the L1 block number timeout of the channel, 0 if not set.
+	Timeout uint64 `json:"timeout"`
+}
+
+type frameState struct {
+	FrameNumber uint16 `json:"frame_number"`
+	// Data is only set for unconfirmed frames.
+	Data hexutil.Bytes `json:"data,omitempty"`
+	// InclusionBlock is only set for confirmed frames.
+	InclusionBlock eth.BlockID `json:"inclusion_block"`
+}
+
+// closedChannelOut is the channel out of a restored channel, of which all frames were output before it was persisted.
+type closedChannelOut struct {
+	id derive.ChannelID
+}
+
+func (co *closedChannelOut) ID() derive.ChannelID {
+	return co.id
+}
+
+func (co *closedChannelOut) Reset() error {
+	return errors.New("cannot reset a restored channel")
+}
+
+func (co *closedChannelOut) AddBatch(*derive.BatchData) (uint64, error) {
+	return 0, errors.New("already closed")
+}
+
+func (co *closedChannelOut) InputBytes() int {
+	return 0
+}
+
+func (co *closedChannelOut) ReadyBytes() int {
+	return 0
+}
+
+func (co *closedChannelOut) Flush() error {
+	return nil
+}
+
+func (co *closedChannelOut) Close() error {
+	return errors.New("already closed")
+}
+
+func (co *closedChannelOut) OutputFrame(*bytes.Buffer, uint64) (uint16, error) {
+	return 0, errors.New("already closed")
+}
+
+func encodeBlocks(blocks []*types.Block) ([]hexutil.Bytes, error) {
+	out := make([]hexutil.Bytes, 0, len(blocks))
+	for _, block := range blocks {
+		data, err := rlp.EncodeToBytes(block)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode block %s: %w", eth.ToBlockID(block), err)
+		}
+		out = append(out, data)
+	}
+	return out, nil
+}
+
+func decodeBlocks(data []hexutil.Bytes) ([]*types.Block, error) {
+	out := make([]*types.Block, 0, len(data))
+	for i, d := range data {
+		block := new(types.Block)
+		if err := rlp.DecodeBytes(d, block); err != nil {
+			return nil, fmt.Errorf("failed to decode block %d: %w", i, err)
+		}
+		out = append(out, block)
+	}
+	return out, nil
+}
+
+// saveChannelManagerState writes the state to the given file. The file is replaced atomically.
+func saveChannelManagerState(path string, state *channelManagerState) error {
+	data, err := json.Marshal(state)
+	if err != nil {
+		return fmt.Errorf("failed to encode channel manager state: %w", err)
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".tmp")
+	if err != nil {
+		return fmt.Errorf("failed to create channel manager state file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("failed to write channel manager state: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write channel manager state: %w", err)
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// loadChannelManagerState reads the state from the given file. It returns nil if the file does not exist.
+func loadChannelManagerState(path string) (*channelManagerState, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to read channel manager state: %w", err)
+	}
+	var state channelManagerState
+	if err := json.Unmarshal(data, &state); err != nil {
+		return nil, fmt.Errorf("failed to decode channel manager state: %w", err)
+	}
+	return &state, nil
+}
@@ -2,6 +2,7 @@ package batcher
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -12,7 +13,9 @@ import (
 	"github.com/kroma-network/kroma/components/batcher/flags"
 	"github.com/kroma-network/kroma/components/batcher/metrics"
 	"github.com/kroma-network/kroma/components/batcher/rpc"
+	"github.com/kroma-network/kroma/components/node/altda"
 	"github.com/kroma-network/kroma/components/node/rollup"
+	"github.com/kroma-network/kroma/components/node/rollup/derive"
 	"github.com/kroma-network/kroma/components/node/sources"
 	"github.com/kroma-network/kroma/utils"
 	klog "github.com/kroma-network/kroma/utils/service/log"
@@ -28,6 +31,9 @@ type Config struct {
 	L2Client     *ethclient.Client
 	RollupClient *sources.RollupClient
 	TxManager    txmgr.TxManager
+	// ExtraTxManagers are the tx managers of the extra sender accounts,
+	// to submit transactions in parallel with TxManager.
+	ExtraTxManagers []txmgr.TxManager
 
 	NetworkTimeout time.Duration
 	PollInterval   time.Duration
@@ -37,6 +43,19 @@ type Config struct {
 
 	// Channel builder parameters
 	Channel ChannelConfig
+
+	// ChannelStateFile is the file to persist the channel state in across restarts. Not persisted if empty.
+	ChannelStateFile string
+
+	// DryRun builds the channels without submitting them, only reporting the projected costs of the transactions.
+	DryRun bool
+
+	// Throttle configures when to back off from submitting transactions during L1 congestion.
+	Throttle ThrottleConfig
+
+	// DAProvider stores the channel frames on an external DA layer, so that only the commitments
+	// to them are submitted to L1. The channel frames are submitted to L1 as calldata if nil.
+	DAProvider DAProvider
 }
 
 // Check ensures that the [Config] is valid.
@@ -47,6 +66,21 @@ func (c *Config) Check() error {
 	if err := c.Channel.Check(); err != nil {
 		return err
 	}
+	// The batcher does not switch the compression at the upgrade, so zstd can only be used once active.
+	if c.Channel.CompressionAlgo == derive.Zstd && !c.Rollup.IsZstd(uint64(time.Now().Unix())) {
+		return errors.New("zstd compression cannot be used before the zstd upgrade is active")
+	}
+	if c.Channel.SpanBatch && !c.Rollup.IsSpanBatch(uint64(time.Now().Unix())) {
+		return errors.New("span batches cannot be used before the span batch upgrade is active")
+	}
+	if c.DAProvider != nil && !c.Rollup.IsAltDA(uint64(time.Now().Unix())) {
+		return errors.New("alt-DA cannot be used before alt-DA commitments are active")
+	}
+	for _, m := range c.ExtraTxManagers {
+		if !c.Rollup.IsExtraBatcherAddr(m.From()) {
+			return fmt.Errorf("extra sender account %s is not an extra batcher address of the rollup config", m.From())
+		}
+	}
 	return nil
 }
 
@@ -92,6 +126,49 @@ type CLIConfig struct {
 	// compression algorithm.
 	ApproxComprRatio float64
 
+	// CompressionAlgo is the algorithm to compress the channel data with.
+	CompressionAlgo derive.CompressionAlgo
+
+	// CompressionLevel is the compression level specific to the algorithm. 0 selects the best compression level.
+	CompressionLevel int
+
+	// SpanBatch enables the encoding of the blocks of a channel into a single span batch.
+	SpanBatch bool
+
+	// AutoTuneMaxNumFrames is the max target number of frames of the auto-tuning of the channel size. 0 disables it.
+	AutoTuneMaxNumFrames int
+
+	// AutoTuneTargetInclusionDelay is the target delay from an L2 block to the L1 inclusion of its channel.
+	AutoTuneTargetInclusionDelay time.Duration
+
+	// ChannelStateFile is the file to persist the buffered blocks and unsubmitted frames in across restarts.
+	ChannelStateFile string
+
+	// ShutdownTimeout is the max duration to submit the remaining L2 blocks on shutdown.
+	ShutdownTimeout time.Duration
+
+	// DryRun builds the channels without submitting them, only reporting the projected costs of the transactions.
+	DryRun bool
+
+	// ThrottleMaxPendingTxs is the maximum number of pending batcher transactions in the L1 mempool
+	// to submit new transactions. 0 disables the check.
+	ThrottleMaxPendingTxs uint64
+
+	// ThrottleMaxBaseFeeGwei is the maximum L1 basefee in gwei to submit transactions at. 0 disables the check.
+	ThrottleMaxBaseFeeGwei uint64
+
+	// ExtraPrivateKeys are the private keys of the extra sender accounts to submit transactions in parallel with.
+	ExtraPrivateKeys []string
+
+	// DataAvailabilityType is the data availability type to use for submitting batches to the L1.
+	DataAvailabilityType flags.DataAvailabilityType
+
+	// AltDAProvider is the external DA layer to store the channel frames on with the altda data availability type.
+	AltDAProvider flags.DAProviderType
+
+	// AltDAServer is the HTTP address of the DA server of the external DA layer.
+	AltDAServer string
+
 	TxMgrConfig   txmgr.CLIConfig
 	RPCConfig     rpc.CLIConfig
 	LogConfig     klog.CLIConfig
@@ -100,6 +177,30 @@ type CLIConfig struct {
 }
 
 func (c CLIConfig) Check() error {
+	if !flags.ValidDataAvailabilityType(c.DataAvailabilityType) {
+		return fmt.Errorf("unknown data availability type: %q", c.DataAvailabilityType)
+	}
+	// Blob transactions require EIP-4844 support of the L1 client and of the derivation pipeline,
+	// which is not available in the go-ethereum version the batcher is built with.
+	// The auto type switches between calldata and blobs, and thus needs blob support as well.
+	if c.DataAvailabilityType == flags.BlobsType || c.DataAvailabilityType == flags.AutoType {
+		return fmt.Errorf("%s data availability is not supported yet: EIP-4844 transactions are not supported by the L1 client", c.DataAvailabilityType)
+	}
+	if c.DataAvailabilityType == flags.AltDAType {
+		if !flags.ValidDAProviderType(c.AltDAProvider) {
+			return fmt.Errorf("unknown DA provider type: %q", c.AltDAProvider)
+		}
+		if c.AltDAServer == "" {
+			return errors.New("DA server must be set with the altda data availability type")
+		}
+	}
+	// The dry-run marks the transactions as confirmed without submitting them, which must not be persisted.
+	if c.DryRun && c.ChannelStateFile != "" {
+		return errors.New("channel state file cannot be used in dry-run mode")
+	}
+	if len(c.ExtraPrivateKeys) > 0 && c.TxMgrConfig.SignerCLIConfig.Enabled() {
+		return errors.New("extra private keys cannot be used with a remote signer")
+	}
 	if err := c.RPCConfig.Check(); err != nil {
 		return err
 	}
@@ -129,16 +230,30 @@ func NewCLIConfig(ctx *cli.Context) CLIConfig {
 		PollInterval:    ctx.GlobalDuration(flags.PollIntervalFlag.Name),
 
 		// Optional Flags
-		MaxChannelDuration: ctx.GlobalUint64(flags.MaxChannelDurationFlag.Name),
-		MaxL1TxSize:        ctx.GlobalUint64(flags.MaxL1TxSizeBytesFlag.Name),
-		TargetL1TxSize:     ctx.GlobalUint64(flags.TargetL1TxSizeBytesFlag.Name),
-		TargetNumFrames:    ctx.GlobalInt(flags.TargetNumFramesFlag.Name),
-		ApproxComprRatio:   ctx.GlobalFloat64(flags.ApproxComprRatioFlag.Name),
-		TxMgrConfig:        txmgr.ReadCLIConfig(ctx),
-		RPCConfig:          rpc.ReadCLIConfig(ctx),
-		LogConfig:          klog.ReadCLIConfig(ctx),
-		MetricsConfig:      kmetrics.ReadCLIConfig(ctx),
-		PprofConfig:        kpprof.ReadCLIConfig(ctx),
+		MaxChannelDuration:           ctx.GlobalUint64(flags.MaxChannelDurationFlag.Name),
+		MaxL1TxSize:                  ctx.GlobalUint64(flags.MaxL1TxSizeBytesFlag.Name),
+		TargetL1TxSize:               ctx.GlobalUint64(flags.TargetL1TxSizeBytesFlag.Name),
+		TargetNumFrames:              ctx.GlobalInt(flags.TargetNumFramesFlag.Name),
+		ApproxComprRatio:             ctx.GlobalFloat64(flags.ApproxComprRatioFlag.Name),
+		CompressionAlgo:              *ctx.GlobalGeneric(flags.CompressionAlgoFlag.Name).(*derive.CompressionAlgo),
+		CompressionLevel:             ctx.GlobalInt(flags.CompressionLevelFlag.Name),
+		SpanBatch:                    ctx.GlobalBool(flags.SpanBatchFlag.Name),
+		AutoTuneMaxNumFrames:         ctx.GlobalInt(flags.AutoTuneMaxNumFramesFlag.Name),
+		AutoTuneTargetInclusionDelay: ctx.GlobalDuration(flags.AutoTuneTargetInclusionDelayFlag.Name),
+		ChannelStateFile:             ctx.GlobalString(flags.ChannelStateFileFlag.Name),
+		ShutdownTimeout:              ctx.GlobalDuration(flags.ShutdownTimeoutFlag.Name),
+		DryRun:                       ctx.GlobalBool(flags.DryRunFlag.Name),
+		ThrottleMaxPendingTxs:        ctx.GlobalUint64(flags.ThrottleMaxPendingTxsFlag.Name),
+		ThrottleMaxBaseFeeGwei:       ctx.GlobalUint64(flags.ThrottleMaxBaseFeeGweiFlag.Name),
+		ExtraPrivateKeys:             ctx.GlobalStringSlice(flags.ExtraPrivateKeysFlag.Name),
+		DataAvailabilityType:         *ctx.GlobalGeneric(flags.DataAvailabilityTypeFlag.Name).(*flags.DataAvailabilityType),
+		AltDAProvider:                *ctx.GlobalGeneric(flags.AltDAProviderFlag.Name).(*flags.DAProviderType),
+		AltDAServer:                  ctx.GlobalString(flags.AltDAServerFlag.Name),
+		TxMgrConfig:                  txmgr.ReadCLIConfig(ctx),
+		RPCConfig:                    rpc.ReadCLIConfig(ctx),
+		LogConfig:                    klog.ReadCLIConfig(ctx),
+		MetricsConfig:                kmetrics.ReadCLIConfig(ctx),
+		PprofConfig:                  kpprof.ReadCLIConfig(ctx),
 	}
 }
 
@@ -172,16 +287,38 @@ func NewBatcherConfig(cfg CLIConfig, l log.Logger, m metrics.Metricer) (*Config,
 		return nil, err
 	}
 
+	extraTxManagers := make([]txmgr.TxManager, 0, len(cfg.ExtraPrivateKeys))
+	for i, key := range cfg.ExtraPrivateKeys {
+		txMgrConfig := cfg.TxMgrConfig
+		txMgrConfig.PrivateKey = key
+		txMgrConfig.Mnemonic = ""
+		txMgrConfig.HDPath = ""
+		extraTxManager, err := txmgr.NewSimpleTxManager("batcher", l, m, txMgrConfig)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create tx manager of extra sender account %d: %w", i, err)
+		}
+		extraTxManagers = append(extraTxManagers, extraTxManager)
+	}
+
+	var daProvider DAProvider
+	if cfg.DataAvailabilityType == flags.AltDAType {
+		daProvider, err = NewDAProvider(cfg.AltDAProvider, altda.NewDAClient(cfg.AltDAServer, altda.DefaultTimeout))
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return &Config{
-		log:            l,
-		metr:           m,
-		L1Client:       l1Client,
-		L2Client:       l2Client,
-		RollupClient:   rollupClient,
-		PollInterval:   cfg.PollInterval,
-		NetworkTimeout: cfg.TxMgrConfig.NetworkTimeout,
-		TxManager:      txManager,
-		Rollup:         rcfg,
+		log:             l,
+		metr:            m,
+		L1Client:        l1Client,
+		L2Client:        l2Client,
+		RollupClient:    rollupClient,
+		PollInterval:    cfg.PollInterval,
+		NetworkTimeout:  cfg.TxMgrConfig.NetworkTimeout,
+		TxManager:       txManager,
+		ExtraTxManagers: extraTxManagers,
+		Rollup:          rcfg,
 		Channel: ChannelConfig{
 			ProposerWindowSize: rcfg.ProposerWindowSize,
 			ChannelTimeout:     rcfg.ChannelTimeout,
@@ -191,6 +328,16 @@ func NewBatcherConfig(cfg CLIConfig, l log.Logger, m metrics.Metricer) (*Config,
 			TargetFrameSize:    cfg.TargetL1TxSize - 1, // subtract 1 byte for version
 			TargetNumFrames:    cfg.TargetNumFrames,
 			ApproxComprRatio:   cfg.ApproxComprRatio,
+			CompressionAlgo:    cfg.CompressionAlgo,
+			CompressionLevel:   cfg.CompressionLevel,
+			SpanBatch:          cfg.SpanBatch,
+
+			AutoTuneMaxNumFrames:         cfg.AutoTuneMaxNumFrames,
+			AutoTuneTargetInclusionDelay: cfg.AutoTuneTargetInclusionDelay,
 		},
+		ChannelStateFile: cfg.ChannelStateFile,
+		DryRun:           cfg.DryRun,
+		Throttle:         NewThrottleConfig(cfg.ThrottleMaxPendingTxs, cfg.ThrottleMaxBaseFeeGwei),
+		DAProvider:       daProvider,
 	}, nil
 }
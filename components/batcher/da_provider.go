@@ -0,0 +1,45 @@
+package batcher
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kroma-network/kroma/components/batcher/flags"
+	"github.com/kroma-network/kroma/components/node/altda"
+)
+
+// DAProvider stores batcher tx data on an external DA layer, so that only the commitment
+// to the data has to be submitted to L1.
+type DAProvider interface {
+	// Store stores the data on the DA layer and returns the commitment to the data.
+	Store(ctx context.Context, data []byte) (altda.Commitment, error)
+}
+
+// daServerProvider stores data on a DA layer through a DA server of the DA layer.
+type daServerProvider struct {
+	layer  altda.DALayer
+	client *altda.DAClient
+}
+
+// NewDAProvider creates the DA provider of the given type, which stores data through the given DA server.
+func NewDAProvider(kind flags.DAProviderType, client *altda.DAClient) (DAProvider, error) {
+	switch kind {
+	case flags.CelestiaProvider:
+		return &daServerProvider{layer: altda.Celestia, client: client}, nil
+	case flags.EigenDAProvider:
+		return &daServerProvider{layer: altda.EigenDA, client: client}, nil
+	default:
+		return nil, fmt.Errorf("unknown DA provider type: %q", kind)
+	}
+}
+
+func (p *daServerProvider) Store(ctx context.Context, data []byte) (altda.Commitment, error) {
+	comm, err := p.client.SetInput(ctx, data)
+	if err != nil {
+		return nil, err
+	}
+	if comm.Layer() != p.layer {
+		return nil, fmt.Errorf("DA server returned commitment %s for %s, expected %s", comm, comm.Layer(), p.layer)
+	}
+	return comm, nil
+}
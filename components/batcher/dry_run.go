@@ -0,0 +1,79 @@
+package batcher
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/core"
+
+	"github.com/kroma-network/kroma/components/node/eth"
+)
+
+const (
+	// blobGasPerBlob is the blob gas used per EIP-4844 blob.
+	blobGasPerBlob = 1 << 17
+	// maxBlobDataSize is the max number of data bytes per blob, with 4 field elements of 31 bytes
+	// encoding 3 additional bytes, minus the 4 bytes of the version and length prefix.
+	maxBlobDataSize = (4*31+3)*1024 - 4
+)
+
+// txCostEstimate is the projected size and cost of a batcher transaction.
+type txCostEstimate struct {
+	// CalldataBytes is the number of calldata bytes if the data is submitted as calldata.
+	CalldataBytes int
+	// CalldataGas is the intrinsic gas of the transaction if the data is submitted as calldata.
+	CalldataGas uint64
+	// CalldataCost is the cost in wei of CalldataGas at the L1 basefee.
+	CalldataCost *big.Int
+	// NumBlobs is the number of blobs if the data is submitted as blobs.
+	NumBlobs int
+	// BlobGas is the blob gas of NumBlobs. The blob basefee is not known to the L1 client,
+	// so the blob cost is not estimated.
+	BlobGas uint64
+}
+
+// estimateTxCost projects the size and cost of submitting the given transaction data, as calldata and as blobs.
+func estimateTxCost(data []byte, baseFee *big.Int) (txCostEstimate, error) {
+	gas, err := core.IntrinsicGas(data, nil, false, true, true, false)
+	if err != nil {
+		return txCostEstimate{}, fmt.Errorf("failed to calculate intrinsic gas: %w", err)
+	}
+	numBlobs := (len(data) + maxBlobDataSize - 1) / maxBlobDataSize
+	return txCostEstimate{
+		CalldataBytes: len(data),
+		CalldataGas:   gas,
+		CalldataCost:  new(big.Int).Mul(new(big.Int).SetUint64(gas), baseFee),
+		NumBlobs:      numBlobs,
+		BlobGas:       uint64(numBlobs) * blobGasPerBlob,
+	}, nil
+}
+
+// dryRunTransactions reports the projected costs of the transaction data instead of submitting it,
+// and marks the transactions as confirmed in the L1 tip, so that the following channels are built.
+func (b *Batcher) dryRunTransactions(ctx context.Context, l1tip eth.L1BlockRef, txdatas []txData) error {
+	tctx, cancel := context.WithTimeout(ctx, b.cfg.NetworkTimeout)
+	defer cancel()
+	head, err := b.cfg.L1Client.HeaderByHash(tctx, l1tip.Hash)
+	if err != nil {
+		return fmt.Errorf("failed to get L1 basefee: %w", err)
+	}
+	baseFee := head.BaseFee
+	if baseFee == nil {
+		baseFee = new(big.Int)
+	}
+
+	for _, txdata := range txdatas {
+		est, err := estimateTxCost(txdata.Bytes(), baseFee)
+		if err != nil {
+			return err
+		}
+		b.l.Info("Dry-run batcher tx",
+			"id", txdata.ID(), "l1_block", l1tip.ID(), "basefee", baseFee,
+			"calldata_bytes", est.CalldataBytes, "calldata_gas", est.CalldataGas, "calldata_cost", est.CalldataCost,
+			"num_blobs", est.NumBlobs, "blob_gas", est.BlobGas)
+		b.metr.RecordDryRunTx(est.CalldataBytes, est.CalldataGas, est.CalldataCost, est.NumBlobs)
+		b.batchSubmitter.state.TxConfirmed(txdata.ID(), l1tip.ID())
+	}
+	return nil
+}
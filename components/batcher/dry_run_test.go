@@ -0,0 +1,29 @@
+package batcher
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEstimateTxCost(t *testing.T) {
+	baseFee := big.NewInt(10 * params.GWei)
+
+	data := make([]byte, 1000)
+	data[0] = 1
+	est, err := estimateTxCost(data, baseFee)
+	require.NoError(t, err)
+	require.Equal(t, 1000, est.CalldataBytes)
+	// one non-zero byte and 999 zero bytes on top of the tx gas
+	require.Equal(t, params.TxGas+params.TxDataNonZeroGasEIP2028+999*params.TxDataZeroGas, est.CalldataGas)
+	require.Equal(t, new(big.Int).Mul(new(big.Int).SetUint64(est.CalldataGas), baseFee), est.CalldataCost)
+	require.Equal(t, 1, est.NumBlobs)
+	require.Equal(t, uint64(blobGasPerBlob), est.BlobGas)
+
+	est, err = estimateTxCost(make([]byte, maxBlobDataSize+1), baseFee)
+	require.NoError(t, err)
+	require.Equal(t, 2, est.NumBlobs)
+	require.Equal(t, uint64(2*blobGasPerBlob), est.BlobGas)
+}
@@ -1,9 +1,14 @@
 package flags
 
 import (
+	"fmt"
+	"strings"
+	"time"
+
 	"github.com/urfave/cli"
 
 	"github.com/kroma-network/kroma/components/batcher/rpc"
+	"github.com/kroma-network/kroma/components/node/rollup/derive"
 	kservice "github.com/kroma-network/kroma/utils/service"
 	klog "github.com/kroma-network/kroma/utils/service/log"
 	kmetrics "github.com/kroma-network/kroma/utils/service/metrics"
@@ -83,8 +88,111 @@ var (
 		Value:  1.0,
 		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "APPROX_COMPR_RATIO"),
 	}
+	CompressionAlgoFlag = cli.GenericFlag{
+		Name: "compression-algo",
+		Usage: "The compression algorithm of the channel data. Zstd can only be used once the zstd upgrade is active. Valid options: " +
+			enumValues(derive.CompressionAlgos),
+		Value: func() *derive.CompressionAlgo {
+			out := derive.Zlib
+			return &out
+		}(),
+		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "COMPRESSION_ALGO"),
+	}
+	CompressionLevelFlag = cli.IntFlag{
+		Name:   "compression-level",
+		Usage:  "The compression level specific to the compression algorithm. 0 selects the best compression level.",
+		Value:  0,
+		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "COMPRESSION_LEVEL"),
+	}
+	SpanBatchFlag = cli.BoolFlag{
+		Name:   "span-batch",
+		Usage:  "Encode the blocks of a channel into a single span batch. Can only be used once the span batch upgrade is active.",
+		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "SPAN_BATCH"),
+	}
+	AutoTuneMaxNumFramesFlag = cli.IntFlag{
+		Name: "auto-tune.max-num-frames",
+		Usage: "Auto-tunes the target number of frames of new channels between 1 and this number, " +
+			"based on the L1 basefee and the inclusion delay of recent channels. 0 disables the auto-tuning.",
+		Value:  0,
+		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "AUTO_TUNE_MAX_NUM_FRAMES"),
+	}
+	AutoTuneTargetInclusionDelayFlag = cli.DurationFlag{
+		Name:   "auto-tune.target-inclusion-delay",
+		Usage:  "Target delay from an L2 block to the L1 inclusion of its channel. The auto-tuning shrinks new channels while it is exceeded. 0 disables the target.",
+		Value:  10 * time.Minute,
+		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "AUTO_TUNE_TARGET_INCLUSION_DELAY"),
+	}
+	ChannelStateFileFlag = cli.StringFlag{
+		Name:   "channel-state-file",
+		Usage:  "File to persist the buffered L2 blocks and unsubmitted channel frames in across restarts. Not persisted if empty.",
+		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "CHANNEL_STATE_FILE"),
+	}
+	ShutdownTimeoutFlag = cli.DurationFlag{
+		Name: "shutdown-timeout",
+		Usage: "Max duration to submit the remaining L2 blocks and wait for their confirmations on shutdown. " +
+			"The remaining state is persisted afterwards, if a channel state file is set.",
+		Value:  5 * time.Minute,
+		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "SHUTDOWN_TIMEOUT"),
+	}
+	DryRunFlag = cli.BoolFlag{
+		Name: "dry-run",
+		Usage: "Build channels from the L2 blocks without submitting batcher transactions, " +
+			"only reporting the projected calldata and blob sizes and costs of the transactions.",
+		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "DRY_RUN"),
+	}
+	ThrottleMaxPendingTxsFlag = cli.Uint64Flag{
+		Name:   "throttle.max-pending-txs",
+		Usage:  "Maximum number of pending batcher transactions in the L1 mempool to submit new transactions. 0 disables the check.",
+		Value:  0,
+		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "THROTTLE_MAX_PENDING_TXS"),
+	}
+	ThrottleMaxBaseFeeGweiFlag = cli.Uint64Flag{
+		Name:   "throttle.max-basefee-gwei",
+		Usage:  "Maximum L1 basefee in gwei to submit transactions at. 0 disables the check.",
+		Value:  0,
+		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "THROTTLE_MAX_BASEFEE_GWEI"),
+	}
+	ExtraPrivateKeysFlag = cli.StringSliceFlag{
+		Name: "extra-private-keys",
+		Usage: "Private keys of extra sender accounts to submit batcher transactions in parallel with the main account, " +
+			"assigned in round-robin. The accounts must be extra batcher addresses of the rollup config.",
+		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "EXTRA_PRIVATE_KEYS"),
+	}
+	DataAvailabilityTypeFlag = cli.GenericFlag{
+		Name: "data-availability-type",
+		Usage: "The data availability type to use for submitting batches to the L1. Valid options: " +
+			enumValues(DataAvailabilityTypes),
+		Value: func() *DataAvailabilityType {
+			out := CalldataType
+			return &out
+		}(),
+		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "DATA_AVAILABILITY_TYPE"),
+	}
+	AltDAProviderFlag = cli.GenericFlag{
+		Name: "altda.provider",
+		Usage: "The external DA layer to store the channel frames on with the altda data availability type. Valid options: " +
+			enumValues(DAProviderTypes),
+		Value: func() *DAProviderType {
+			out := CelestiaProvider
+			return &out
+		}(),
+		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "ALTDA_PROVIDER"),
+	}
+	AltDAServerFlag = cli.StringFlag{
+		Name:   "altda.server",
+		Usage:  "HTTP address of the DA server of the external DA layer. Required with the altda data availability type.",
+		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "ALTDA_SERVER"),
+	}
 )
 
+func enumValues[T fmt.Stringer](values []T) string {
+	var out []string
+	for _, v := range values {
+		out = append(out, v.String())
+	}
+	return strings.Join(out, ", ")
+}
+
 var requiredFlags = []cli.Flag{
 	L1EthRpcFlag,
 	L2EthRpcFlag,
@@ -99,6 +207,20 @@ var optionalFlags = []cli.Flag{
 	TargetL1TxSizeBytesFlag,
 	TargetNumFramesFlag,
 	ApproxComprRatioFlag,
+	CompressionAlgoFlag,
+	CompressionLevelFlag,
+	SpanBatchFlag,
+	AutoTuneMaxNumFramesFlag,
+	AutoTuneTargetInclusionDelayFlag,
+	ChannelStateFileFlag,
+	ShutdownTimeoutFlag,
+	DryRunFlag,
+	ThrottleMaxPendingTxsFlag,
+	ThrottleMaxBaseFeeGweiFlag,
+	ExtraPrivateKeysFlag,
+	DataAvailabilityTypeFlag,
+	AltDAProviderFlag,
+	AltDAServerFlag,
 }
 
 func init() {
@@ -0,0 +1,79 @@
+package flags
+
+import "fmt"
+
+type DataAvailabilityType string
+
+const (
+	// CalldataType submits the channel frames as calldata of the batcher transactions.
+	CalldataType DataAvailabilityType = "calldata"
+	// BlobsType submits the channel frames as EIP-4844 blobs of the batcher transactions.
+	BlobsType DataAvailabilityType = "blobs"
+	// AutoType submits the channel frames either as calldata or as blobs, whichever is cheaper at the time of submission.
+	AutoType DataAvailabilityType = "auto"
+	// AltDAType submits the channel frames to an external DA layer, and only the commitments to them as calldata.
+	AltDAType DataAvailabilityType = "altda"
+)
+
+var DataAvailabilityTypes = []DataAvailabilityType{
+	CalldataType,
+	BlobsType,
+	AutoType,
+	AltDAType,
+}
+
+func (kind DataAvailabilityType) String() string {
+	return string(kind)
+}
+
+func (kind *DataAvailabilityType) Set(value string) error {
+	if !ValidDataAvailabilityType(DataAvailabilityType(value)) {
+		return fmt.Errorf("unknown data-availability type: %q", value)
+	}
+	*kind = DataAvailabilityType(value)
+	return nil
+}
+
+func ValidDataAvailabilityType(value DataAvailabilityType) bool {
+	for _, k := range DataAvailabilityTypes {
+		if k == value {
+			return true
+		}
+	}
+	return false
+}
+
+type DAProviderType string
+
+const (
+	// CelestiaProvider stores the channel frames on Celestia through a Celestia DA server.
+	CelestiaProvider DAProviderType = "celestia"
+	// EigenDAProvider stores the channel frames on EigenDA through an EigenDA DA server.
+	EigenDAProvider DAProviderType = "eigenda"
+)
+
+var DAProviderTypes = []DAProviderType{
+	CelestiaProvider,
+	EigenDAProvider,
+}
+
+func (kind DAProviderType) String() string {
+	return string(kind)
+}
+
+func (kind *DAProviderType) Set(value string) error {
+	if !ValidDAProviderType(DAProviderType(value)) {
+		return fmt.Errorf("unknown DA provider type: %q", value)
+	}
+	*kind = DAProviderType(value)
+	return nil
+}
+
+func ValidDAProviderType(value DAProviderType) bool {
+	for _, k := range DAProviderTypes {
+		if k == value {
+			return true
+		}
+	}
+	return false
+}
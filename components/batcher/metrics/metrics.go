@@ -2,10 +2,13 @@ package metrics
 
 import (
 	"context"
+	"math/big"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/params"
 	"github.com/prometheus/client_golang/prometheus"
 
 	"github.com/kroma-network/kroma/components/node/eth"
@@ -33,11 +36,19 @@ type Metricer interface {
 	RecordChannelClosed(id derive.ChannelID, numPendingBlocks int, numFrames int, inputBytes int, outputComprBytes int, reason error)
 	RecordChannelFullySubmitted(id derive.ChannelID)
 	RecordChannelTimedOut(id derive.ChannelID)
+	RecordChannelUtilization(numBlocks int, outputBytes int, targetOutputBytes int)
+	RecordL2BlockInclusionDelay(delay time.Duration)
 
+	RecordBatchTxFrames(numFrames int, dataBytes int, maxDataBytes int)
 	RecordBatchTxSubmitted()
 	RecordBatchTxSuccess()
 	RecordBatchTxFailed()
 
+	RecordSubmissionDeferred()
+	RecordSubmissionResumed(deferral time.Duration)
+
+	RecordDryRunTx(calldataBytes int, calldataGas uint64, calldataCost *big.Int, numBlobs int)
+
 	Document() []kmetrics.DocumentedMetric
 }
 
@@ -65,8 +76,22 @@ type Metrics struct {
 	ChannelNumFrames       prometheus.Gauge
 	ChannelComprRatio      prometheus.Histogram
 	ChannelComprRatioValue prometheus.Gauge
-
-	BatcherTxEvs kmetrics.EventVec
+	ChannelFillRatio       prometheus.Histogram
+	ChannelBytesPerBlock   prometheus.Histogram
+	L2BlockInclusionDelay  prometheus.Histogram
+
+	BatcherTxEvs       kmetrics.EventVec
+	BatcherTxNumFrames prometheus.Histogram
+	BatcherTxFillRatio prometheus.Histogram
+
+	SubmissionDeferred         prometheus.Gauge
+	SubmissionDeferralDuration prometheus.Histogram
+
+	DryRunTxs           prometheus.Counter
+	DryRunCalldataBytes prometheus.Counter
+	DryRunCalldataGas   prometheus.Counter
+	DryRunCalldataCost  prometheus.Counter
+	DryRunBlobs         prometheus.Counter
 }
 
 var _ Metricer = (*Metrics)(nil)
@@ -150,8 +175,76 @@ func NewMetrics(procName string) *Metrics {
 			Name:      "channel_compr_ratio_value",
 			Help:      "Compression ratios of closed channel.",
 		}),
+		ChannelFillRatio: factory.NewHistogram(prometheus.HistogramOpts{
+			Namespace: ns,
+			Name:      "channel_fill_ratio",
+			Help:      "Ratios of the compressed output bytes of closed channel to the target output bytes.",
+			Buckets:   prometheus.LinearBuckets(0.1, 0.1, 12),
+		}),
+		ChannelBytesPerBlock: factory.NewHistogram(prometheus.HistogramOpts{
+			Namespace: ns,
+			Name:      "channel_bytes_per_block",
+			Help:      "Compressed output bytes per L2 block of closed channel.",
+			Buckets:   prometheus.ExponentialBuckets(64, 2, 12),
+		}),
+		L2BlockInclusionDelay: factory.NewHistogram(prometheus.HistogramOpts{
+			Namespace: ns,
+			Name:      "l2_block_inclusion_delay_seconds",
+			Help:      "Delays from the L2 block timestamp to the L1 inclusion of the channel containing the block.",
+			Buckets:   prometheus.ExponentialBuckets(12, 2, 10),
+		}),
 
 		BatcherTxEvs: kmetrics.NewEventVec(factory, ns, "batcher_tx", "BatcherTx", []string{"stage"}),
+		BatcherTxNumFrames: factory.NewHistogram(prometheus.HistogramOpts{
+			Namespace: ns,
+			Name:      "batcher_tx_num_frames",
+			Help:      "Number of frames per submitted batcher tx.",
+			Buckets:   prometheus.LinearBuckets(1, 1, 6),
+		}),
+		BatcherTxFillRatio: factory.NewHistogram(prometheus.HistogramOpts{
+			Namespace: ns,
+			Name:      "batcher_tx_fill_ratio",
+			Help:      "Ratios of the frame data bytes of submitted batcher tx to the max frame data bytes.",
+			Buckets:   prometheus.LinearBuckets(0.1, 0.1, 10),
+		}),
+
+		SubmissionDeferred: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: ns,
+			Name:      "submission_deferred",
+			Help:      "1 if the submission of batcher txs is deferred because of L1 congestion, 0 otherwise.",
+		}),
+		SubmissionDeferralDuration: factory.NewHistogram(prometheus.HistogramOpts{
+			Namespace: ns,
+			Name:      "submission_deferral_seconds",
+			Help:      "Durations the submission of batcher txs was deferred because of L1 congestion.",
+			Buckets:   []float64{12, 60, 300, 900, 1800, 3600, 7200, 14400},
+		}),
+
+		DryRunTxs: factory.NewCounter(prometheus.CounterOpts{
+			Namespace: ns,
+			Name:      "dry_run_txs_total",
+			Help:      "Number of batcher txs built in dry-run mode.",
+		}),
+		DryRunCalldataBytes: factory.NewCounter(prometheus.CounterOpts{
+			Namespace: ns,
+			Name:      "dry_run_calldata_bytes_total",
+			Help:      "Projected calldata bytes of the batcher txs built in dry-run mode.",
+		}),
+		DryRunCalldataGas: factory.NewCounter(prometheus.CounterOpts{
+			Namespace: ns,
+			Name:      "dry_run_calldata_gas_total",
+			Help:      "Projected intrinsic gas of the batcher txs built in dry-run mode, submitted as calldata.",
+		}),
+		DryRunCalldataCost: factory.NewCounter(prometheus.CounterOpts{
+			Namespace: ns,
+			Name:      "dry_run_calldata_cost_gwei_total",
+			Help:      "Projected cost in gwei at the L1 basefee of the batcher txs built in dry-run mode, submitted as calldata.",
+		}),
+		DryRunBlobs: factory.NewCounter(prometheus.CounterOpts{
+			Namespace: ns,
+			Name:      "dry_run_blobs_total",
+			Help:      "Projected number of blobs of the batcher txs built in dry-run mode, submitted as blobs.",
+		}),
 	}
 }
 
@@ -250,6 +343,30 @@ func (m *Metrics) RecordChannelTimedOut(id derive.ChannelID) {
 	m.ChannelEvs.Record(StageTimedOut)
 }
 
+// RecordChannelUtilization should be called when a channel got closed, with the number of blocks
+// and the compressed output bytes of the channel.
+func (m *Metrics) RecordChannelUtilization(numBlocks int, outputBytes int, targetOutputBytes int) {
+	if targetOutputBytes > 0 {
+		m.ChannelFillRatio.Observe(float64(outputBytes) / float64(targetOutputBytes))
+	}
+	if numBlocks > 0 {
+		m.ChannelBytesPerBlock.Observe(float64(outputBytes) / float64(numBlocks))
+	}
+}
+
+// RecordL2BlockInclusionDelay should be called for each L2 block of a channel once it is fully submitted to L1.
+func (m *Metrics) RecordL2BlockInclusionDelay(delay time.Duration) {
+	m.L2BlockInclusionDelay.Observe(delay.Seconds())
+}
+
+// RecordBatchTxFrames should be called when a batcher tx got confirmed, with the frames data bytes of the tx.
+func (m *Metrics) RecordBatchTxFrames(numFrames int, dataBytes int, maxDataBytes int) {
+	m.BatcherTxNumFrames.Observe(float64(numFrames))
+	if maxDataBytes > 0 {
+		m.BatcherTxFillRatio.Observe(float64(dataBytes) / float64(maxDataBytes))
+	}
+}
+
 func (m *Metrics) RecordBatchTxSubmitted() {
 	m.BatcherTxEvs.Record(TxStageSubmitted)
 }
@@ -261,3 +378,22 @@ func (m *Metrics) RecordBatchTxSuccess() {
 func (m *Metrics) RecordBatchTxFailed() {
 	m.BatcherTxEvs.Record(TxStageFailed)
 }
+
+func (m *Metrics) RecordSubmissionDeferred() {
+	m.SubmissionDeferred.Set(1)
+}
+
+func (m *Metrics) RecordSubmissionResumed(deferral time.Duration) {
+	m.SubmissionDeferred.Set(0)
+	m.SubmissionDeferralDuration.Observe(deferral.Seconds())
+}
+
+// RecordDryRunTx should be called for each batcher tx built in dry-run mode, with its projected size and cost.
+func (m *Metrics) RecordDryRunTx(calldataBytes int, calldataGas uint64, calldataCost *big.Int, numBlobs int) {
+	m.DryRunTxs.Inc()
+	m.DryRunCalldataBytes.Add(float64(calldataBytes))
+	m.DryRunCalldataGas.Add(float64(calldataGas))
+	costGwei, _ := new(big.Float).Quo(new(big.Float).SetInt(calldataCost), big.NewFloat(params.GWei)).Float64()
+	m.DryRunCalldataCost.Add(costGwei)
+	m.DryRunBlobs.Add(float64(numBlobs))
+}
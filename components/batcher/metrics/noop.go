@@ -1,6 +1,9 @@
 package metrics
 
 import (
+	"math/big"
+	"time"
+
 	"github.com/kroma-network/kroma/components/node/eth"
 	"github.com/kroma-network/kroma/components/node/rollup/derive"
 	kmetrics "github.com/kroma-network/kroma/utils/service/metrics"
@@ -28,7 +31,16 @@ func (*noopMetrics) RecordChannelClosed(derive.ChannelID, int, int, int, int, er
 
 func (*noopMetrics) RecordChannelFullySubmitted(derive.ChannelID) {}
 func (*noopMetrics) RecordChannelTimedOut(derive.ChannelID)       {}
+func (*noopMetrics) RecordChannelUtilization(int, int, int)       {}
+func (*noopMetrics) RecordL2BlockInclusionDelay(time.Duration)    {}
+
+func (*noopMetrics) RecordBatchTxFrames(int, int, int) {}
 
 func (*noopMetrics) RecordBatchTxSubmitted() {}
 func (*noopMetrics) RecordBatchTxSuccess()   {}
 func (*noopMetrics) RecordBatchTxFailed()    {}
+
+func (*noopMetrics) RecordSubmissionDeferred()             {}
+func (*noopMetrics) RecordSubmissionResumed(time.Duration) {}
+
+func (*noopMetrics) RecordDryRunTx(int, uint64, *big.Int, int) {}
@@ -2,11 +2,33 @@ package rpc
 
 import (
 	"context"
+
+	"github.com/kroma-network/kroma/components/node/eth"
 )
 
+// BatcherStatus reports the batch submission progress of the batcher.
+type BatcherStatus struct {
+	// Paused is true if the batch submission is paused.
+	Paused bool `json:"paused"`
+	// PendingBlocks is the number of L2 blocks that are not added to a channel yet.
+	PendingBlocks int `json:"pending_blocks"`
+	// PendingFrames is the number of frames that are waiting to be submitted.
+	PendingFrames int `json:"pending_frames"`
+	// PendingBytes is the total size of the frames that are waiting to be submitted.
+	PendingBytes int `json:"pending_bytes"`
+	// PendingTxs is the number of submitted batcher transactions that are not confirmed yet.
+	PendingTxs int `json:"pending_txs"`
+	// OldestUnsubmittedBlock is the oldest L2 block of which the batch is not fully submitted yet, if any.
+	OldestUnsubmittedBlock *eth.BlockID `json:"oldest_unsubmitted_block,omitempty"`
+}
+
 type batcherClient interface {
 	Start() error
 	Stop(ctx context.Context) error
+	Pause() error
+	Resume() error
+	Flush(ctx context.Context) error
+	Status() *BatcherStatus
 }
 
 type adminAPI struct {
@@ -26,3 +48,24 @@ func (a *adminAPI) StartBatcher(_ context.Context) error {
 func (a *adminAPI) StopBatcher(ctx context.Context) error {
 	return a.b.Stop(ctx)
 }
+
+// PauseBatcher pauses the submission of batcher transactions, e.g. during an L1 incident.
+// L2 blocks are still loaded, to be submitted once the batcher is resumed.
+func (a *adminAPI) PauseBatcher(_ context.Context) error {
+	return a.b.Pause()
+}
+
+// ResumeBatcher resumes the submission of batcher transactions after a pause.
+func (a *adminAPI) ResumeBatcher(_ context.Context) error {
+	return a.b.Resume()
+}
+
+// FlushBatcher closes the current channel with all loaded L2 blocks, so that its frames are submitted right away.
+func (a *adminAPI) FlushBatcher(ctx context.Context) error {
+	return a.b.Flush(ctx)
+}
+
+// Status returns the batch submission progress of the batcher.
+func (a *adminAPI) Status(_ context.Context) (*BatcherStatus, error) {
+	return a.b.Status(), nil
+}
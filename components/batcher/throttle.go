@@ -0,0 +1,110 @@
+package batcher
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/params"
+
+	"github.com/kroma-network/kroma/components/node/eth"
+)
+
+// ThrottleConfig configures when the batcher backs off from submitting transactions,
+// to not stack transactions and replacements in the L1 mempool during congestion.
+type ThrottleConfig struct {
+	// MaxPendingTxs is the maximum number of pending batcher transactions in the L1 mempool, per sender account.
+	// No new transactions are submitted while it is exceeded. 0 disables the check.
+	MaxPendingTxs uint64
+	// MaxBaseFee is the maximum L1 basefee to submit transactions at. Nil disables the check.
+	// Blob fees are not considered, as the batcher does not submit blobs.
+	MaxBaseFee *big.Int
+}
+
+// NewThrottleConfig creates a ThrottleConfig with the given max basefee in gwei, 0 disables the basefee check.
+func NewThrottleConfig(maxPendingTxs uint64, maxBaseFeeGwei uint64) ThrottleConfig {
+	cfg := ThrottleConfig{MaxPendingTxs: maxPendingTxs}
+	if maxBaseFeeGwei != 0 {
+		cfg.MaxBaseFee = new(big.Int).Mul(new(big.Int).SetUint64(maxBaseFeeGwei), big.NewInt(params.GWei))
+	}
+	return cfg
+}
+
+// checkThrottle returns an error with the reason to back off from submitting transactions,
+// if the L1 is too congested for the throttle configuration.
+func (b *Batcher) checkThrottle(ctx context.Context, l1tip eth.L1BlockRef) error {
+	cfg := b.cfg.Throttle
+	if cfg.MaxBaseFee != nil {
+		tctx, cancel := context.WithTimeout(ctx, b.cfg.NetworkTimeout)
+		defer cancel()
+		head, err := b.cfg.L1Client.HeaderByHash(tctx, l1tip.Hash)
+		if err != nil {
+			return fmt.Errorf("failed to get L1 basefee: %w", err)
+		}
+		if head.BaseFee != nil && head.BaseFee.Cmp(cfg.MaxBaseFee) > 0 {
+			return fmt.Errorf("L1 basefee %s exceeds max basefee %s", head.BaseFee, cfg.MaxBaseFee)
+		}
+	}
+	if cfg.MaxPendingTxs != 0 {
+		for _, sender := range b.senders {
+			if err := b.checkPendingTxs(ctx, sender.From(), cfg.MaxPendingTxs); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// checkPendingTxs returns an error if the account has more pending transactions than the given max.
+func (b *Batcher) checkPendingTxs(ctx context.Context, from common.Address, maxPendingTxs uint64) error {
+	tctx, cancel := context.WithTimeout(ctx, b.cfg.NetworkTimeout)
+	defer cancel()
+	pendingNonce, err := b.cfg.L1Client.PendingNonceAt(tctx, from)
+	if err != nil {
+		return fmt.Errorf("failed to get pending nonce of %s: %w", from, err)
+	}
+	nonce, err := b.cfg.L1Client.NonceAt(tctx, from, nil)
+	if err != nil {
+		return fmt.Errorf("failed to get nonce of %s: %w", from, err)
+	}
+	if pendingNonce > nonce && pendingNonce-nonce > maxPendingTxs {
+		return fmt.Errorf("%d pending transactions of %s exceed max pending transactions %d", pendingNonce-nonce, from, maxPendingTxs)
+	}
+	return nil
+}
+
+// proposerWindowLeft returns the number of L1 blocks left until the proposer window of the oldest
+// unsubmitted L2 block expires. It returns false if there is no unsubmitted L2 block.
+func (b *Batcher) proposerWindowLeft(l1tip eth.L1BlockRef) (uint64, bool) {
+	origin, ok := b.batchSubmitter.state.OldestL1Origin()
+	if !ok {
+		return 0, false
+	}
+	end := origin + b.cfg.Rollup.ProposerWindowSize
+	if end <= l1tip.Number {
+		return 0, true
+	}
+	return end - l1tip.Number, true
+}
+
+// deferSubmission records that the submission of transactions is deferred for the given reason.
+func (b *Batcher) deferSubmission(reason error, windowLeft uint64) {
+	if b.deferredSince.IsZero() {
+		b.deferredSince = time.Now()
+		b.metr.RecordSubmissionDeferred()
+	}
+	b.l.Warn("Deferring batcher tx submission", "reason", reason, "deferred_for", time.Since(b.deferredSince), "window_left", windowLeft)
+}
+
+// resumeSubmission records that the submission of transactions is resumed, if it was deferred.
+func (b *Batcher) resumeSubmission() {
+	if b.deferredSince.IsZero() {
+		return
+	}
+	deferral := time.Since(b.deferredSince)
+	b.deferredSince = time.Time{}
+	b.metr.RecordSubmissionResumed(deferral)
+	b.l.Info("Resuming batcher tx submission", "deferred_for", deferral)
+}
@@ -0,0 +1,43 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/urfave/cli"
+
+	"github.com/kroma-network/kroma/components/indexer"
+	"github.com/kroma-network/kroma/components/indexer/flags"
+	klog "github.com/kroma-network/kroma/utils/service/log"
+)
+
+var (
+	Version = ""
+	Meta    = ""
+)
+
+func main() {
+	klog.SetupDefaults()
+
+	app := cli.NewApp()
+	app.Flags = flags.Flags
+	app.Version = fmt.Sprintf("%s-%s", Version, Meta)
+	app.Name = "kroma-indexer"
+	app.Usage = "Indexer Service"
+	app.Description = "Service for indexing the deposits and withdrawals between L1 and L2, and serving their statuses."
+
+	app.Action = curryMain(Version)
+	err := app.Run(os.Args)
+	if err != nil {
+		log.Crit("Application failed", "message", err)
+	}
+}
+
+// curryMain transforms the indexer.Main function into an app.Action
+// This is done to capture the Version of the indexer.
+func curryMain(version string) func(ctx *cli.Context) error {
+	return func(ctx *cli.Context) error {
+		return indexer.Main(version, ctx)
+	}
+}
@@ -0,0 +1,206 @@
+package indexer
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/urfave/cli"
+
+	"github.com/kroma-network/kroma/bindings/bindings"
+	"github.com/kroma-network/kroma/bindings/predeploys"
+	"github.com/kroma-network/kroma/components/indexer/flags"
+	"github.com/kroma-network/kroma/utils"
+	klog "github.com/kroma-network/kroma/utils/service/log"
+	kmetrics "github.com/kroma-network/kroma/utils/service/metrics"
+	kpprof "github.com/kroma-network/kroma/utils/service/pprof"
+	krpc "github.com/kroma-network/kroma/utils/service/rpc"
+)
+
+type Config struct {
+	L1Client      *ethclient.Client
+	L2Client      *ethclient.Client
+	Portal        *bindings.KromaPortalFilterer
+	MessagePasser *bindings.L2ToL1MessagePasserFilterer
+	DB            *DB
+
+	// FinalizationPeriod is the finalization period of the proven withdrawals in seconds, queried at startup.
+	FinalizationPeriod uint64
+
+	L1StartBlock    uint64
+	L2StartBlock    uint64
+	L1Confirmations uint64
+	L2Confirmations uint64
+	BlockRange      uint64
+	PollInterval    time.Duration
+}
+
+type CLIConfig struct {
+	// L1EthRpc is the HTTP provider URL for L1.
+	L1EthRpc string
+
+	// L2EthRpc is the HTTP provider URL for the L2 execution engine.
+	L2EthRpc string
+
+	// PortalAddress is the address of the KromaPortal contract.
+	PortalAddress string
+
+	// DBDriver is the database/sql driver of the database, sqlite3 or postgres.
+	DBDriver string
+
+	// DBDSN is the data source name of the database.
+	DBDSN string
+
+	// L1StartBlock is the L1 block to start indexing from if none is indexed yet.
+	L1StartBlock uint64
+
+	// L2StartBlock is the L2 block to start indexing from if none is indexed yet.
+	L2StartBlock uint64
+
+	// L1Confirmations is the number of confirmations of an L1 block to index its events.
+	L1Confirmations uint64
+
+	// L2Confirmations is the number of confirmations of an L2 block to index its events.
+	L2Confirmations uint64
+
+	// BlockRange is the max number of blocks to query the events of at once.
+	BlockRange uint64
+
+	// PollInterval is the delay between querying the new blocks to index.
+	PollInterval time.Duration
+
+	RPCConfig     krpc.CLIConfig
+	LogConfig     klog.CLIConfig
+	MetricsConfig kmetrics.CLIConfig
+	PprofConfig   kpprof.CLIConfig
+}
+
+func (c CLIConfig) Check() error {
+	if !common.IsHexAddress(c.PortalAddress) {
+		return fmt.Errorf("invalid portal address: %s", c.PortalAddress)
+	}
+	if !driverRegistered(c.DBDriver) {
+		return fmt.Errorf("unsupported database driver %q, available: %s", c.DBDriver, strings.Join(sql.Drivers(), ", "))
+	}
+	if c.BlockRange == 0 {
+		return errors.New("block range must be greater than 0")
+	}
+	if c.PollInterval <= 0 {
+		return errors.New("poll interval must be greater than 0")
+	}
+	if err := c.RPCConfig.Check(); err != nil {
+		return err
+	}
+	if err := c.LogConfig.Check(); err != nil {
+		return err
+	}
+	if err := c.MetricsConfig.Check(); err != nil {
+		return err
+	}
+	if err := c.PprofConfig.Check(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// driverRegistered returns true if the database driver is built into the binary.
+func driverRegistered(driver string) bool {
+	for _, d := range sql.Drivers() {
+		if d == driver {
+			return true
+		}
+	}
+	return false
+}
+
+// NewCLIConfig parses the CLIConfig from the provided flags or environment variables.
+func NewCLIConfig(ctx *cli.Context) CLIConfig {
+	return CLIConfig{
+		// Required Flags
+		L1EthRpc:      ctx.GlobalString(flags.L1EthRpcFlag.Name),
+		L2EthRpc:      ctx.GlobalString(flags.L2EthRpcFlag.Name),
+		PortalAddress: ctx.GlobalString(flags.PortalAddressFlag.Name),
+		DBDriver:      ctx.GlobalString(flags.DBDriverFlag.Name),
+		DBDSN:         ctx.GlobalString(flags.DBDSNFlag.Name),
+
+		// Optional Flags
+		L1StartBlock:    ctx.GlobalUint64(flags.L1StartBlockFlag.Name),
+		L2StartBlock:    ctx.GlobalUint64(flags.L2StartBlockFlag.Name),
+		L1Confirmations: ctx.GlobalUint64(flags.L1ConfirmationsFlag.Name),
+		L2Confirmations: ctx.GlobalUint64(flags.L2ConfirmationsFlag.Name),
+		BlockRange:      ctx.GlobalUint64(flags.BlockRangeFlag.Name),
+		PollInterval:    ctx.GlobalDuration(flags.PollIntervalFlag.Name),
+		RPCConfig:       krpc.ReadCLIConfig(ctx),
+		LogConfig:       klog.ReadCLIConfig(ctx),
+		MetricsConfig:   kmetrics.ReadCLIConfig(ctx),
+		PprofConfig:     kpprof.ReadCLIConfig(ctx),
+	}
+}
+
+// NewIndexerConfig creates an indexer config with given the CLIConfig
+func NewIndexerConfig(cfg CLIConfig) (*Config, error) {
+	ctx := context.Background()
+
+	l1Client, err := utils.DialEthClientWithTimeout(ctx, cfg.L1EthRpc)
+	if err != nil {
+		return nil, err
+	}
+
+	l2Client, err := utils.DialEthClientWithTimeout(ctx, cfg.L2EthRpc)
+	if err != nil {
+		return nil, err
+	}
+
+	portalAddr := common.HexToAddress(cfg.PortalAddress)
+	portal, err := bindings.NewKromaPortalCaller(portalAddr, l1Client)
+	if err != nil {
+		return nil, err
+	}
+	l2OutputOracleAddr, err := portal.L2ORACLE(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get L2OutputOracle address: %w", err)
+	}
+	l2OutputOracle, err := bindings.NewL2OutputOracleCaller(l2OutputOracleAddr, l1Client)
+	if err != nil {
+		return nil, err
+	}
+	finalizationPeriod, err := l2OutputOracle.FINALIZATIONPERIODSECONDS(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get finalization period: %w", err)
+	}
+
+	portalFilterer, err := bindings.NewKromaPortalFilterer(portalAddr, l1Client)
+	if err != nil {
+		return nil, err
+	}
+	messagePasser, err := bindings.NewL2ToL1MessagePasserFilterer(predeploys.L2ToL1MessagePasserAddr, l2Client)
+	if err != nil {
+		return nil, err
+	}
+
+	db, err := OpenDB(ctx, cfg.DBDriver, cfg.DBDSN)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Config{
+		L1Client:           l1Client,
+		L2Client:           l2Client,
+		Portal:             portalFilterer,
+		MessagePasser:      messagePasser,
+		DB:                 db,
+		FinalizationPeriod: finalizationPeriod.Uint64(),
+		L1StartBlock:       cfg.L1StartBlock,
+		L2StartBlock:       cfg.L2StartBlock,
+		L1Confirmations:    cfg.L1Confirmations,
+		L2Confirmations:    cfg.L2Confirmations,
+		BlockRange:         cfg.BlockRange,
+		PollInterval:       cfg.PollInterval,
+	}, nil
+}
@@ -0,0 +1,373 @@
+package indexer
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/kroma-network/kroma/components/indexer/rpc"
+)
+
+const (
+	chainL1 = "l1"
+	chainL2 = "l2"
+)
+
+// schema is written in the SQL dialect common to SQLite and Postgres, so that both can be used as the database.
+var schema = []string{
+	`CREATE TABLE IF NOT EXISTS checkpoints (
+		chain        TEXT PRIMARY KEY,
+		block_number BIGINT NOT NULL
+	)`,
+	`CREATE TABLE IF NOT EXISTS withdrawals (
+		withdrawal_hash        TEXT PRIMARY KEY,
+		nonce                  TEXT,
+		sender                 TEXT,
+		target                 TEXT,
+		value                  TEXT,
+		gas_limit              TEXT,
+		data                   TEXT,
+		initiated_tx_hash      TEXT,
+		initiated_block_number BIGINT,
+		initiated_at           BIGINT,
+		proven_tx_hash         TEXT,
+		proven_at              BIGINT,
+		finalized_tx_hash      TEXT,
+		finalized_at           BIGINT,
+		finalize_success       BOOLEAN
+	)`,
+	`CREATE INDEX IF NOT EXISTS withdrawals_sender ON withdrawals (sender, initiated_block_number)`,
+	`CREATE TABLE IF NOT EXISTS deposits (
+		l2_tx_hash      TEXT PRIMARY KEY,
+		from_address    TEXT NOT NULL,
+		to_address      TEXT,
+		mint            TEXT NOT NULL,
+		value           TEXT NOT NULL,
+		gas_limit       BIGINT NOT NULL,
+		is_creation     BOOLEAN NOT NULL,
+		data            TEXT NOT NULL,
+		l1_tx_hash      TEXT NOT NULL,
+		l1_log_index    BIGINT NOT NULL,
+		l1_block_number BIGINT NOT NULL,
+		deposited_at    BIGINT NOT NULL
+	)`,
+	`CREATE INDEX IF NOT EXISTS deposits_from_address ON deposits (from_address, l1_block_number)`,
+	`CREATE INDEX IF NOT EXISTS deposits_l1_tx_hash ON deposits (l1_tx_hash)`,
+}
+
+// DB stores the indexed messages in a SQL database. The driver of the database must be registered
+// to database/sql, see the drivers_*.go files.
+type DB struct {
+	db *sql.DB
+}
+
+// OpenDB opens the database with the given driver and data source name, and creates the tables if not exist.
+func OpenDB(ctx context.Context, driver string, dsn string) (*DB, error) {
+	db, err := sql.Open(driver, dsn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open %s database: %w", driver, err)
+	}
+	if err := db.PingContext(ctx); err != nil {
+		_ = db.Close()
+		return nil, fmt.Errorf("failed to connect to %s database: %w", driver, err)
+	}
+	for _, stmt := range schema {
+		if _, err := db.ExecContext(ctx, stmt); err != nil {
+			_ = db.Close()
+			return nil, fmt.Errorf("failed to create schema: %w", err)
+		}
+	}
+	return &DB{db: db}, nil
+}
+
+func (d *DB) Close() error {
+	return d.db.Close()
+}
+
+// Checkpoint returns the last block of the chain of which the events are indexed, false if none is indexed.
+func (d *DB) Checkpoint(ctx context.Context, chain string) (uint64, bool, error) {
+	var number int64
+	err := d.db.QueryRowContext(ctx, `SELECT block_number FROM checkpoints WHERE chain = $1`, chain).Scan(&number)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, false, nil
+	} else if err != nil {
+		return 0, false, err
+	}
+	return uint64(number), true, nil
+}
+
+// Update runs the writes in a single transaction, so that the events of a block range and
+// the checkpoint of the range are stored together.
+func (d *DB) Update(ctx context.Context, fn func(tx *dbTx) error) error {
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	if err := fn(&dbTx{ctx: ctx, tx: tx}); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+type dbTx struct {
+	ctx context.Context
+	tx  *sql.Tx
+}
+
+func (t *dbTx) setCheckpoint(chain string, number uint64) error {
+	_, err := t.tx.ExecContext(t.ctx,
+		`INSERT INTO checkpoints (chain, block_number) VALUES ($1, $2)
+		ON CONFLICT (chain) DO UPDATE SET block_number = excluded.block_number`,
+		chain, int64(number))
+	return err
+}
+
+// The withdrawal events are indexed from L1 and L2 independently, so that a withdrawal can be proven
+// before its initiation is indexed. Each event upserts its own columns only.
+
+func (t *dbTx) withdrawalInitiated(w *rpc.Withdrawal) error {
+	_, err := t.tx.ExecContext(t.ctx,
+		`INSERT INTO withdrawals (withdrawal_hash, nonce, sender, target, value, gas_limit, data,
+			initiated_tx_hash, initiated_block_number, initiated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		ON CONFLICT (withdrawal_hash) DO UPDATE SET
+			nonce = excluded.nonce, sender = excluded.sender, target = excluded.target, value = excluded.value,
+			gas_limit = excluded.gas_limit, data = excluded.data, initiated_tx_hash = excluded.initiated_tx_hash,
+			initiated_block_number = excluded.initiated_block_number, initiated_at = excluded.initiated_at`,
+		w.WithdrawalHash.Hex(), bigToString(w.Nonce), w.Sender.Hex(), w.Target.Hex(), bigToString(w.Value),
+		bigToString(w.GasLimit), w.Data.String(), w.InitiatedTxHash.Hex(), int64(w.InitiatedBlockNumber),
+		int64(w.InitiatedAt))
+	return err
+}
+
+// withdrawalProven records the latest proof of the withdrawal, as a withdrawal can be proven again
+// if the output it is proven against is replaced.
+func (t *dbTx) withdrawalProven(withdrawalHash common.Hash, txHash common.Hash, provenAt uint64) error {
+	_, err := t.tx.ExecContext(t.ctx,
+		`INSERT INTO withdrawals (withdrawal_hash, proven_tx_hash, proven_at) VALUES ($1, $2, $3)
+		ON CONFLICT (withdrawal_hash) DO UPDATE SET
+			proven_tx_hash = excluded.proven_tx_hash, proven_at = excluded.proven_at`,
+		withdrawalHash.Hex(), txHash.Hex(), int64(provenAt))
+	return err
+}
+
+func (t *dbTx) withdrawalFinalized(withdrawalHash common.Hash, txHash common.Hash, finalizedAt uint64, success bool) error {
+	_, err := t.tx.ExecContext(t.ctx,
+		`INSERT INTO withdrawals (withdrawal_hash, finalized_tx_hash, finalized_at, finalize_success) VALUES ($1, $2, $3, $4)
+		ON CONFLICT (withdrawal_hash) DO UPDATE SET
+			finalized_tx_hash = excluded.finalized_tx_hash, finalized_at = excluded.finalized_at,
+			finalize_success = excluded.finalize_success`,
+		withdrawalHash.Hex(), txHash.Hex(), int64(finalizedAt), success)
+	return err
+}
+
+func (t *dbTx) deposit(dep *rpc.Deposit) error {
+	var to sql.NullString
+	if dep.To != nil {
+		to = sql.NullString{String: dep.To.Hex(), Valid: true}
+	}
+	_, err := t.tx.ExecContext(t.ctx,
+		`INSERT INTO deposits (l2_tx_hash, from_address, to_address, mint, value, gas_limit, is_creation, data,
+			l1_tx_hash, l1_log_index, l1_block_number, deposited_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+		ON CONFLICT (l2_tx_hash) DO NOTHING`,
+		dep.L2TxHash.Hex(), dep.From.Hex(), to, bigToString(dep.Mint), bigToString(dep.Value), int64(dep.GasLimit),
+		dep.IsCreation, dep.Data.String(), dep.L1TxHash.Hex(), int64(dep.L1LogIndex), int64(dep.BlockNumber),
+		int64(dep.DepositedAt))
+	return err
+}
+
+const withdrawalColumns = `withdrawal_hash, nonce, sender, target, value, gas_limit, data, initiated_tx_hash,
+	initiated_block_number, initiated_at, proven_tx_hash, proven_at, finalized_tx_hash, finalized_at, finalize_success`
+
+// Withdrawal returns the withdrawal of the given hash, or nil if it is not indexed.
+// The status of the withdrawal is not set.
+func (d *DB) Withdrawal(ctx context.Context, withdrawalHash common.Hash) (*rpc.Withdrawal, error) {
+	rows, err := d.db.QueryContext(ctx,
+		`SELECT `+withdrawalColumns+` FROM withdrawals WHERE withdrawal_hash = $1`, withdrawalHash.Hex())
+	if err != nil {
+		return nil, err
+	}
+	ws, err := scanWithdrawals(rows)
+	if err != nil || len(ws) == 0 {
+		return nil, err
+	}
+	return ws[0], nil
+}
+
+// WithdrawalsBySender returns the latest withdrawals initiated by the sender, the latest first.
+// The statuses of the withdrawals are not set.
+func (d *DB) WithdrawalsBySender(ctx context.Context, sender common.Address, limit int) ([]*rpc.Withdrawal, error) {
+	rows, err := d.db.QueryContext(ctx,
+		`SELECT `+withdrawalColumns+` FROM withdrawals WHERE sender = $1
+		ORDER BY initiated_block_number DESC LIMIT $2`, sender.Hex(), limit)
+	if err != nil {
+		return nil, err
+	}
+	return scanWithdrawals(rows)
+}
+
+func scanWithdrawals(rows *sql.Rows) ([]*rpc.Withdrawal, error) {
+	defer rows.Close()
+
+	var ws []*rpc.Withdrawal
+	for rows.Next() {
+		var (
+			hash                                               string
+			nonce, sender, target, value, gasLimit, data       sql.NullString
+			initiatedTxHash, provenTxHash, finalizedTxHash     sql.NullString
+			initiatedBlockNumber, initiatedAt, provenAt, finAt sql.NullInt64
+			finalizeSuccess                                    sql.NullBool
+		)
+		if err := rows.Scan(&hash, &nonce, &sender, &target, &value, &gasLimit, &data, &initiatedTxHash,
+			&initiatedBlockNumber, &initiatedAt, &provenTxHash, &provenAt, &finalizedTxHash, &finAt,
+			&finalizeSuccess); err != nil {
+			return nil, err
+		}
+
+		w := &rpc.Withdrawal{WithdrawalHash: common.HexToHash(hash)}
+		if initiatedTxHash.Valid {
+			var err error
+			if w.Nonce, err = stringToBig(nonce.String); err != nil {
+				return nil, err
+			}
+			if w.Value, err = stringToBig(value.String); err != nil {
+				return nil, err
+			}
+			if w.GasLimit, err = stringToBig(gasLimit.String); err != nil {
+				return nil, err
+			}
+			if w.Data, err = hexutil.Decode(data.String); err != nil {
+				return nil, fmt.Errorf("invalid withdrawal data: %w", err)
+			}
+			senderAddr, targetAddr := common.HexToAddress(sender.String), common.HexToAddress(target.String)
+			w.Sender, w.Target = &senderAddr, &targetAddr
+			w.InitiatedTxHash = nullHash(initiatedTxHash)
+			w.InitiatedBlockNumber = uint64(initiatedBlockNumber.Int64)
+			w.InitiatedAt = uint64(initiatedAt.Int64)
+		}
+		if provenTxHash.Valid {
+			w.ProvenTxHash = nullHash(provenTxHash)
+			w.ProvenAt = uint64(provenAt.Int64)
+		}
+		if finalizedTxHash.Valid {
+			w.FinalizedTxHash = nullHash(finalizedTxHash)
+			w.FinalizedAt = uint64(finAt.Int64)
+			w.FinalizeSuccess = finalizeSuccess.Bool
+		}
+		ws = append(ws, w)
+	}
+	return ws, rows.Err()
+}
+
+const depositColumns = `l2_tx_hash, from_address, to_address, mint, value, gas_limit, is_creation, data,
+	l1_tx_hash, l1_log_index, l1_block_number, deposited_at`
+
+// Deposit returns the deposit of the given L2 transaction hash, or nil if it is not indexed.
+func (d *DB) Deposit(ctx context.Context, l2TxHash common.Hash) (*rpc.Deposit, error) {
+	rows, err := d.db.QueryContext(ctx,
+		`SELECT `+depositColumns+` FROM deposits WHERE l2_tx_hash = $1`, l2TxHash.Hex())
+	if err != nil {
+		return nil, err
+	}
+	deps, err := scanDeposits(rows)
+	if err != nil || len(deps) == 0 {
+		return nil, err
+	}
+	return deps[0], nil
+}
+
+// DepositsByL1Tx returns the deposits included in the L1 transaction, in the order of the logs.
+func (d *DB) DepositsByL1Tx(ctx context.Context, l1TxHash common.Hash) ([]*rpc.Deposit, error) {
+	rows, err := d.db.QueryContext(ctx,
+		`SELECT `+depositColumns+` FROM deposits WHERE l1_tx_hash = $1 ORDER BY l1_log_index`, l1TxHash.Hex())
+	if err != nil {
+		return nil, err
+	}
+	return scanDeposits(rows)
+}
+
+// DepositsBySender returns the latest deposits from the address, the latest first.
+func (d *DB) DepositsBySender(ctx context.Context, from common.Address, limit int) ([]*rpc.Deposit, error) {
+	rows, err := d.db.QueryContext(ctx,
+		`SELECT `+depositColumns+` FROM deposits WHERE from_address = $1
+		ORDER BY l1_block_number DESC, l1_log_index DESC LIMIT $2`, from.Hex(), limit)
+	if err != nil {
+		return nil, err
+	}
+	return scanDeposits(rows)
+}
+
+func scanDeposits(rows *sql.Rows) ([]*rpc.Deposit, error) {
+	defer rows.Close()
+
+	var deps []*rpc.Deposit
+	for rows.Next() {
+		var (
+			l2TxHash, from, mint, value, data, l1TxHash  string
+			to                                           sql.NullString
+			gasLimit, logIndex, blockNumber, depositedAt int64
+			isCreation                                   bool
+		)
+		if err := rows.Scan(&l2TxHash, &from, &to, &mint, &value, &gasLimit, &isCreation, &data,
+			&l1TxHash, &logIndex, &blockNumber, &depositedAt); err != nil {
+			return nil, err
+		}
+
+		dep := &rpc.Deposit{
+			L2TxHash:    common.HexToHash(l2TxHash),
+			From:        common.HexToAddress(from),
+			GasLimit:    uint64(gasLimit),
+			IsCreation:  isCreation,
+			L1TxHash:    common.HexToHash(l1TxHash),
+			L1LogIndex:  uint64(logIndex),
+			BlockNumber: uint64(blockNumber),
+			DepositedAt: uint64(depositedAt),
+		}
+		if to.Valid {
+			toAddr := common.HexToAddress(to.String)
+			dep.To = &toAddr
+		}
+		var err error
+		if dep.Mint, err = stringToBig(mint); err != nil {
+			return nil, err
+		}
+		if dep.Value, err = stringToBig(value); err != nil {
+			return nil, err
+		}
+		if dep.Data, err = hexutil.Decode(data); err != nil {
+			return nil, fmt.Errorf("invalid deposit data: %w", err)
+		}
+		deps = append(deps, dep)
+	}
+	return deps, rows.Err()
+}
+
+// The uint256 values are stored as decimal strings, as they exceed the integer types of the databases.
+
+func bigToString(v *hexutil.Big) string {
+	if v == nil {
+		return "0"
+	}
+	return v.ToInt().String()
+}
+
+func stringToBig(s string) (*hexutil.Big, error) {
+	v, ok := new(big.Int).SetString(strings.TrimSpace(s), 10)
+	if !ok {
+		return nil, fmt.Errorf("invalid integer: %q", s)
+	}
+	return (*hexutil.Big)(v), nil
+}
+
+func nullHash(s sql.NullString) *common.Hash {
+	h := common.HexToHash(s.String)
+	return &h
+}
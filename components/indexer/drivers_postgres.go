@@ -0,0 +1,6 @@
+//go:build postgres
+
+package indexer
+
+// The Postgres driver is only built into the binary with the postgres build tag.
+import _ "github.com/lib/pq"
@@ -0,0 +1,6 @@
+//go:build sqlite
+
+package indexer
+
+// The SQLite driver requires cgo, so it is only built into the binary with the sqlite build tag.
+import _ "github.com/mattn/go-sqlite3"
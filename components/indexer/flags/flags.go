@@ -0,0 +1,119 @@
+package flags
+
+import (
+	"time"
+
+	"github.com/urfave/cli"
+
+	kservice "github.com/kroma-network/kroma/utils/service"
+	klog "github.com/kroma-network/kroma/utils/service/log"
+	kmetrics "github.com/kroma-network/kroma/utils/service/metrics"
+	kpprof "github.com/kroma-network/kroma/utils/service/pprof"
+	krpc "github.com/kroma-network/kroma/utils/service/rpc"
+)
+
+const envVarPrefix = "INDEXER"
+
+var (
+	// Required flags
+
+	L1EthRpcFlag = cli.StringFlag{
+		Name:     "l1-eth-rpc",
+		Usage:    "HTTP provider URL for L1",
+		Required: true,
+		EnvVar:   kservice.PrefixEnvVar(envVarPrefix, "L1_ETH_RPC"),
+	}
+	L2EthRpcFlag = cli.StringFlag{
+		Name:     "l2-eth-rpc",
+		Usage:    "HTTP provider URL for L2 execution engine",
+		Required: true,
+		EnvVar:   kservice.PrefixEnvVar(envVarPrefix, "L2_ETH_RPC"),
+	}
+	PortalAddressFlag = cli.StringFlag{
+		Name:     "portal-address",
+		Usage:    "Address of the KromaPortal contract",
+		Required: true,
+		EnvVar:   kservice.PrefixEnvVar(envVarPrefix, "PORTAL_ADDRESS"),
+	}
+	DBDriverFlag = cli.StringFlag{
+		Name:     "db.driver",
+		Usage:    "Driver of the database to store the indexed messages in, sqlite3 or postgres",
+		Required: true,
+		EnvVar:   kservice.PrefixEnvVar(envVarPrefix, "DB_DRIVER"),
+	}
+	DBDSNFlag = cli.StringFlag{
+		Name:     "db.dsn",
+		Usage:    "Data source name of the database, e.g. a file path for sqlite3 or a connection URL for postgres",
+		Required: true,
+		EnvVar:   kservice.PrefixEnvVar(envVarPrefix, "DB_DSN"),
+	}
+
+	// Optional flags
+
+	L1StartBlockFlag = cli.Uint64Flag{
+		Name:   "l1-start-block",
+		Usage:  "L1 block to start indexing from if none is indexed yet, e.g. the deployment block of the KromaPortal",
+		Value:  0,
+		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "L1_START_BLOCK"),
+	}
+	L2StartBlockFlag = cli.Uint64Flag{
+		Name:   "l2-start-block",
+		Usage:  "L2 block to start indexing from if none is indexed yet",
+		Value:  0,
+		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "L2_START_BLOCK"),
+	}
+	L1ConfirmationsFlag = cli.Uint64Flag{
+		Name:   "l1-confirmations",
+		Usage:  "Number of confirmations of an L1 block to index its events, to not index reorged events",
+		Value:  12,
+		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "L1_CONFIRMATIONS"),
+	}
+	L2ConfirmationsFlag = cli.Uint64Flag{
+		Name:   "l2-confirmations",
+		Usage:  "Number of confirmations of an L2 block to index its events, to not index reorged events",
+		Value:  64,
+		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "L2_CONFIRMATIONS"),
+	}
+	BlockRangeFlag = cli.Uint64Flag{
+		Name:   "block-range",
+		Usage:  "Max number of blocks to query the events of at once",
+		Value:  2000,
+		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "BLOCK_RANGE"),
+	}
+	PollIntervalFlag = cli.DurationFlag{
+		Name:   "poll-interval",
+		Usage:  "Delay between querying the new blocks to index",
+		Value:  12 * time.Second,
+		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "POLL_INTERVAL"),
+	}
+)
+
+var requiredFlags = []cli.Flag{
+	L1EthRpcFlag,
+	L2EthRpcFlag,
+	PortalAddressFlag,
+	DBDriverFlag,
+	DBDSNFlag,
+}
+
+var optionalFlags = []cli.Flag{
+	L1StartBlockFlag,
+	L2StartBlockFlag,
+	L1ConfirmationsFlag,
+	L2ConfirmationsFlag,
+	BlockRangeFlag,
+	PollIntervalFlag,
+}
+
+func init() {
+	requiredFlags = append(requiredFlags, krpc.CLIFlags(envVarPrefix)...)
+
+	optionalFlags = append(optionalFlags, klog.CLIFlags(envVarPrefix)...)
+	optionalFlags = append(optionalFlags, kmetrics.CLIFlags(envVarPrefix)...)
+	optionalFlags = append(optionalFlags, kpprof.CLIFlags(envVarPrefix)...)
+
+	Flags = append(requiredFlags, optionalFlags...)
+}
+
+// Flags contains the list of configuration options available to the binary.
+var Flags []cli.Flag
@@ -0,0 +1,429 @@
+package indexer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/log"
+	gethrpc "github.com/ethereum/go-ethereum/rpc"
+	"github.com/urfave/cli"
+
+	"github.com/kroma-network/kroma/components/indexer/metrics"
+	"github.com/kroma-network/kroma/components/indexer/rpc"
+	"github.com/kroma-network/kroma/components/node/rollup/derive"
+	"github.com/kroma-network/kroma/utils"
+	"github.com/kroma-network/kroma/utils/monitoring"
+	klog "github.com/kroma-network/kroma/utils/service/log"
+	krpc "github.com/kroma-network/kroma/utils/service/rpc"
+)
+
+// The message events that are indexed, the labels of the messages metric.
+const (
+	EventDeposited = "deposited"
+	EventInitiated = "initiated"
+	EventProven    = "proven"
+	EventFinalized = "finalized"
+)
+
+// Main is the entrypoint into the Indexer.
+func Main(version string, cliCtx *cli.Context) error {
+	cliCfg := NewCLIConfig(cliCtx)
+	if err := cliCfg.Check(); err != nil {
+		return fmt.Errorf("invalid CLI flags: %w", err)
+	}
+
+	l := klog.NewLogger(cliCfg.LogConfig)
+	m := metrics.NewMetrics("default")
+	l.Info("Initializing Indexer")
+
+	indexerCfg, err := NewIndexerConfig(cliCfg)
+	if err != nil {
+		l.Error("Unable to create indexer config", "err", err)
+		return err
+	}
+	defer indexerCfg.DB.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	monitoring.MaybeStartPprof(ctx, cliCfg.PprofConfig, l)
+	monitoring.MaybeStartMetrics(ctx, cliCfg.MetricsConfig, l, m, indexerCfg.L1Client, common.Address{})
+
+	indexer := NewIndexer(*indexerCfg, l, m)
+
+	server, err := monitoring.StartRPC(cliCfg.RPCConfig, version, krpc.WithLogger(l), krpc.WithAPIs([]gethrpc.API{{
+		Namespace: "indexer",
+		Service:   rpc.NewIndexerAPI(indexer),
+	}}))
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err = server.Stop(); err != nil {
+			l.Error("Error shutting down http server: %w", err)
+		}
+	}()
+
+	m.RecordInfo(version)
+	m.RecordUp()
+
+	indexer.Start()
+	<-utils.WaitInterrupt()
+	indexer.Stop()
+
+	return nil
+}
+
+// Indexer indexes the deposits and the withdrawals between L1 and L2, from the events of the KromaPortal
+// on L1 and of the L2ToL1MessagePasser on L2, and serves the statuses of the messages.
+type Indexer struct {
+	cfg  Config
+	l    log.Logger
+	metr metrics.Metricer
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func NewIndexer(cfg Config, l log.Logger, m metrics.Metricer) *Indexer {
+	return &Indexer{
+		cfg:  cfg,
+		l:    l,
+		metr: m,
+	}
+}
+
+func (i *Indexer) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	i.cancel = cancel
+
+	i.wg.Add(1)
+	go i.loop(ctx)
+}
+
+func (i *Indexer) Stop() {
+	i.cancel()
+	i.wg.Wait()
+}
+
+func (i *Indexer) loop(ctx context.Context) {
+	defer i.wg.Done()
+
+	ticker := time.NewTicker(i.cfg.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := i.sync(ctx, chainL1, i.cfg.L1Client, i.cfg.L1StartBlock, i.cfg.L1Confirmations, i.indexL1); err != nil && !errors.Is(err, context.Canceled) {
+			i.l.Error("Failed to index L1 events", "err", err)
+		}
+		if err := i.sync(ctx, chainL2, i.cfg.L2Client, i.cfg.L2StartBlock, i.cfg.L2Confirmations, i.indexL2); err != nil && !errors.Is(err, context.Canceled) {
+			i.l.Error("Failed to index L2 events", "err", err)
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// sync indexes the events of the chain from the last indexed block up to the confirmed head, range by range.
+func (i *Indexer) sync(ctx context.Context, chain string, client *ethclient.Client, startBlock uint64, confirmations uint64,
+	index func(ctx context.Context, from, to uint64) error,
+) error {
+	head, err := client.BlockNumber(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get head: %w", err)
+	}
+	if head < confirmations {
+		return nil
+	}
+	confirmed := head - confirmations
+
+	from := startBlock
+	last, ok, err := i.cfg.DB.Checkpoint(ctx, chain)
+	if err != nil {
+		return fmt.Errorf("failed to get checkpoint: %w", err)
+	}
+	if ok {
+		from = last + 1
+	}
+
+	for from <= confirmed {
+		to := from + i.cfg.BlockRange - 1
+		if to > confirmed {
+			to = confirmed
+		}
+		if err := index(ctx, from, to); err != nil {
+			return fmt.Errorf("failed to index blocks %d-%d: %w", from, to, err)
+		}
+		i.l.Debug("Indexed blocks", "chain", chain, "from", from, "to", to)
+		i.metr.RecordIndexedBlock(chain, to)
+		from = to + 1
+	}
+	return nil
+}
+
+// indexL1 indexes the deposits, and the proofs and the finalizations of the withdrawals in the L1 blocks.
+func (i *Indexer) indexL1(ctx context.Context, from, to uint64) error {
+	opts := &bind.FilterOpts{Start: from, End: &to, Context: ctx}
+	times := newBlockTimes(i.cfg.L1Client)
+
+	var deposits []*rpc.Deposit
+	depositIter, err := i.cfg.Portal.FilterTransactionDeposited(opts, nil, nil, nil)
+	if err != nil {
+		return fmt.Errorf("failed to filter deposits: %w", err)
+	}
+	for depositIter.Next() {
+		ev := depositIter.Event
+		dep, err := derive.UnmarshalDepositLogEvent(&ev.Raw)
+		if err != nil {
+			return fmt.Errorf("failed to parse deposit in tx %s: %w", ev.Raw.TxHash, err)
+		}
+		depositedAt, err := times.get(ctx, ev.Raw.BlockNumber)
+		if err != nil {
+			return err
+		}
+		deposits = append(deposits, toDeposit(dep, ev.Raw, depositedAt))
+	}
+	if err := depositIter.Error(); err != nil {
+		return fmt.Errorf("failed to filter deposits: %w", err)
+	}
+
+	type provenEvent struct {
+		withdrawalHash common.Hash
+		txHash         common.Hash
+		provenAt       uint64
+	}
+	var proven []provenEvent
+	provenIter, err := i.cfg.Portal.FilterWithdrawalProven(opts, nil, nil, nil)
+	if err != nil {
+		return fmt.Errorf("failed to filter proven withdrawals: %w", err)
+	}
+	for provenIter.Next() {
+		ev := provenIter.Event
+		provenAt, err := times.get(ctx, ev.Raw.BlockNumber)
+		if err != nil {
+			return err
+		}
+		proven = append(proven, provenEvent{ev.WithdrawalHash, ev.Raw.TxHash, provenAt})
+	}
+	if err := provenIter.Error(); err != nil {
+		return fmt.Errorf("failed to filter proven withdrawals: %w", err)
+	}
+
+	type finalizedEvent struct {
+		withdrawalHash common.Hash
+		txHash         common.Hash
+		finalizedAt    uint64
+		success        bool
+	}
+	var finalized []finalizedEvent
+	finalizedIter, err := i.cfg.Portal.FilterWithdrawalFinalized(opts, nil)
+	if err != nil {
+		return fmt.Errorf("failed to filter finalized withdrawals: %w", err)
+	}
+	for finalizedIter.Next() {
+		ev := finalizedIter.Event
+		finalizedAt, err := times.get(ctx, ev.Raw.BlockNumber)
+		if err != nil {
+			return err
+		}
+		finalized = append(finalized, finalizedEvent{ev.WithdrawalHash, ev.Raw.TxHash, finalizedAt, ev.Success})
+	}
+	if err := finalizedIter.Error(); err != nil {
+		return fmt.Errorf("failed to filter finalized withdrawals: %w", err)
+	}
+
+	err = i.cfg.DB.Update(ctx, func(tx *dbTx) error {
+		for _, dep := range deposits {
+			if err := tx.deposit(dep); err != nil {
+				return fmt.Errorf("failed to store deposit %s: %w", dep.L2TxHash, err)
+			}
+		}
+		for _, ev := range proven {
+			if err := tx.withdrawalProven(ev.withdrawalHash, ev.txHash, ev.provenAt); err != nil {
+				return fmt.Errorf("failed to store proven withdrawal %s: %w", ev.withdrawalHash, err)
+			}
+		}
+		for _, ev := range finalized {
+			if err := tx.withdrawalFinalized(ev.withdrawalHash, ev.txHash, ev.finalizedAt, ev.success); err != nil {
+				return fmt.Errorf("failed to store finalized withdrawal %s: %w", ev.withdrawalHash, err)
+			}
+		}
+		return tx.setCheckpoint(chainL1, to)
+	})
+	if err != nil {
+		return err
+	}
+
+	i.metr.RecordMessages(EventDeposited, len(deposits))
+	i.metr.RecordMessages(EventProven, len(proven))
+	i.metr.RecordMessages(EventFinalized, len(finalized))
+	return nil
+}
+
+// indexL2 indexes the initiations of the withdrawals in the L2 blocks.
+func (i *Indexer) indexL2(ctx context.Context, from, to uint64) error {
+	opts := &bind.FilterOpts{Start: from, End: &to, Context: ctx}
+	times := newBlockTimes(i.cfg.L2Client)
+
+	var initiated []*rpc.Withdrawal
+	iter, err := i.cfg.MessagePasser.FilterMessagePassed(opts, nil, nil, nil)
+	if err != nil {
+		return fmt.Errorf("failed to filter initiated withdrawals: %w", err)
+	}
+	for iter.Next() {
+		ev := iter.Event
+		initiatedAt, err := times.get(ctx, ev.Raw.BlockNumber)
+		if err != nil {
+			return err
+		}
+		txHash := ev.Raw.TxHash
+		initiated = append(initiated, &rpc.Withdrawal{
+			WithdrawalHash:       ev.WithdrawalHash,
+			Nonce:                (*hexutil.Big)(ev.Nonce),
+			Sender:               &ev.Sender,
+			Target:               &ev.Target,
+			Value:                (*hexutil.Big)(ev.Value),
+			GasLimit:             (*hexutil.Big)(ev.GasLimit),
+			Data:                 ev.Data,
+			InitiatedTxHash:      &txHash,
+			InitiatedBlockNumber: ev.Raw.BlockNumber,
+			InitiatedAt:          initiatedAt,
+		})
+	}
+	if err := iter.Error(); err != nil {
+		return fmt.Errorf("failed to filter initiated withdrawals: %w", err)
+	}
+
+	err = i.cfg.DB.Update(ctx, func(tx *dbTx) error {
+		for _, w := range initiated {
+			if err := tx.withdrawalInitiated(w); err != nil {
+				return fmt.Errorf("failed to store initiated withdrawal %s: %w", w.WithdrawalHash, err)
+			}
+		}
+		return tx.setCheckpoint(chainL2, to)
+	})
+	if err != nil {
+		return err
+	}
+
+	i.metr.RecordMessages(EventInitiated, len(initiated))
+	return nil
+}
+
+func toDeposit(dep *types.DepositTx, raw types.Log, depositedAt uint64) *rpc.Deposit {
+	mint := dep.Mint
+	if mint == nil {
+		mint = new(big.Int)
+	}
+	return &rpc.Deposit{
+		L2TxHash:    types.NewTx(dep).Hash(),
+		From:        dep.From,
+		To:          dep.To,
+		Mint:        (*hexutil.Big)(mint),
+		Value:       (*hexutil.Big)(dep.Value),
+		GasLimit:    dep.Gas,
+		IsCreation:  dep.To == nil,
+		Data:        dep.Data,
+		L1TxHash:    raw.TxHash,
+		L1LogIndex:  uint64(raw.Index),
+		BlockNumber: raw.BlockNumber,
+		DepositedAt: depositedAt,
+	}
+}
+
+// blockTimes caches the timestamps of the blocks of a range, as a block usually contains several events.
+type blockTimes struct {
+	client *ethclient.Client
+	times  map[uint64]uint64
+}
+
+func newBlockTimes(client *ethclient.Client) *blockTimes {
+	return &blockTimes{client: client, times: make(map[uint64]uint64)}
+}
+
+func (b *blockTimes) get(ctx context.Context, number uint64) (uint64, error) {
+	if t, ok := b.times[number]; ok {
+		return t, nil
+	}
+	header, err := b.client.HeaderByNumber(ctx, new(big.Int).SetUint64(number))
+	if err != nil {
+		return 0, fmt.Errorf("failed to get header of block %d: %w", number, err)
+	}
+	b.times[number] = header.Time
+	return header.Time, nil
+}
+
+// setWithdrawalStatus sets the status of the withdrawal at the given unix time, and when it is finalizable if proven.
+func setWithdrawalStatus(w *rpc.Withdrawal, finalizationPeriod uint64, now uint64) {
+	if w.ProvenTxHash != nil {
+		w.FinalizableAt = w.ProvenAt + finalizationPeriod
+	}
+	switch {
+	case w.FinalizedTxHash != nil:
+		w.Status = rpc.WithdrawalFinalized
+	case w.ProvenTxHash != nil && now >= w.FinalizableAt:
+		w.Status = rpc.WithdrawalFinalizable
+	case w.ProvenTxHash != nil:
+		w.Status = rpc.WithdrawalProven
+	default:
+		w.Status = rpc.WithdrawalInitiated
+	}
+}
+
+func (i *Indexer) Withdrawal(ctx context.Context, withdrawalHash common.Hash) (*rpc.Withdrawal, error) {
+	w, err := i.cfg.DB.Withdrawal(ctx, withdrawalHash)
+	if err != nil || w == nil {
+		return nil, err
+	}
+	setWithdrawalStatus(w, i.cfg.FinalizationPeriod, uint64(time.Now().Unix()))
+	return w, nil
+}
+
+func (i *Indexer) WithdrawalsBySender(ctx context.Context, sender common.Address, limit int) ([]*rpc.Withdrawal, error) {
+	ws, err := i.cfg.DB.WithdrawalsBySender(ctx, sender, limit)
+	if err != nil {
+		return nil, err
+	}
+	now := uint64(time.Now().Unix())
+	for _, w := range ws {
+		setWithdrawalStatus(w, i.cfg.FinalizationPeriod, now)
+	}
+	return ws, nil
+}
+
+func (i *Indexer) Deposit(ctx context.Context, l2TxHash common.Hash) (*rpc.Deposit, error) {
+	return i.cfg.DB.Deposit(ctx, l2TxHash)
+}
+
+func (i *Indexer) DepositsByL1Tx(ctx context.Context, l1TxHash common.Hash) ([]*rpc.Deposit, error) {
+	return i.cfg.DB.DepositsByL1Tx(ctx, l1TxHash)
+}
+
+func (i *Indexer) DepositsBySender(ctx context.Context, from common.Address, limit int) ([]*rpc.Deposit, error) {
+	return i.cfg.DB.DepositsBySender(ctx, from, limit)
+}
+
+func (i *Indexer) SyncStatus(ctx context.Context) (*rpc.SyncStatus, error) {
+	l1Block, _, err := i.cfg.DB.Checkpoint(ctx, chainL1)
+	if err != nil {
+		return nil, err
+	}
+	l2Block, _, err := i.cfg.DB.Checkpoint(ctx, chainL2)
+	if err != nil {
+		return nil, err
+	}
+	return &rpc.SyncStatus{L1BlockNumber: l1Block, L2BlockNumber: l2Block}, nil
+}
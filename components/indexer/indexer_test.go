@@ -0,0 +1,33 @@
+package indexer
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kroma-network/kroma/components/indexer/rpc"
+)
+
+func TestSetWithdrawalStatus(t *testing.T) {
+	const period = 100
+	txHash := common.HexToHash("0x01")
+
+	w := &rpc.Withdrawal{InitiatedTxHash: &txHash}
+	setWithdrawalStatus(w, period, 1000)
+	require.Equal(t, rpc.WithdrawalInitiated, w.Status)
+	require.Zero(t, w.FinalizableAt)
+
+	w.ProvenTxHash, w.ProvenAt = &txHash, 950
+	setWithdrawalStatus(w, period, 1000)
+	require.Equal(t, rpc.WithdrawalProven, w.Status)
+	require.Equal(t, uint64(1050), w.FinalizableAt)
+
+	setWithdrawalStatus(w, period, 1050)
+	require.Equal(t, rpc.WithdrawalFinalizable, w.Status)
+
+	w.FinalizedTxHash, w.FinalizedAt = &txHash, 1100
+	setWithdrawalStatus(w, period, 1100)
+	require.Equal(t, rpc.WithdrawalFinalized, w.Status)
+	require.Equal(t, uint64(1050), w.FinalizableAt)
+}
@@ -0,0 +1,116 @@
+package metrics
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/prometheus/client_golang/prometheus"
+
+	kmetrics "github.com/kroma-network/kroma/utils/service/metrics"
+)
+
+const Namespace = "kroma_indexer"
+
+type Metricer interface {
+	RecordInfo(version string)
+	RecordUp()
+
+	RecordIndexedBlock(chain string, number uint64)
+	RecordMessages(event string, count int)
+
+	Document() []kmetrics.DocumentedMetric
+}
+
+type Metrics struct {
+	ns       string
+	registry *prometheus.Registry
+	factory  kmetrics.Factory
+
+	Info prometheus.GaugeVec
+	Up   prometheus.Gauge
+
+	// label by l1, l2
+	IndexedBlock prometheus.GaugeVec
+	// label by deposited, initiated, proven, finalized
+	Messages prometheus.CounterVec
+}
+
+var _ Metricer = (*Metrics)(nil)
+
+func NewMetrics(procName string) *Metrics {
+	if procName == "" {
+		procName = "default"
+	}
+	ns := Namespace + "_" + procName
+
+	registry := kmetrics.NewRegistry()
+	factory := kmetrics.With(registry)
+
+	return &Metrics{
+		ns:       ns,
+		registry: registry,
+		factory:  factory,
+
+		Info: *factory.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: ns,
+			Name:      "info",
+			Help:      "Pseudo-metric tracking version and config info",
+		}, []string{
+			"version",
+		}),
+		Up: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: ns,
+			Name:      "up",
+			Help:      "1 if the kroma-indexer has finished starting up",
+		}),
+
+		IndexedBlock: *factory.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: ns,
+			Name:      "indexed_block_number",
+			Help:      "Last block of which the events are indexed.",
+		}, []string{"chain"}),
+		Messages: *factory.NewCounterVec(prometheus.CounterOpts{
+			Namespace: ns,
+			Name:      "messages_total",
+			Help:      "Number of the indexed message events.",
+		}, []string{"event"}),
+	}
+}
+
+func (m *Metrics) Serve(ctx context.Context, host string, port int) error {
+	return kmetrics.ListenAndServe(ctx, m.registry, host, port)
+}
+
+func (m *Metrics) Document() []kmetrics.DocumentedMetric {
+	return m.factory.Document()
+}
+
+func (m *Metrics) StartBalanceMetrics(ctx context.Context,
+	l log.Logger, client *ethclient.Client, account common.Address,
+) {
+	kmetrics.LaunchBalanceMetrics(ctx, l, m.registry, m.ns, client, account)
+}
+
+// RecordInfo sets a pseudo-metric that contains versioning and
+// config info for the kroma-indexer.
+func (m *Metrics) RecordInfo(version string) {
+	m.Info.WithLabelValues(version).Set(1)
+}
+
+// RecordUp sets the up metric to 1.
+func (m *Metrics) RecordUp() {
+	prometheus.MustRegister()
+	m.Up.Set(1)
+}
+
+// RecordIndexedBlock records the last block of the chain of which the events are indexed.
+func (m *Metrics) RecordIndexedBlock(chain string, number uint64) {
+	m.IndexedBlock.WithLabelValues(chain).Set(float64(number))
+}
+
+// RecordMessages records the number of the indexed message events of the given kind.
+func (m *Metrics) RecordMessages(event string, count int) {
+	m.Messages.WithLabelValues(event).Add(float64(count))
+}
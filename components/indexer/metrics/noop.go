@@ -0,0 +1,17 @@
+package metrics
+
+import (
+	kmetrics "github.com/kroma-network/kroma/utils/service/metrics"
+)
+
+type noopMetrics struct{}
+
+var NoopMetrics Metricer = new(noopMetrics)
+
+func (*noopMetrics) Document() []kmetrics.DocumentedMetric { return nil }
+
+func (*noopMetrics) RecordInfo(version string) {}
+func (*noopMetrics) RecordUp()                 {}
+
+func (*noopMetrics) RecordIndexedBlock(chain string, number uint64) {}
+func (*noopMetrics) RecordMessages(event string, count int)         {}
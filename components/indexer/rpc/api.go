@@ -0,0 +1,140 @@
+package rpc
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// The statuses of a withdrawal, in the order of the exit flow.
+const (
+	// WithdrawalInitiated is the status of a withdrawal that is initiated on L2, and not proven yet.
+	WithdrawalInitiated = "initiated"
+	// WithdrawalProven is the status of a withdrawal that is proven on L1, in the finalization period.
+	WithdrawalProven = "proven"
+	// WithdrawalFinalizable is the status of a proven withdrawal of which the finalization period has ended.
+	WithdrawalFinalizable = "finalizable"
+	// WithdrawalFinalized is the status of a withdrawal that is finalized on L1.
+	WithdrawalFinalized = "finalized"
+)
+
+// Withdrawal reports the status of a withdrawal message from L2 to L1.
+type Withdrawal struct {
+	WithdrawalHash common.Hash `json:"withdrawal_hash"`
+	// Status is one of initiated, proven, finalizable and finalized.
+	Status string `json:"status"`
+
+	// The fields of the withdrawal, unset if the initiating L2 transaction is not indexed yet.
+	Nonce    *hexutil.Big    `json:"nonce,omitempty"`
+	Sender   *common.Address `json:"sender,omitempty"`
+	Target   *common.Address `json:"target,omitempty"`
+	Value    *hexutil.Big    `json:"value,omitempty"`
+	GasLimit *hexutil.Big    `json:"gas_limit,omitempty"`
+	Data     hexutil.Bytes   `json:"data,omitempty"`
+
+	// InitiatedTxHash is the L2 transaction that initiated the withdrawal.
+	InitiatedTxHash      *common.Hash `json:"initiated_tx_hash,omitempty"`
+	InitiatedBlockNumber uint64       `json:"initiated_block_number,omitempty"`
+	// InitiatedAt is the unix time of the L2 block that initiated the withdrawal.
+	InitiatedAt uint64 `json:"initiated_at,omitempty"`
+
+	// ProvenTxHash is the L1 transaction that proved the withdrawal last.
+	ProvenTxHash *common.Hash `json:"proven_tx_hash,omitempty"`
+	// ProvenAt is the unix time of the L1 block that proved the withdrawal last.
+	ProvenAt uint64 `json:"proven_at,omitempty"`
+	// FinalizableAt is the unix time from which the proven withdrawal can be finalized.
+	FinalizableAt uint64 `json:"finalizable_at,omitempty"`
+
+	// FinalizedTxHash is the L1 transaction that finalized the withdrawal.
+	FinalizedTxHash *common.Hash `json:"finalized_tx_hash,omitempty"`
+	// FinalizedAt is the unix time of the L1 block that finalized the withdrawal.
+	FinalizedAt uint64 `json:"finalized_at,omitempty"`
+	// FinalizeSuccess is false if the call to the target of the finalized withdrawal failed.
+	FinalizeSuccess bool `json:"finalize_success,omitempty"`
+}
+
+// Deposit reports a deposit transaction from L1 to L2.
+type Deposit struct {
+	// L2TxHash is the hash of the deposit transaction on L2.
+	L2TxHash    common.Hash     `json:"l2_tx_hash"`
+	From        common.Address  `json:"from"`
+	To          *common.Address `json:"to,omitempty"`
+	Mint        *hexutil.Big    `json:"mint"`
+	Value       *hexutil.Big    `json:"value"`
+	GasLimit    uint64          `json:"gas_limit"`
+	IsCreation  bool            `json:"is_creation"`
+	Data        hexutil.Bytes   `json:"data"`
+	L1TxHash    common.Hash     `json:"l1_tx_hash"`
+	L1LogIndex  uint64          `json:"l1_log_index"`
+	BlockNumber uint64          `json:"l1_block_number"`
+	// DepositedAt is the unix time of the L1 block that included the deposit.
+	DepositedAt uint64 `json:"deposited_at"`
+}
+
+// SyncStatus reports the indexing progress of the indexer.
+type SyncStatus struct {
+	// L1BlockNumber is the last L1 block of which the events are indexed.
+	L1BlockNumber uint64 `json:"l1_block_number"`
+	// L2BlockNumber is the last L2 block of which the events are indexed.
+	L2BlockNumber uint64 `json:"l2_block_number"`
+}
+
+type indexerClient interface {
+	Withdrawal(ctx context.Context, withdrawalHash common.Hash) (*Withdrawal, error)
+	WithdrawalsBySender(ctx context.Context, sender common.Address, limit int) ([]*Withdrawal, error)
+	Deposit(ctx context.Context, l2TxHash common.Hash) (*Deposit, error)
+	DepositsByL1Tx(ctx context.Context, l1TxHash common.Hash) ([]*Deposit, error)
+	DepositsBySender(ctx context.Context, from common.Address, limit int) ([]*Deposit, error)
+	SyncStatus(ctx context.Context) (*SyncStatus, error)
+}
+
+// MaxLimit is the max number of the messages returned by a query.
+const MaxLimit = 1000
+
+type indexerAPI struct {
+	i indexerClient
+}
+
+func NewIndexerAPI(i indexerClient) *indexerAPI {
+	return &indexerAPI{
+		i: i,
+	}
+}
+
+// GetWithdrawal returns the withdrawal of the given hash, or nil if it is not indexed.
+func (a *indexerAPI) GetWithdrawal(ctx context.Context, withdrawalHash common.Hash) (*Withdrawal, error) {
+	return a.i.Withdrawal(ctx, withdrawalHash)
+}
+
+// GetWithdrawalsBySender returns the latest withdrawals initiated by the given sender, the latest first.
+func (a *indexerAPI) GetWithdrawalsBySender(ctx context.Context, sender common.Address, limit int) ([]*Withdrawal, error) {
+	return a.i.WithdrawalsBySender(ctx, sender, clampLimit(limit))
+}
+
+// GetDeposit returns the deposit of the given L2 transaction hash, or nil if it is not indexed.
+func (a *indexerAPI) GetDeposit(ctx context.Context, l2TxHash common.Hash) (*Deposit, error) {
+	return a.i.Deposit(ctx, l2TxHash)
+}
+
+// GetDepositsByL1Tx returns the deposits included in the given L1 transaction.
+func (a *indexerAPI) GetDepositsByL1Tx(ctx context.Context, l1TxHash common.Hash) ([]*Deposit, error) {
+	return a.i.DepositsByL1Tx(ctx, l1TxHash)
+}
+
+// GetDepositsBySender returns the latest deposits from the given address, the latest first.
+func (a *indexerAPI) GetDepositsBySender(ctx context.Context, from common.Address, limit int) ([]*Deposit, error) {
+	return a.i.DepositsBySender(ctx, from, clampLimit(limit))
+}
+
+// SyncStatus returns the last L1 and L2 blocks that are indexed.
+func (a *indexerAPI) SyncStatus(ctx context.Context) (*SyncStatus, error) {
+	return a.i.SyncStatus(ctx)
+}
+
+func clampLimit(limit int) int {
+	if limit <= 0 || limit > MaxLimit {
+		return MaxLimit
+	}
+	return limit
+}
@@ -0,0 +1,86 @@
+package altda
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// DefaultTimeout is the default timeout of requests to a DA server.
+// Storing data can take as long as the block time of the DA layer.
+const DefaultTimeout = time.Minute
+
+// ErrNotFound is returned when the DA server does not have the data of a commitment.
+var ErrNotFound = errors.New("not found")
+
+// DAClient is a client of a DA server, which stores data on an external DA layer on behalf of the client.
+// The DA server stores data with POST /put, and responds with the commitment to the data.
+// It returns the data of a commitment with GET /get/<hex encoded commitment>.
+type DAClient struct {
+	url    string
+	client *http.Client
+}
+
+// NewDAClient creates a client of the DA server at the given URL.
+func NewDAClient(url string, timeout time.Duration) *DAClient {
+	return &DAClient{
+		url: url,
+		client: &http.Client{
+			Timeout: timeout,
+		},
+	}
+}
+
+// GetInput returns the data of the given commitment from the DA server.
+// It returns ErrNotFound if the DA server does not have the data.
+func (c *DAClient) GetInput(ctx context.Context, comm Commitment) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s/get/%s", c.url, comm), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create DA server request: %w", err)
+	}
+	res, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get input from DA server: %w", err)
+	}
+	defer res.Body.Close()
+	if res.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("commitment %s: %w", comm, ErrNotFound)
+	}
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("DA server returned status code %d", res.StatusCode)
+	}
+	input, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read input from DA server: %w", err)
+	}
+	return input, nil
+}
+
+// SetInput stores the data on the DA server and returns the commitment to the data.
+func (c *DAClient) SetInput(ctx context.Context, input []byte) (Commitment, error) {
+	if len(input) == 0 {
+		return nil, errors.New("input must not be empty")
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.url+"/put", bytes.NewReader(input))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create DA server request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/octet-stream")
+	res, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to put input to DA server: %w", err)
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("DA server returned status code %d", res.StatusCode)
+	}
+	data, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read commitment from DA server: %w", err)
+	}
+	return DecodeCommitment(data)
+}
@@ -0,0 +1,69 @@
+package altda
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDAClient(t *testing.T) {
+	store := make(map[string][]byte)
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/put":
+			input, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			comm := NewCommitment(Celestia, crypto.Keccak256(input))
+			store[comm.String()] = input
+			_, _ = w.Write(comm)
+		case r.Method == http.MethodGet && strings.HasPrefix(r.URL.Path, "/get/"):
+			input, ok := store[strings.TrimPrefix(r.URL.Path, "/get/")]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			_, _ = w.Write(input)
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer s.Close()
+
+	client := NewDAClient(s.URL, time.Second)
+	ctx := context.Background()
+
+	input := []byte("batcher tx data")
+	comm, err := client.SetInput(ctx, input)
+	require.NoError(t, err)
+	require.Equal(t, Celestia, comm.Layer())
+	require.Equal(t, crypto.Keccak256(input), comm.Payload())
+
+	out, err := client.GetInput(ctx, comm)
+	require.NoError(t, err)
+	require.Equal(t, input, out)
+
+	_, err = client.GetInput(ctx, NewCommitment(Celestia, crypto.Keccak256([]byte("unknown"))))
+	require.ErrorIs(t, err, ErrNotFound)
+
+	_, err = client.SetInput(ctx, nil)
+	require.Error(t, err)
+}
+
+func TestDecodeCommitment(t *testing.T) {
+	_, err := DecodeCommitment(nil)
+	require.Error(t, err)
+	_, err = DecodeCommitment([]byte{byte(EigenDA)})
+	require.Error(t, err)
+
+	comm, err := DecodeCommitment([]byte{byte(EigenDA), 0x01, 0x02})
+	require.NoError(t, err)
+	require.Equal(t, EigenDA, comm.Layer())
+	require.Equal(t, []byte{0x01, 0x02}, comm.Payload())
+}
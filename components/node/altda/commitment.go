@@ -0,0 +1,58 @@
+package altda
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// DALayer identifies the external DA layer that a commitment refers to.
+type DALayer byte
+
+const (
+	EigenDA  DALayer = 0x00
+	Celestia DALayer = 0x0c
+)
+
+func (l DALayer) String() string {
+	switch l {
+	case EigenDA:
+		return "eigenda"
+	case Celestia:
+		return "celestia"
+	default:
+		return fmt.Sprintf("unknown(%d)", byte(l))
+	}
+}
+
+// Commitment is a commitment to data stored on an external DA layer.
+// It is the DA layer byte followed by the DA layer specific commitment to the data.
+type Commitment []byte
+
+// NewCommitment creates a commitment from the DA layer specific commitment to the data.
+func NewCommitment(layer DALayer, payload []byte) Commitment {
+	return append(Commitment{byte(layer)}, payload...)
+}
+
+// DecodeCommitment decodes a commitment, and checks that it has a DA layer specific commitment.
+func DecodeCommitment(data []byte) (Commitment, error) {
+	if len(data) < 2 {
+		return nil, errors.New("commitment is too short")
+	}
+	return Commitment(data), nil
+}
+
+// Layer returns the DA layer of the commitment.
+func (c Commitment) Layer() DALayer {
+	return DALayer(c[0])
+}
+
+// Payload returns the DA layer specific commitment.
+func (c Commitment) Payload() []byte {
+	return c[1:]
+}
+
+func (c Commitment) String() string {
+	return hexutil.Encode(c)
+}
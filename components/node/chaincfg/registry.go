@@ -0,0 +1,57 @@
+package chaincfg
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/kroma-network/kroma/components/node/rollup"
+)
+
+// maxRegistryResponseSize limits the size of a rollup config served by a registry.
+const maxRegistryResponseSize = 1 << 20
+
+// FetchRollupConfig fetches the rollup config of the named network from a remote registry.
+// The registry serves the rollup config of each network at <registry>/<network>/rollup.json.
+// If the network is also known to the embedded registry, the fetched config must describe the same chain,
+// so a misconfigured or compromised registry cannot point the node at another chain.
+func FetchRollupConfig(ctx context.Context, registry string, name string) (rollup.Config, error) {
+	configURL, err := url.JoinPath(registry, url.PathEscape(name), "rollup.json")
+	if err != nil {
+		return rollup.Config{}, fmt.Errorf("invalid rollup config registry %q: %w", registry, err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, configURL, nil)
+	if err != nil {
+		return rollup.Config{}, fmt.Errorf("failed to create rollup config request: %w", err)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return rollup.Config{}, fmt.Errorf("failed to fetch rollup config of network %s: %w", name, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return rollup.Config{}, fmt.Errorf("failed to fetch rollup config of network %s: unexpected status %s", name, resp.Status)
+	}
+
+	var config rollup.Config
+	if err := json.NewDecoder(io.LimitReader(resp.Body, maxRegistryResponseSize)).Decode(&config); err != nil {
+		return rollup.Config{}, fmt.Errorf("failed to decode rollup config of network %s: %w", name, err)
+	}
+	if err := config.Check(); err != nil {
+		return rollup.Config{}, fmt.Errorf("invalid rollup config of network %s: %w", name, err)
+	}
+	if known, ok := NetworksByName[name]; ok {
+		if config.L1ChainID.Cmp(known.L1ChainID) != 0 || config.L2ChainID.Cmp(known.L2ChainID) != 0 ||
+			config.Genesis.L1 != known.Genesis.L1 || config.Genesis.L2 != known.Genesis.L2 {
+			return rollup.Config{}, fmt.Errorf("rollup config of network %s does not match the embedded genesis", name)
+		}
+	}
+	return config, nil
+}
@@ -0,0 +1,54 @@
+package chaincfg
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFetchRollupConfig(t *testing.T) {
+	tampered := Sepolia
+	tampered.L2ChainID = big.NewInt(1)
+	var mtx sync.Mutex
+	configs := map[string]any{
+		"/sepolia/rollup.json": Sepolia,
+		"/devnet/rollup.json":  tampered,
+	}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mtx.Lock()
+		config, ok := configs[r.URL.Path]
+		mtx.Unlock()
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(config)
+	}))
+	defer srv.Close()
+
+	config, err := FetchRollupConfig(context.Background(), srv.URL, "sepolia")
+	require.NoError(t, err)
+	require.Equal(t, Sepolia.Genesis, config.Genesis)
+	require.Equal(t, Sepolia.L1SystemConfigAddress, config.L1SystemConfigAddress)
+
+	// networks unknown to the embedded registry are served as is
+	config, err = FetchRollupConfig(context.Background(), srv.URL, "devnet")
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(1), config.L2ChainID)
+
+	_, err = FetchRollupConfig(context.Background(), srv.URL, "unknown")
+	require.ErrorContains(t, err, "unexpected status")
+
+	// a known network must match the embedded genesis
+	mtx.Lock()
+	configs["/sepolia/rollup.json"] = tampered
+	mtx.Unlock()
+	_, err = FetchRollupConfig(context.Background(), srv.URL, "sepolia")
+	require.ErrorContains(t, err, "does not match")
+}
@@ -0,0 +1,164 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/kroma-network/kroma/components/node/metrics"
+)
+
+// engineWriteMethods are the prefixes of the Engine API methods that change the state of an execution engine.
+var engineWriteMethods = []string{
+	"engine_forkchoiceUpdated",
+	"engine_newPayload",
+}
+
+func isEngineWriteMethod(method string) bool {
+	for _, prefix := range engineWriteMethods {
+		if strings.HasPrefix(method, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// MultiplexedRPC drives a primary execution engine and any number of secondary execution engines.
+// Engine API calls that change the state of the engines are forwarded to all of them, and their results
+// are cross-checked against the result of the primary. All other calls are served by the primary,
+// and fail over to the secondaries in order if the primary cannot be reached.
+type MultiplexedRPC struct {
+	log         log.Logger
+	primary     RPC
+	secondaries []RPC
+}
+
+var _ RPC = (*MultiplexedRPC)(nil)
+
+// NewMultiplexedRPC creates a RPC that multiplexes calls between the primary and the secondaries.
+func NewMultiplexedRPC(log log.Logger, primary RPC, secondaries ...RPC) *MultiplexedRPC {
+	return &MultiplexedRPC{
+		log:         log,
+		primary:     primary,
+		secondaries: secondaries,
+	}
+}
+
+func (m *MultiplexedRPC) Close() {
+	m.primary.Close()
+	for _, s := range m.secondaries {
+		s.Close()
+	}
+}
+
+func (m *MultiplexedRPC) CallContext(ctx context.Context, result any, method string, args ...any) error {
+	if isEngineWriteMethod(method) {
+		return m.broadcast(ctx, result, method, args...)
+	}
+	return m.withFailover(method, func(c RPC) error {
+		return c.CallContext(ctx, result, method, args...)
+	})
+}
+
+func (m *MultiplexedRPC) BatchCallContext(ctx context.Context, b []rpc.BatchElem) error {
+	return m.withFailover(metrics.BatchMethod, func(c RPC) error {
+		return c.BatchCallContext(ctx, b)
+	})
+}
+
+func (m *MultiplexedRPC) EthSubscribe(ctx context.Context, channel any, args ...any) (ethereum.Subscription, error) {
+	var sub ethereum.Subscription
+	err := m.withFailover("eth_subscribe", func(c RPC) error {
+		var err error
+		sub, err = c.EthSubscribe(ctx, channel, args...)
+		return err
+	})
+	return sub, err
+}
+
+// withFailover calls fn with the primary, and then with each secondary in order, until the call succeeds,
+// or fails with an error returned by the execution engine itself rather than by the connection to it.
+func (m *MultiplexedRPC) withFailover(method string, fn func(c RPC) error) error {
+	err := fn(m.primary)
+	if err == nil || isEngineError(err) {
+		return err
+	}
+	for i, s := range m.secondaries {
+		m.log.Warn("Primary execution engine failed, failing over to secondary", "method", method, "secondary", i, "err", err)
+		err = fn(s)
+		if err == nil || isEngineError(err) {
+			return err
+		}
+	}
+	return err
+}
+
+// broadcast forwards the call to all execution engines, and returns the result of the primary.
+// If the primary cannot be reached, the result of the first succeeding secondary is returned instead.
+// Diverging results of secondaries are logged, but do not affect the returned result.
+func (m *MultiplexedRPC) broadcast(ctx context.Context, result any, method string, args ...any) error {
+	secondaryResults := make([]json.RawMessage, len(m.secondaries))
+	secondaryErrs := make([]error, len(m.secondaries))
+	var wg sync.WaitGroup
+	for i, s := range m.secondaries {
+		wg.Add(1)
+		go func(i int, s RPC) {
+			defer wg.Done()
+			secondaryErrs[i] = s.CallContext(ctx, &secondaryResults[i], method, args...)
+		}(i, s)
+	}
+	primaryErr := m.primary.CallContext(ctx, result, method, args...)
+	wg.Wait()
+
+	if primaryErr != nil && !isEngineError(primaryErr) {
+		for i, err := range secondaryErrs {
+			if err != nil {
+				continue
+			}
+			m.log.Warn("Primary execution engine failed, using result of secondary", "method", method, "secondary", i, "err", primaryErr)
+			if err := json.Unmarshal(secondaryResults[i], result); err != nil {
+				return fmt.Errorf("failed to decode result of secondary %d: %w", i, err)
+			}
+			return nil
+		}
+		return primaryErr
+	}
+
+	for i, err := range secondaryErrs {
+		if err != nil {
+			if primaryErr == nil {
+				m.log.Warn("Secondary execution engine failed", "method", method, "secondary", i, "err", err)
+			}
+			continue
+		}
+		if primaryErr != nil {
+			m.log.Warn("Secondary execution engine diverges from primary", "method", method, "secondary", i,
+				"primary_err", primaryErr, "secondary_result", string(secondaryResults[i]))
+			continue
+		}
+		// decode the secondary result into the same type as the primary result, to compare them regardless of encoding
+		secondaryResult := reflect.New(reflect.TypeOf(result).Elem())
+		if err := json.Unmarshal(secondaryResults[i], secondaryResult.Interface()); err != nil {
+			m.log.Warn("Failed to decode result of secondary execution engine", "method", method, "secondary", i, "err", err)
+		} else if !reflect.DeepEqual(result, secondaryResult.Interface()) {
+			m.log.Warn("Secondary execution engine diverges from primary", "method", method, "secondary", i,
+				"primary_result", result, "secondary_result", string(secondaryResults[i]))
+		}
+	}
+	return primaryErr
+}
+
+// isEngineError returns true if the error was returned by the execution engine in a JSON-RPC response,
+// in which case failing over to another execution engine does not help.
+func isEngineError(err error) bool {
+	var rpcErr rpc.Error
+	return errors.As(err, &rpcErr)
+}
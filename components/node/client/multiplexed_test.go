@@ -0,0 +1,114 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kroma-network/kroma/components/node/testlog"
+)
+
+// engineRPC is a fake execution engine, responding to every call with the same result or error.
+type engineRPC struct {
+	result string
+	err    error
+
+	mtx     sync.Mutex
+	methods []string
+}
+
+func (e *engineRPC) Close() {}
+
+func (e *engineRPC) CallContext(ctx context.Context, result any, method string, args ...any) error {
+	e.mtx.Lock()
+	e.methods = append(e.methods, method)
+	e.mtx.Unlock()
+	if e.err != nil {
+		return e.err
+	}
+	return json.Unmarshal([]byte(e.result), result)
+}
+
+func (e *engineRPC) BatchCallContext(ctx context.Context, b []rpc.BatchElem) error {
+	return errors.New("not supported")
+}
+
+func (e *engineRPC) EthSubscribe(ctx context.Context, channel any, args ...any) (ethereum.Subscription, error) {
+	return nil, errors.New("not supported")
+}
+
+func (e *engineRPC) Methods() []string {
+	e.mtx.Lock()
+	defer e.mtx.Unlock()
+	return e.methods
+}
+
+type engineErr struct{}
+
+func (engineErr) Error() string  { return "invalid params" }
+func (engineErr) ErrorCode() int { return -32602 }
+
+func TestMultiplexedRPC(t *testing.T) {
+	logger := testlog.Logger(t, log.LvlError)
+
+	t.Run("write methods are forwarded to all engines", func(t *testing.T) {
+		primary := &engineRPC{result: `"primary"`}
+		secondary := &engineRPC{result: `"secondary"`}
+		m := NewMultiplexedRPC(logger, primary, secondary)
+
+		var out string
+		require.NoError(t, m.CallContext(context.Background(), &out, "engine_newPayloadV1"))
+		require.Equal(t, "primary", out)
+		require.Equal(t, []string{"engine_newPayloadV1"}, primary.Methods())
+		require.Equal(t, []string{"engine_newPayloadV1"}, secondary.Methods())
+	})
+
+	t.Run("write methods use a secondary result if the primary is unavailable", func(t *testing.T) {
+		primary := &engineRPC{err: errors.New("connection refused")}
+		secondary := &engineRPC{result: `"secondary"`}
+		m := NewMultiplexedRPC(logger, primary, secondary)
+
+		var out string
+		require.NoError(t, m.CallContext(context.Background(), &out, "engine_forkchoiceUpdatedV1"))
+		require.Equal(t, "secondary", out)
+	})
+
+	t.Run("read methods fail over to secondaries", func(t *testing.T) {
+		primary := &engineRPC{err: errors.New("connection refused")}
+		first := &engineRPC{err: errors.New("connection refused")}
+		second := &engineRPC{result: `"second"`}
+		m := NewMultiplexedRPC(logger, primary, first, second)
+
+		var out string
+		require.NoError(t, m.CallContext(context.Background(), &out, "eth_getBlockByNumber"))
+		require.Equal(t, "second", out)
+	})
+
+	t.Run("read methods are served by the primary only", func(t *testing.T) {
+		primary := &engineRPC{result: `"primary"`}
+		secondary := &engineRPC{result: `"secondary"`}
+		m := NewMultiplexedRPC(logger, primary, secondary)
+
+		var out string
+		require.NoError(t, m.CallContext(context.Background(), &out, "engine_getPayloadV1"))
+		require.Equal(t, "primary", out)
+		require.Empty(t, secondary.Methods())
+	})
+
+	t.Run("engine errors do not fail over", func(t *testing.T) {
+		primary := &engineRPC{err: engineErr{}}
+		secondary := &engineRPC{result: `"secondary"`}
+		m := NewMultiplexedRPC(logger, primary, secondary)
+
+		var out string
+		require.ErrorIs(t, m.CallContext(context.Background(), &out, "eth_getBlockByNumber"), engineErr{})
+		require.Empty(t, secondary.Methods())
+	})
+}
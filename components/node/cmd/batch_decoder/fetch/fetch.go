@@ -8,6 +8,7 @@ import (
 	"math/big"
 	"os"
 	"path"
+	"sync"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -38,6 +39,8 @@ type Config struct {
 	BatchInbox   common.Address
 	BatchSenders map[common.Address]struct{}
 	OutDirectory string
+	// ConcurrentRequests is the number of blocks fetched in parallel. Blocks are fetched one by one if it is zero.
+	ConcurrentRequests uint64
 }
 
 // Batches fetches & stores all transactions sent to the batch inbox address in
@@ -47,14 +50,30 @@ func Batches(client *ethclient.Client, config Config) (totalValid, totalInvalid
 	if err := os.MkdirAll(config.OutDirectory, 0750); err != nil {
 		log.Fatal(err)
 	}
-	number := new(big.Int).SetUint64(config.Start)
+	concurrency := config.ConcurrentRequests
+	if concurrency == 0 {
+		concurrency = 1
+	}
 	signer := types.LatestSignerForChainID(config.ChainID)
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	var mtx sync.Mutex
 	for i := config.Start; i < config.End; i++ {
-		valid, invalid := fetchBatchesPerBlock(client, number, signer, config)
-		totalValid += valid
-		totalInvalid += invalid
-		number = number.Add(number, common.Big1)
+		sem <- struct{}{}
+		wg.Add(1)
+		go func(number *big.Int) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			valid, invalid := fetchBatchesPerBlock(client, number, signer, config)
+			mtx.Lock()
+			defer mtx.Unlock()
+			totalValid += valid
+			totalInvalid += invalid
+		}(new(big.Int).SetUint64(i))
 	}
+	wg.Wait()
 	return
 }
 
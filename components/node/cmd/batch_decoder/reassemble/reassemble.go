@@ -1,6 +1,7 @@
 package reassemble
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -21,8 +22,12 @@ type ChannelWithMetadata struct {
 	IsReady        bool                `json:"is_ready"`
 	InvalidFrames  bool                `json:"invalid_frames"`
 	InvalidBatches bool                `json:"invalid_batches"`
+	BatchErr       string              `json:"batch_error,omitempty"`
+	ComprSize      int                 `json:"compressed_size"`
+	UncomprSize    int                 `json:"uncompressed_size"`
 	Frames         []FrameWithMetadata `json:"frames"`
 	Batches        []derive.BatchV1    `json:"batches"`
+	SpanBatches    []derive.SpanBatch  `json:"span_batches,omitempty"`
 }
 
 type FrameWithMetadata struct {
@@ -102,20 +107,36 @@ func processFrames(id derive.ChannelID, frames []FrameWithMetadata) ChannelWithM
 	}
 
 	var batches []derive.BatchV1
+	var spanBatches []derive.SpanBatch
 	invalidBatches := false
+	batchErr := ""
+	comprSize, uncomprSize := 0, 0
 	if ch.IsReady() {
-		br, err := derive.BatchReader(ch.Reader(), eth.L1BlockRef{})
+		data, err := io.ReadAll(ch.Reader())
+		if err != nil {
+			log.Fatal(err)
+		}
+		comprSize = len(data)
+		uncomprSize = uncompressedSize(data)
+		br, err := derive.BatchReader(bytes.NewReader(data), eth.L1BlockRef{}, true)
 		if err == nil {
 			for batch, err := br(); err != io.EOF; batch, err = br() {
 				if err != nil {
 					fmt.Printf("Error reading batch for channel %v. Err: %v\n", id.String(), err)
 					invalidBatches = true
+					batchErr = err.Error()
+					// like the derivation pipeline, drop the rest of the channel after an invalid batch
+					break
+				} else if batch.Batch.Span != nil {
+					spanBatches = append(spanBatches, *batch.Batch.Span)
 				} else {
 					batches = append(batches, batch.Batch.BatchV1)
 				}
 			}
 		} else {
 			fmt.Printf("Error creating batch reader for channel %v. Err: %v\n", id.String(), err)
+			invalidBatches = true
+			batchErr = err.Error()
 		}
 	} else {
 		fmt.Printf("Channel %v is not ready\n", id.String())
@@ -127,8 +148,23 @@ func processFrames(id derive.ChannelID, frames []FrameWithMetadata) ChannelWithM
 		IsReady:        ch.IsReady(),
 		InvalidFrames:  invalidFrame,
 		InvalidBatches: invalidBatches,
+		BatchErr:       batchErr,
+		ComprSize:      comprSize,
+		UncomprSize:    uncomprSize,
 		Batches:        batches,
+		SpanBatches:    spanBatches,
+	}
+}
+
+// uncompressedSize returns the size of the decompressed channel data, up to the maximum the derivation reads.
+// Corrupted data is only counted up to the point it can be decompressed.
+func uncompressedSize(data []byte) int {
+	zr, err := derive.DecompressChannel(bytes.NewReader(data), true)
+	if err != nil {
+		return 0
 	}
+	n, _ := io.Copy(io.Discard, io.LimitReader(zr, derive.MaxRLPBytesPerChannel))
+	return int(n)
 }
 
 func transactionsToFrames(txns []fetch.TransactionWithMetadata) []FrameWithMetadata {
@@ -1,19 +1,24 @@
 package genesis
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"math/big"
 	"os"
 	"path/filepath"
 
+	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/log"
 	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/urfave/cli/v2"
 
 	"github.com/kroma-network/kroma/bindings/hardhat"
+	"github.com/kroma-network/kroma/components/node/rollup"
 	"github.com/kroma-network/kroma/utils/chain-ops/genesis"
 )
 
@@ -105,6 +110,14 @@ var Subcommands = cli.Commands{
 				Name:  "outfile.rollup",
 				Usage: "Path to rollup output file",
 			},
+			&cli.StringFlag{
+				Name:  "check.l2",
+				Usage: "Path to an existing L2 genesis file, to verify that the generated L2 genesis block matches it",
+			},
+			&cli.StringFlag{
+				Name:  "check.rollup",
+				Usage: "Path to an existing rollup config file, to verify that the generated rollup config matches it",
+			},
 		},
 		Action: func(ctx *cli.Context) error {
 			deployConfig := ctx.String("deploy-config")
@@ -113,6 +126,16 @@ var Subcommands = cli.Commands{
 				return err
 			}
 
+			checking := ctx.String("check.l2") != "" || ctx.String("check.rollup") != ""
+			tag := config.L1StartingBlockTag
+			if tag != nil && tag.BlockHash == nil && (tag.BlockNumber == nil || tag.BlockNumber.Int64() < 0) {
+				// the starting block is resolved from the L1 chain, e.g. "latest", so the output depends on when it is generated
+				if checking {
+					return errors.New("cannot verify a genesis with an L1 starting block tag like latest")
+				}
+				log.Warn("L1 starting block is not a block hash, the generated genesis is not reproducible")
+			}
+
 			depPath, network := filepath.Split(ctx.String("deployment-dir"))
 			hh, err := hardhat.New(network, nil, []string{depPath})
 			if err != nil {
@@ -164,14 +187,73 @@ var Subcommands = cli.Commands{
 				return fmt.Errorf("generated rollup config does not pass validation: %w", err)
 			}
 
-			if err := writeGenesisFile(ctx.String("outfile.l2"), l2Genesis); err != nil {
-				return err
+			if path := ctx.String("check.l2"); path != "" {
+				if err := checkL2Genesis(path, l2GenesisBlock); err != nil {
+					return err
+				}
+				log.Info("Generated L2 genesis matches", "path", path, "hash", l2GenesisBlock.Hash())
 			}
-			return writeGenesisFile(ctx.String("outfile.rollup"), rollupConfig)
+			if path := ctx.String("check.rollup"); path != "" {
+				if err := checkRollupConfig(path, rollupConfig); err != nil {
+					return err
+				}
+				log.Info("Generated rollup config matches", "path", path)
+			}
+
+			if path := ctx.String("outfile.l2"); path != "" {
+				if err := writeGenesisFile(path, l2Genesis); err != nil {
+					return err
+				}
+			}
+			if path := ctx.String("outfile.rollup"); path != "" {
+				return writeGenesisFile(path, rollupConfig)
+			}
+			return nil
 		},
 	},
 }
 
+// checkL2Genesis verifies that the L2 genesis file at the given path produces the given genesis block.
+func checkL2Genesis(path string, block *types.Block) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read L2 genesis to check: %w", err)
+	}
+	var existing core.Genesis
+	if err := json.Unmarshal(data, &existing); err != nil {
+		return fmt.Errorf("failed to decode L2 genesis to check: %w", err)
+	}
+	if existingHash := existing.ToBlock().Hash(); existingHash != block.Hash() {
+		return fmt.Errorf("generated L2 genesis block %s does not match %s in %s", block.Hash(), existingHash, path)
+	}
+	return nil
+}
+
+// checkRollupConfig verifies that the rollup config file at the given path is equal to the given rollup config.
+func checkRollupConfig(path string, config *rollup.Config) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read rollup config to check: %w", err)
+	}
+	var existing rollup.Config
+	if err := json.Unmarshal(data, &existing); err != nil {
+		return fmt.Errorf("failed to decode rollup config to check: %w", err)
+	}
+	// compare the canonical encodings, so formatting differences of the file do not matter
+	want, err := json.Marshal(&existing)
+	if err != nil {
+		return err
+	}
+	got, err := json.Marshal(config)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(want, got) {
+		return fmt.Errorf("generated rollup config does not match %s:\ngenerated: %s\nexisting:  %s", path, got, want)
+	}
+	return nil
+}
+
 func writeGenesisFile(outfile string, input any) error {
 	f, err := os.OpenFile(outfile, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o755)
 	if err != nil {
@@ -0,0 +1,206 @@
+package inspect
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/hashicorp/go-multierror"
+	"github.com/urfave/cli/v2"
+
+	"github.com/kroma-network/kroma/bindings/predeploys"
+	"github.com/kroma-network/kroma/utils/chain-ops/genesis"
+)
+
+// versionABI is the ABI of the "version" getter of the Semver contracts.
+var versionABI = func() abi.ABI {
+	parsed, err := abi.JSON(strings.NewReader(`[{"inputs":[],"name":"version","outputs":[{"internalType":"string","name":"","type":"string"}],"stateMutability":"view","type":"function"}]`))
+	if err != nil {
+		panic(err)
+	}
+	return parsed
+}()
+
+var Subcommands = cli.Commands{
+	{
+		Name:  "predeploys",
+		Usage: "Reports the implementation, admin and version of every predeploy on an L2 endpoint",
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:     "l2",
+				Usage:    "Address of the L2 execution client RPC",
+				Required: true,
+			},
+			&cli.Int64Flag{
+				Name:  "block",
+				Usage: "Number of the L2 block to inspect the predeploys at. If not set, the latest block is inspected",
+				Value: -1,
+			},
+			&cli.StringFlag{
+				Name:  "expected",
+				Usage: "Path to a JSON file of the expected version by predeploy name, to verify that the network is on the expected contract set",
+			},
+			&cli.BoolFlag{
+				Name:  "json",
+				Usage: "Print the predeploys as JSON instead of a table",
+			},
+		},
+		Action: func(ctx *cli.Context) error {
+			var expected map[string]string
+			if path := ctx.String("expected"); path != "" {
+				file, err := os.ReadFile(path)
+				if err != nil {
+					return err
+				}
+				if err := json.Unmarshal(file, &expected); err != nil {
+					return fmt.Errorf("cannot parse %s: %w", path, err)
+				}
+			}
+
+			client, err := ethclient.DialContext(ctx.Context, ctx.String("l2"))
+			if err != nil {
+				return fmt.Errorf("cannot dial %s: %w", ctx.String("l2"), err)
+			}
+			defer client.Close()
+
+			var block *big.Int
+			if n := ctx.Int64("block"); n >= 0 {
+				block = big.NewInt(n)
+			}
+
+			names := make([]string, 0, len(predeploys.Predeploys))
+			for name := range predeploys.Predeploys {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+
+			infos := make([]*PredeployInfo, 0, len(names))
+			for _, name := range names {
+				info, err := InspectPredeploy(ctx.Context, client, name, *predeploys.Predeploys[name], block)
+				if err != nil {
+					return err
+				}
+				infos = append(infos, info)
+			}
+
+			if ctx.Bool("json") {
+				enc := json.NewEncoder(os.Stdout)
+				enc.SetIndent("", "  ")
+				if err := enc.Encode(infos); err != nil {
+					return err
+				}
+			} else {
+				printPredeploys(infos)
+			}
+
+			return checkVersions(infos, expected)
+		},
+	},
+}
+
+// PredeployInfo is the state of a predeploy on an L2 chain.
+type PredeployInfo struct {
+	Name    string         `json:"name"`
+	Address common.Address `json:"address"`
+	Proxied bool           `json:"proxied"`
+	// Implementation and Admin are the EIP-1967 slots of the proxy, which are zero if the predeploy is not proxied
+	Implementation common.Address `json:"implementation"`
+	Admin          common.Address `json:"admin"`
+	// CodeNamespace is whether the implementation is still the one set in the genesis, in the code namespace
+	CodeNamespace bool `json:"codeNamespace"`
+	// Version is empty if the predeploy is not a Semver contract
+	Version string `json:"version"`
+}
+
+// InspectPredeploy reads the proxy slots and the version of the predeploy at the block.
+func InspectPredeploy(ctx context.Context, client *ethclient.Client, name string, addr common.Address, block *big.Int) (*PredeployInfo, error) {
+	info := &PredeployInfo{
+		Name:    name,
+		Address: addr,
+		Proxied: !genesis.UntouchablePredeploys[addr],
+	}
+
+	if info.Proxied {
+		impl, err := client.StorageAt(ctx, addr, genesis.ImplementationSlot, block)
+		if err != nil {
+			return nil, fmt.Errorf("cannot read implementation of %s: %w", name, err)
+		}
+		admin, err := client.StorageAt(ctx, addr, genesis.AdminSlot, block)
+		if err != nil {
+			return nil, fmt.Errorf("cannot read admin of %s: %w", name, err)
+		}
+		info.Implementation = common.BytesToAddress(impl)
+		info.Admin = common.BytesToAddress(admin)
+
+		codeAddr, err := genesis.AddressToCodeNamespace(addr)
+		if err != nil {
+			return nil, err
+		}
+		info.CodeNamespace = info.Implementation == codeAddr
+	}
+
+	data, err := versionABI.Pack("version")
+	if err != nil {
+		return nil, err
+	}
+	out, err := client.CallContract(ctx, ethereum.CallMsg{To: &addr, Data: data}, block)
+	// The predeploys that are not Semver contracts revert, or return nothing if they have no fallback
+	if err != nil || len(out) == 0 {
+		return info, nil
+	}
+	res, err := versionABI.Unpack("version", out)
+	if err != nil {
+		return nil, fmt.Errorf("cannot decode version of %s: %w", name, err)
+	}
+	info.Version = res[0].(string)
+	return info, nil
+}
+
+func printPredeploys(infos []*PredeployInfo) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "NAME\tADDRESS\tIMPLEMENTATION\tADMIN\tVERSION")
+	for _, info := range infos {
+		impl, admin := "-", "-"
+		if info.Proxied {
+			impl, admin = info.Implementation.Hex(), info.Admin.Hex()
+			if info.CodeNamespace {
+				impl += " (genesis)"
+			}
+		}
+		version := info.Version
+		if version == "" {
+			version = "-"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", info.Name, info.Address, impl, admin, version)
+	}
+	w.Flush()
+}
+
+// checkVersions checks that the predeploys are on the expected versions.
+func checkVersions(infos []*PredeployInfo, expected map[string]string) error {
+	var result *multierror.Error
+	for name, version := range expected {
+		var info *PredeployInfo
+		for _, i := range infos {
+			if i.Name == name {
+				info = i
+				break
+			}
+		}
+		if info == nil {
+			result = multierror.Append(result, fmt.Errorf("unknown predeploy %s", name))
+		} else if info.Version != version {
+			result = multierror.Append(result, fmt.Errorf("%s is on version %q, expected %q", name, info.Version, version))
+		}
+	}
+	return result.ErrorOrNil()
+}
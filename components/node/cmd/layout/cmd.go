@@ -0,0 +1,135 @@
+package layout
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/urfave/cli/v2"
+
+	"github.com/kroma-network/kroma/bindings/bindings"
+	"github.com/kroma-network/kroma/bindings/hardhat"
+	"github.com/kroma-network/kroma/bindings/solc"
+	"github.com/kroma-network/kroma/components/node/flags"
+	"github.com/kroma-network/kroma/utils/chain-ops/layout"
+	klog "github.com/kroma-network/kroma/utils/service/log"
+)
+
+var (
+	RPCFlag = &cli.StringFlag{
+		Name:     "rpc",
+		Usage:    "Address of the execution client RPC of the network that the contracts are deployed to",
+		Required: true,
+	}
+	DeploymentDirFlag = &cli.StringFlag{
+		Name:     "deployment-dir",
+		Usage:    "Path to the deployment directory of the network",
+		Required: true,
+	}
+	ArtifactsDirFlag = &cli.StringFlag{
+		Name:  "artifacts-dir",
+		Usage: "Path to the hardhat artifacts of the current contracts. If not set, the storage layouts of the bindings are used",
+	}
+	ContractsFlag = &cli.StringSliceFlag{
+		Name:  "contracts",
+		Usage: "Names of the contracts to check. If not set, every proxied contract of the deployment directory is checked",
+	}
+)
+
+var Flags = append([]cli.Flag{
+	RPCFlag,
+	DeploymentDirFlag,
+	ArtifactsDirFlag,
+	ContractsFlag,
+}, klog.CLIFlagsV2(flags.EnvVarPrefix)...)
+
+// Main compares the storage layouts of the implementations that the proxies point to on the live network with
+// the storage layouts of the current contracts, and fails if any of the current contracts is incompatible with
+// the storage of its proxy, so that the incompatibility is found before the upgrade is executed.
+func Main(ctx *cli.Context) error {
+	logCfg := klog.ReadCLIConfigV2(ctx)
+	if err := logCfg.Check(); err != nil {
+		return fmt.Errorf("invalid log config: %w", err)
+	}
+	log := klog.NewLogger(logCfg)
+
+	depDir := filepath.Clean(ctx.String(DeploymentDirFlag.Name))
+	depPath, network := filepath.Split(depDir)
+	var artifacts []string
+	if dir := ctx.String(ArtifactsDirFlag.Name); dir != "" {
+		artifacts = []string{dir}
+	}
+	hh, err := hardhat.New(network, artifacts, []string{depPath})
+	if err != nil {
+		return err
+	}
+
+	names := ctx.StringSlice(ContractsFlag.Name)
+	if len(names) == 0 {
+		if names, err = proxiedContracts(depDir); err != nil {
+			return err
+		}
+	}
+
+	client, err := ethclient.DialContext(ctx.Context, ctx.String(RPCFlag.Name))
+	if err != nil {
+		return fmt.Errorf("failed to dial RPC: %w", err)
+	}
+	defer client.Close()
+
+	var incompatibilities []layout.Incompatibility
+	for _, name := range names {
+		var current *solc.StorageLayout
+		if len(artifacts) > 0 {
+			current, err = hh.GetStorageLayout(name)
+		} else {
+			current, err = bindings.GetStorageLayout(name)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get storage layout of %s: %w", name, err)
+		}
+
+		found, err := layout.CheckDeployment(ctx.Context, client, hh, name, current)
+		if err != nil {
+			return err
+		}
+		if len(found) == 0 {
+			log.Info("Storage layout is compatible", "contract", name)
+		}
+		for _, incompatibility := range found {
+			log.Error("Incompatible storage layout", "contract", name, "label", incompatibility.Label,
+				"slot", incompatibility.Slot, "offset", incompatibility.Offset, "reason", incompatibility.Reason)
+		}
+		incompatibilities = append(incompatibilities, found...)
+	}
+
+	if len(incompatibilities) > 0 {
+		return fmt.Errorf("found %d incompatible storage layout changes", len(incompatibilities))
+	}
+	return nil
+}
+
+// proxiedContracts returns the names of the contracts that have a proxy deployment in the deployment directory.
+func proxiedContracts(depDir string) ([]string, error) {
+	entries, err := os.ReadDir(depDir)
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), "Proxy.json") {
+			continue
+		}
+		name := strings.TrimSuffix(entry.Name(), "Proxy.json")
+		// The implementation deployment is needed for the deployed storage layout
+		if _, err := os.Stat(filepath.Join(depDir, name+".json")); err != nil {
+			continue
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names, nil
+}
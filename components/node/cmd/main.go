@@ -16,7 +16,13 @@ import (
 	"github.com/kroma-network/kroma/components/node/chaincfg"
 	"github.com/kroma-network/kroma/components/node/cmd/doc"
 	"github.com/kroma-network/kroma/components/node/cmd/genesis"
+	"github.com/kroma-network/kroma/components/node/cmd/inspect"
+	"github.com/kroma-network/kroma/components/node/cmd/layout"
+	"github.com/kroma-network/kroma/components/node/cmd/multi"
 	"github.com/kroma-network/kroma/components/node/cmd/p2p"
+	"github.com/kroma-network/kroma/components/node/cmd/replay"
+	"github.com/kroma-network/kroma/components/node/cmd/systemconfig"
+	"github.com/kroma-network/kroma/components/node/cmd/withdraw"
 	"github.com/kroma-network/kroma/components/node/flags"
 	"github.com/kroma-network/kroma/components/node/heartbeat"
 	"github.com/kroma-network/kroma/components/node/metrics"
@@ -64,10 +70,43 @@ func main() {
 			Name:        "genesis",
 			Subcommands: genesis.Subcommands,
 		},
+		{
+			Name:        "inspect",
+			Subcommands: inspect.Subcommands,
+		},
+		{
+			Name:        "system-config",
+			Usage:       "Reads and updates the SystemConfig parameters as the chain admin",
+			Subcommands: systemconfig.Subcommands,
+		},
 		{
 			Name:        "doc",
 			Subcommands: doc.Subcommands,
 		},
+		{
+			Name:   "multi",
+			Usage:  "Runs several independent rollup node instances, configured in a JSON file, in a single process",
+			Flags:  multi.Flags,
+			Action: multi.Main(VersionWithMeta),
+		},
+		{
+			Name:   "replay",
+			Usage:  "Replays the derivation of batches from a range of L1 blocks, without an execution engine",
+			Flags:  replay.Flags,
+			Action: replay.Main,
+		},
+		{
+			Name:   "withdraw",
+			Usage:  "Proves and finalizes an L2 withdrawal on L1, waiting for the output and the finalization period",
+			Flags:  withdraw.Flags,
+			Action: withdraw.Main,
+		},
+		{
+			Name:   "check-layout",
+			Usage:  "Checks that the storage layouts of the current contracts are compatible with the proxies on a live network",
+			Flags:  layout.Flags,
+			Action: layout.Main,
+		},
 	}
 
 	err := app.Run(os.Args)
@@ -131,15 +170,46 @@ func RollupNodeMain(ctx *cli.Context) error {
 		}
 
 		beatCtx, beatCtxCancel := context.WithCancel(context.Background())
-		payload := &heartbeat.Payload{
-			Version: version.Version,
-			Meta:    version.Meta,
-			Moniker: cfg.Heartbeat.Moniker,
-			PeerID:  peerID,
-			ChainID: cfg.Rollup.L2ChainID.Uint64(),
+		collect := func(ctx context.Context) (*heartbeat.Payload, error) {
+			payload := &heartbeat.Payload{
+				Moniker: cfg.Heartbeat.Moniker,
+				PeerID:  peerID,
+			}
+			if cfg.Heartbeat.HasField(heartbeat.FieldVersion) {
+				payload.Version = version.Version
+				payload.Meta = version.Meta
+			}
+			if cfg.Heartbeat.HasField(heartbeat.FieldChainID) {
+				payload.ChainID = cfg.Rollup.L2ChainID.Uint64()
+			}
+			if cfg.Heartbeat.HasField(heartbeat.FieldPeerCount) {
+				peerCount := 0
+				if !cfg.P2P.Disabled() {
+					peerCount = len(n.P2P().Host().Network().Peers())
+				}
+				payload.PeerCount = &peerCount
+			}
+			if cfg.Heartbeat.HasField(heartbeat.FieldSyncState) {
+				status, err := n.SyncStatus(ctx)
+				if err != nil {
+					return nil, fmt.Errorf("failed to get sync status: %w", err)
+				}
+				payload.SyncState = &heartbeat.SyncState{
+					CurrentL1:   status.CurrentL1.Number,
+					HeadL1:      status.HeadL1.Number,
+					UnsafeL2:    status.UnsafeL2.Number,
+					SafeL2:      status.SafeL2.Number,
+					FinalizedL2: status.FinalizedL2.Number,
+				}
+			}
+			return payload, nil
+		}
+		heartbeatCfg := heartbeat.Config{
+			URL:      cfg.Heartbeat.URL,
+			Interval: cfg.Heartbeat.Interval,
 		}
 		go func() {
-			if err := heartbeat.Beat(beatCtx, log, cfg.Heartbeat.URL, payload); err != nil {
+			if err := heartbeat.Run(beatCtx, log, heartbeatCfg, collect); err != nil {
 				log.Error("heartbeat goroutine crashed", "err", err)
 			}
 		}()
@@ -164,7 +234,17 @@ func RollupNodeMain(ctx *cli.Context) error {
 		syscall.SIGTERM,
 		syscall.SIGQUIT,
 	}...)
-	<-interruptChannel
-
-	return nil
+	// SIGHUP reloads the engine JWT secret, to rotate it without a restart.
+	hupChannel := make(chan os.Signal, 1)
+	signal.Notify(hupChannel, syscall.SIGHUP)
+	for {
+		select {
+		case <-hupChannel:
+			if err := n.ReloadEngineJWTSecret(); err != nil {
+				log.Error("Unable to reload the engine JWT secret", "error", err)
+			}
+		case <-interruptChannel:
+			return nil
+		}
+	}
 }
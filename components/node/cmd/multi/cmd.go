@@ -0,0 +1,176 @@
+package multi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/hashicorp/go-multierror"
+	"github.com/urfave/cli/v2"
+
+	knode "github.com/kroma-network/kroma/components/node"
+	"github.com/kroma-network/kroma/components/node/flags"
+	"github.com/kroma-network/kroma/components/node/metrics"
+	"github.com/kroma-network/kroma/components/node/node"
+	klog "github.com/kroma-network/kroma/utils/service/log"
+)
+
+var ConfigFlag = &cli.StringFlag{
+	Name:     "config",
+	Usage:    "Path to the JSON file listing the rollup node instances to run",
+	Required: true,
+}
+
+var Flags = append([]cli.Flag{ConfigFlag}, klog.CLIFlagsV2(flags.EnvVarPrefix)...)
+
+// Config lists the rollup node instances to run in a single process.
+type Config struct {
+	Instances []InstanceConfig `json:"instances"`
+}
+
+// InstanceConfig configures a single rollup node instance with the same flags as a standalone kroma-node.
+// Every instance needs its own L2 engine, and its own RPC, metrics and p2p ports.
+// Environment variables apply to all instances, so they can be used for settings shared by all instances.
+type InstanceConfig struct {
+	// Name of the instance, which is added to its logs and used as the process name of its metrics.
+	Name string   `json:"name"`
+	Args []string `json:"args"`
+}
+
+func (c *Config) Check() error {
+	if len(c.Instances) == 0 {
+		return errors.New("no instances configured")
+	}
+	names := make(map[string]struct{})
+	for _, inst := range c.Instances {
+		if inst.Name == "" {
+			return errors.New("instance name must not be empty")
+		}
+		if _, ok := names[inst.Name]; ok {
+			return fmt.Errorf("duplicate instance name %s", inst.Name)
+		}
+		names[inst.Name] = struct{}{}
+	}
+	return nil
+}
+
+func LoadConfig(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read multi-network config: %w", err)
+	}
+	var cfg Config
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return nil, fmt.Errorf("failed to decode multi-network config: %w", err)
+	}
+	if err := cfg.Check(); err != nil {
+		return nil, fmt.Errorf("invalid multi-network config: %w", err)
+	}
+	return &cfg, nil
+}
+
+// instanceConfig parses the node config of the instance from its args, as if they were passed to a standalone kroma-node.
+func instanceConfig(inst InstanceConfig, logger log.Logger) (*node.Config, log.Logger, error) {
+	var (
+		cfg         *node.Config
+		snapshotLog log.Logger
+	)
+	app := cli.NewApp()
+	app.Name = inst.Name
+	app.Flags = flags.Flags
+	app.Action = func(ctx *cli.Context) error {
+		var err error
+		if cfg, err = knode.NewConfig(ctx, logger); err != nil {
+			return err
+		}
+		snapshotLog, err = knode.NewSnapshotLogger(ctx)
+		return err
+	}
+	if err := app.Run(append([]string{inst.Name}, inst.Args...)); err != nil {
+		return nil, nil, fmt.Errorf("invalid config of instance %s: %w", inst.Name, err)
+	}
+	if cfg == nil {
+		return nil, nil, fmt.Errorf("no config parsed for instance %s", inst.Name)
+	}
+	return cfg, snapshotLog, nil
+}
+
+// Main runs all the rollup node instances of the multi-network config in this process, until interrupted.
+// If any instance fails to start, all instances are stopped.
+func Main(version string) cli.ActionFunc {
+	return func(ctx *cli.Context) error {
+		logCfg := klog.ReadCLIConfigV2(ctx)
+		if err := logCfg.Check(); err != nil {
+			log.Error("Unable to create the log config", "error", err)
+			return err
+		}
+		logger := klog.NewLogger(logCfg)
+
+		multiCfg, err := LoadConfig(ctx.String(ConfigFlag.Name))
+		if err != nil {
+			return err
+		}
+
+		var nodes []*node.KromaNode
+		defer func() {
+			var result *multierror.Error
+			for _, n := range nodes {
+				if err := n.Close(); err != nil {
+					result = multierror.Append(result, err)
+				}
+			}
+			if err := result.ErrorOrNil(); err != nil {
+				logger.Error("Failed to close rollup node instances", "err", err)
+			}
+		}()
+
+		for _, inst := range multiCfg.Instances {
+			instLog := logger.New("instance", inst.Name)
+			cfg, snapshotLog, err := instanceConfig(inst, instLog)
+			if err != nil {
+				return err
+			}
+			m := metrics.NewMetrics(inst.Name)
+			n, err := node.New(context.Background(), cfg, instLog, snapshotLog, version, m)
+			if err != nil {
+				return fmt.Errorf("unable to create rollup node instance %s: %w", inst.Name, err)
+			}
+			nodes = append(nodes, n)
+			if err := n.Start(context.Background()); err != nil {
+				return fmt.Errorf("unable to start rollup node instance %s: %w", inst.Name, err)
+			}
+			m.RecordInfo(version)
+			m.RecordUp()
+			instLog.Info("Rollup node instance started", "l2_chain_id", cfg.Rollup.L2ChainID)
+		}
+		logger.Info("All rollup node instances started", "instances", len(nodes))
+
+		interruptChannel := make(chan os.Signal, 1)
+		signal.Notify(interruptChannel, []os.Signal{
+			os.Interrupt,
+			os.Kill,
+			syscall.SIGTERM,
+			syscall.SIGQUIT,
+		}...)
+		// SIGHUP reloads the engine JWT secrets of all instances, to rotate them without a restart.
+		hupChannel := make(chan os.Signal, 1)
+		signal.Notify(hupChannel, syscall.SIGHUP)
+		for {
+			select {
+			case <-hupChannel:
+				for i, n := range nodes {
+					if err := n.ReloadEngineJWTSecret(); err != nil {
+						logger.Error("Unable to reload the engine JWT secret", "instance", multiCfg.Instances[i].Name, "error", err)
+					}
+				}
+			case <-interruptChannel:
+				return nil
+			}
+		}
+	}
+}
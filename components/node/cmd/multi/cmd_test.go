@@ -0,0 +1,31 @@
+package multi
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadConfig(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "multi.json")
+
+	require.NoError(t, os.WriteFile(path, []byte(`{"instances": [
+		{"name": "mainnet", "args": ["--network=mainnet", "--rpc.port=9545"]},
+		{"name": "sepolia", "args": ["--network=sepolia", "--rpc.port=9546"]}
+	]}`), 0o600))
+	cfg, err := LoadConfig(path)
+	require.NoError(t, err)
+	require.Len(t, cfg.Instances, 2)
+	require.Equal(t, "sepolia", cfg.Instances[1].Name)
+	require.Equal(t, []string{"--network=sepolia", "--rpc.port=9546"}, cfg.Instances[1].Args)
+
+	require.NoError(t, os.WriteFile(path, []byte(`{"instances": [{"name": "a"}, {"name": "a"}]}`), 0o600))
+	_, err = LoadConfig(path)
+	require.ErrorContains(t, err, "duplicate instance name")
+
+	require.NoError(t, os.WriteFile(path, []byte(`{"instances": []}`), 0o600))
+	_, err = LoadConfig(path)
+	require.ErrorContains(t, err, "no instances")
+}
@@ -0,0 +1,143 @@
+package replay
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/urfave/cli/v2"
+
+	knode "github.com/kroma-network/kroma/components/node"
+	"github.com/kroma-network/kroma/components/node/altda"
+	"github.com/kroma-network/kroma/components/node/eth"
+	"github.com/kroma-network/kroma/components/node/flags"
+	"github.com/kroma-network/kroma/components/node/metrics"
+	"github.com/kroma-network/kroma/components/node/rollup/derive"
+	"github.com/kroma-network/kroma/components/node/sources"
+	klog "github.com/kroma-network/kroma/utils/service/log"
+)
+
+var (
+	L1StartFlag = &cli.Uint64Flag{
+		Name:     "l1.start",
+		Usage:    "First L1 block (inclusive) to replay the derivation from",
+		Required: true,
+	}
+	L1EndFlag = &cli.Uint64Flag{
+		Name:     "l1.end",
+		Usage:    "Last L1 block (inclusive) to replay the derivation to",
+		Required: true,
+	}
+	OutFlag = &cli.StringFlag{
+		Name:  "out",
+		Usage: "Path to write the replayed batches to. Defaults to stdout, which is shared with the logs",
+	}
+	BatcherAddrFlag = &cli.StringFlag{
+		Name:  "batcher",
+		Usage: "Batcher address at the start block, if it changed since genesis. Defaults to the batcher address of the rollup genesis",
+	}
+)
+
+var Flags = append([]cli.Flag{
+	L1StartFlag,
+	L1EndFlag,
+	OutFlag,
+	BatcherAddrFlag,
+	flags.L1NodeAddr,
+	flags.L1TrustRPC,
+	flags.L1RPCProviderKind,
+	flags.L1RPCRateLimit,
+	flags.L1RPCMaxBatchSize,
+	flags.L1HTTPPollInterval,
+	flags.RollupConfig,
+	flags.Network,
+	flags.NetworkRegistry,
+	flags.AltDAServerFlag,
+}, klog.CLIFlagsV2(flags.EnvVarPrefix)...)
+
+// batchOutput is the JSON representation of a replayed batch.
+type batchOutput struct {
+	L1Block    eth.BlockID `json:"l1Block"`
+	ParentHash common.Hash `json:"parentHash"`
+	EpochNum   uint64      `json:"epochNum"`
+	EpochHash  common.Hash `json:"epochHash"`
+	Timestamp  uint64      `json:"timestamp"`
+	TxCount    int         `json:"txCount"`
+}
+
+// Main runs the L1 stages of the derivation pipeline over the given L1 range, without an execution engine,
+// and prints every batch read from the batch inbox as a JSON line.
+func Main(ctx *cli.Context) error {
+	logCfg := klog.ReadCLIConfigV2(ctx)
+	if err := logCfg.Check(); err != nil {
+		return fmt.Errorf("invalid log config: %w", err)
+	}
+	log := klog.NewLogger(logCfg)
+
+	start, end := ctx.Uint64(L1StartFlag.Name), ctx.Uint64(L1EndFlag.Name)
+	if start > end {
+		return fmt.Errorf("start block %d is after end block %d", start, end)
+	}
+
+	rollupCfg, err := knode.NewRollupConfig(ctx)
+	if err != nil {
+		return err
+	}
+	if start < rollupCfg.Genesis.L1.Number {
+		return fmt.Errorf("start block %d is before the rollup genesis at L1 block %d", start, rollupCfg.Genesis.L1.Number)
+	}
+	sysCfg := rollupCfg.Genesis.SystemConfig
+	if batcher := ctx.String(BatcherAddrFlag.Name); batcher != "" {
+		if !common.IsHexAddress(batcher) {
+			return fmt.Errorf("invalid batcher address: %s", batcher)
+		}
+		sysCfg.BatcherAddr = common.HexToAddress(batcher)
+	} else if start > rollupCfg.Genesis.L1.Number {
+		log.Warn("Replaying with the batcher address of the rollup genesis", "batcher", sysCfg.BatcherAddr)
+	}
+
+	l1Endpoint := knode.NewL1EndpointConfig(ctx)
+	if err := l1Endpoint.Check(); err != nil {
+		return fmt.Errorf("invalid L1 endpoint config: %w", err)
+	}
+	l1RPC, rpcCfg, err := l1Endpoint.Setup(ctx.Context, log, rollupCfg)
+	if err != nil {
+		return fmt.Errorf("failed to get L1 RPC client: %w", err)
+	}
+	defer l1RPC.Close()
+	l1Source, err := sources.NewL1Client(l1RPC, log, nil, rpcCfg)
+	if err != nil {
+		return fmt.Errorf("failed to create L1 source: %w", err)
+	}
+	startRef, err := l1Source.L1BlockRefByNumber(ctx.Context, start)
+	if err != nil {
+		return fmt.Errorf("failed to fetch start block %d: %w", start, err)
+	}
+
+	out := os.Stdout
+	if path := ctx.String(OutFlag.Name); path != "" {
+		out, err = os.Create(path)
+		if err != nil {
+			return fmt.Errorf("failed to create output file: %w", err)
+		}
+		defer out.Close()
+	}
+	var daFetcher derive.AltDAFetcher
+	if server := ctx.String(flags.AltDAServerFlag.Name); server != "" {
+		daFetcher = altda.NewDAClient(server, altda.DefaultTimeout)
+	}
+
+	enc := json.NewEncoder(out)
+	return derive.Replay(ctx.Context, log, rollupCfg, l1Source, daFetcher, metrics.NoopMetrics, startRef, sysCfg, end,
+		func(b derive.ReplayedBatch) error {
+			return enc.Encode(&batchOutput{
+				L1Block:    b.L1Block.ID(),
+				ParentHash: b.Batch.ParentHash,
+				EpochNum:   uint64(b.Batch.EpochNum),
+				EpochHash:  b.Batch.EpochHash,
+				Timestamp:  b.Batch.Timestamp,
+				TxCount:    len(b.Batch.Transactions),
+			})
+		})
+}
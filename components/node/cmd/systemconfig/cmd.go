@@ -0,0 +1,325 @@
+package systemconfig
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"strings"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/urfave/cli/v2"
+
+	"github.com/kroma-network/kroma/bindings/bindings"
+	"github.com/kroma-network/kroma/components/node/flags"
+)
+
+var (
+	L1RPCFlag = &cli.StringFlag{
+		Name:     "l1",
+		Usage:    "Address of the L1 execution client RPC",
+		Required: true,
+	}
+	AddressFlag = &cli.StringFlag{
+		Name:     "address",
+		Usage:    "Address of the SystemConfig proxy",
+		Required: true,
+	}
+	PrivateKeyFlag = &cli.StringFlag{
+		Name:    "private-key",
+		Usage:   "Private key of the SystemConfig owner to send the update with",
+		EnvVars: []string{flags.EnvVarPrefix + "_SYSTEM_CONFIG_PRIVATE_KEY"},
+	}
+	DryRunFlag = &cli.BoolFlag{
+		Name:  "dry-run",
+		Usage: "Simulate the update from the SystemConfig owner without sending it",
+	}
+	MultisigFlag = &cli.BoolFlag{
+		Name:  "multisig",
+		Usage: "Print the update as a Safe transaction builder payload, to be proposed to the multisig that owns SystemConfig",
+	}
+)
+
+var updateFlags = []cli.Flag{
+	L1RPCFlag,
+	AddressFlag,
+	PrivateKeyFlag,
+	DryRunFlag,
+	MultisigFlag,
+}
+
+var Subcommands = cli.Commands{
+	{
+		Name:  "show",
+		Usage: "Prints the current SystemConfig parameters",
+		Flags: []cli.Flag{L1RPCFlag, AddressFlag},
+		Action: func(ctx *cli.Context) error {
+			client, addr, err := dial(ctx)
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+
+			params, err := readParams(ctx.Context, client, addr)
+			if err != nil {
+				return err
+			}
+			return printJSON(params)
+		},
+	},
+	{
+		Name:  "set-gas-config",
+		Usage: "Updates the L1 fee overhead and scalar",
+		Flags: append([]cli.Flag{
+			&cli.Uint64Flag{
+				Name:     "overhead",
+				Usage:    "L1 fee overhead",
+				Required: true,
+			},
+			&cli.Uint64Flag{
+				Name:     "scalar",
+				Usage:    "L1 fee scalar",
+				Required: true,
+			},
+		}, updateFlags...),
+		Action: func(ctx *cli.Context) error {
+			overhead := new(big.Int).SetUint64(ctx.Uint64("overhead"))
+			scalar := new(big.Int).SetUint64(ctx.Uint64("scalar"))
+			return update(ctx, "setGasConfig", overhead, scalar)
+		},
+	},
+	{
+		Name:  "set-batcher-hash",
+		Usage: "Updates the batcher hash, which identifies the batch sender",
+		Flags: append([]cli.Flag{
+			&cli.StringFlag{
+				Name:     "batcher",
+				Usage:    "Address of the batch sender",
+				Required: true,
+			},
+		}, updateFlags...),
+		Action: func(ctx *cli.Context) error {
+			batcher, err := parseAddress(ctx.String("batcher"))
+			if err != nil {
+				return err
+			}
+			return update(ctx, "setBatcherHash", batcher.Hash())
+		},
+	},
+	{
+		Name:  "set-unsafe-block-signer",
+		Usage: "Updates the address that signs the unsafe blocks gossiped on the p2p network",
+		Flags: append([]cli.Flag{
+			&cli.StringFlag{
+				Name:     "signer",
+				Usage:    "Address of the unsafe block signer",
+				Required: true,
+			},
+		}, updateFlags...),
+		Action: func(ctx *cli.Context) error {
+			signer, err := parseAddress(ctx.String("signer"))
+			if err != nil {
+				return err
+			}
+			return update(ctx, "setUnsafeBlockSigner", signer)
+		},
+	},
+}
+
+// Params are the SystemConfig parameters that can be updated by the owner.
+type Params struct {
+	Owner             common.Address `json:"owner"`
+	Overhead          *big.Int       `json:"overhead"`
+	Scalar            *big.Int       `json:"scalar"`
+	BatcherHash       common.Hash    `json:"batcherHash"`
+	UnsafeBlockSigner common.Address `json:"unsafeBlockSigner"`
+}
+
+// safeBatch is the payload of the Safe transaction builder.
+type safeBatch struct {
+	Version      string   `json:"version"`
+	ChainID      string   `json:"chainId"`
+	Meta         safeMeta `json:"meta"`
+	Transactions []safeTx `json:"transactions"`
+}
+
+type safeMeta struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+type safeTx struct {
+	To    common.Address `json:"to"`
+	Value string         `json:"value"`
+	Data  hexutil.Bytes  `json:"data"`
+}
+
+func dial(ctx *cli.Context) (*ethclient.Client, common.Address, error) {
+	addr, err := parseAddress(ctx.String(AddressFlag.Name))
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+	client, err := ethclient.DialContext(ctx.Context, ctx.String(L1RPCFlag.Name))
+	if err != nil {
+		return nil, common.Address{}, fmt.Errorf("failed to dial L1 RPC: %w", err)
+	}
+	return client, addr, nil
+}
+
+func parseAddress(s string) (common.Address, error) {
+	if !common.IsHexAddress(s) {
+		return common.Address{}, fmt.Errorf("invalid address: %s", s)
+	}
+	return common.HexToAddress(s), nil
+}
+
+func readParams(ctx context.Context, client *ethclient.Client, addr common.Address) (*Params, error) {
+	sysCfg, err := bindings.NewSystemConfigCaller(addr, client)
+	if err != nil {
+		return nil, err
+	}
+	opts := &bind.CallOpts{Context: ctx}
+
+	var params Params
+	if params.Owner, err = sysCfg.Owner(opts); err != nil {
+		return nil, fmt.Errorf("failed to get owner: %w", err)
+	}
+	if params.Overhead, err = sysCfg.Overhead(opts); err != nil {
+		return nil, fmt.Errorf("failed to get overhead: %w", err)
+	}
+	if params.Scalar, err = sysCfg.Scalar(opts); err != nil {
+		return nil, fmt.Errorf("failed to get scalar: %w", err)
+	}
+	batcherHash, err := sysCfg.BatcherHash(opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get batcher hash: %w", err)
+	}
+	params.BatcherHash = batcherHash
+	if params.UnsafeBlockSigner, err = sysCfg.UnsafeBlockSigner(opts); err != nil {
+		return nil, fmt.Errorf("failed to get unsafe block signer: %w", err)
+	}
+	return &params, nil
+}
+
+// update calls the setter of SystemConfig with the arguments, in the mode selected by the flags: it prints the
+// payload for the multisig owner, simulates the call from the owner, or sends the transaction with the owner key.
+func update(ctx *cli.Context, method string, args ...interface{}) error {
+	if ctx.Bool(DryRunFlag.Name) && ctx.Bool(MultisigFlag.Name) {
+		return errors.New("only one of --dry-run and --multisig can be set")
+	}
+
+	sysCfgABI, err := bindings.SystemConfigMetaData.GetAbi()
+	if err != nil {
+		return err
+	}
+	data, err := sysCfgABI.Pack(method, args...)
+	if err != nil {
+		return fmt.Errorf("failed to encode %s: %w", method, err)
+	}
+
+	client, addr, err := dial(ctx)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	before, err := readParams(ctx.Context, client, addr)
+	if err != nil {
+		return err
+	}
+	log.Info("Updating SystemConfig", "method", method, "args", formatArgs(sysCfgABI, method, args), "owner", before.Owner)
+
+	// every mode simulates the call from the owner first, so that an update that would revert is not proposed
+	if _, err := client.CallContract(ctx.Context, ethereum.CallMsg{From: before.Owner, To: &addr, Data: data}, nil); err != nil {
+		return fmt.Errorf("%s reverts: %w", method, bindings.WrapRevertError(err))
+	}
+
+	switch {
+	case ctx.Bool(DryRunFlag.Name):
+		gas, err := client.EstimateGas(ctx.Context, ethereum.CallMsg{From: before.Owner, To: &addr, Data: data})
+		if err != nil {
+			return fmt.Errorf("failed to estimate gas: %w", bindings.WrapRevertError(err))
+		}
+		log.Info("Dry run succeeded", "method", method, "gas", gas)
+		return printJSON(safeTx{To: addr, Value: "0", Data: data})
+	case ctx.Bool(MultisigFlag.Name):
+		chainID, err := client.ChainID(ctx.Context)
+		if err != nil {
+			return fmt.Errorf("failed to get L1 chain ID: %w", err)
+		}
+		return printJSON(safeBatch{
+			Version: "1.0",
+			ChainID: chainID.String(),
+			Meta: safeMeta{
+				Name:        "SystemConfig " + method,
+				Description: fmt.Sprintf("%s(%s) on SystemConfig %s", method, formatArgs(sysCfgABI, method, args), addr),
+			},
+			Transactions: []safeTx{{To: addr, Value: "0", Data: data}},
+		})
+	}
+
+	if ctx.String(PrivateKeyFlag.Name) == "" {
+		return errors.New("--private-key is required to send the update, or use --dry-run or --multisig")
+	}
+	key, err := crypto.HexToECDSA(strings.TrimPrefix(ctx.String(PrivateKeyFlag.Name), "0x"))
+	if err != nil {
+		return fmt.Errorf("invalid private key: %w", err)
+	}
+	if from := crypto.PubkeyToAddress(key.PublicKey); from != before.Owner {
+		return fmt.Errorf("%s is not the SystemConfig owner %s", from, before.Owner)
+	}
+	chainID, err := client.ChainID(ctx.Context)
+	if err != nil {
+		return fmt.Errorf("failed to get L1 chain ID: %w", err)
+	}
+	txOpts, err := bind.NewKeyedTransactorWithChainID(key, chainID)
+	if err != nil {
+		return err
+	}
+	txOpts.Context = ctx.Context
+
+	tx, err := bind.NewBoundContract(addr, *sysCfgABI, client, client, client).RawTransact(txOpts, data)
+	if err != nil {
+		return fmt.Errorf("failed to send %s: %w", method, bindings.WrapRevertError(err))
+	}
+	log.Info("Sent SystemConfig update", "txHash", tx.Hash())
+	receipt, err := bind.WaitMined(ctx.Context, client, tx)
+	if err != nil {
+		return err
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return fmt.Errorf("transaction %s reverted", tx.Hash())
+	}
+
+	after, err := readParams(ctx.Context, client, addr)
+	if err != nil {
+		return err
+	}
+	return printJSON(after)
+}
+
+// formatArgs formats the arguments of the method with their names.
+func formatArgs(parsed *abi.ABI, method string, args []interface{}) string {
+	inputs := parsed.Methods[method].Inputs
+	formatted := make([]string, len(args))
+	for i, arg := range args {
+		formatted[i] = fmt.Sprintf("%s=%v", strings.TrimPrefix(inputs[i].Name, "_"), arg)
+	}
+	return strings.Join(formatted, ", ")
+}
+
+func printJSON(v any) error {
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
@@ -0,0 +1,263 @@
+package withdraw
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/ethclient/gethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/urfave/cli/v2"
+
+	"github.com/kroma-network/kroma/bindings/bindings"
+	"github.com/kroma-network/kroma/components/node/client"
+	"github.com/kroma-network/kroma/components/node/flags"
+	"github.com/kroma-network/kroma/components/node/rollup"
+	"github.com/kroma-network/kroma/components/node/sources"
+	"github.com/kroma-network/kroma/components/node/withdrawals"
+	klog "github.com/kroma-network/kroma/utils/service/log"
+)
+
+var (
+	L1RPCFlag = &cli.StringFlag{
+		Name:     "l1",
+		Usage:    "Address of the L1 execution client RPC",
+		Required: true,
+	}
+	L2RPCFlag = &cli.StringFlag{
+		Name:     "l2",
+		Usage:    "Address of the L2 execution client RPC, to generate the storage proof of the withdrawal with",
+		Required: true,
+	}
+	RollupRPCFlag = &cli.StringFlag{
+		Name:     "rollup-rpc",
+		Usage:    "Address of the kroma-node RPC, to get the rollup config from",
+		Required: true,
+	}
+	PortalAddrFlag = &cli.StringFlag{
+		Name:     "portal-address",
+		Usage:    "Address of the KromaPortal contract",
+		Required: true,
+	}
+	TxHashFlag = &cli.StringFlag{
+		Name:     "tx-hash",
+		Usage:    "Hash of the L2 transaction that initiated the withdrawal",
+		Required: true,
+	}
+	PrivateKeyFlag = &cli.StringFlag{
+		Name:     "private-key",
+		Usage:    "Private key of the L1 account to send the prove and finalize transactions with",
+		EnvVars:  []string{flags.EnvVarPrefix + "_WITHDRAW_PRIVATE_KEY"},
+		Required: true,
+	}
+)
+
+var Flags = append([]cli.Flag{
+	L1RPCFlag,
+	L2RPCFlag,
+	RollupRPCFlag,
+	PortalAddrFlag,
+	TxHashFlag,
+	PrivateKeyFlag,
+}, klog.CLIFlagsV2(flags.EnvVarPrefix)...)
+
+// Main runs the full exit flow of the withdrawal initiated by the given L2 transaction: it waits for the output
+// that includes the withdrawal, proves the withdrawal against it, waits for the finalization period, and
+// finalizes the withdrawal. The steps that are done already are skipped, so that the command can be resumed.
+func Main(ctx *cli.Context) error {
+	logCfg := klog.ReadCLIConfigV2(ctx)
+	if err := logCfg.Check(); err != nil {
+		return fmt.Errorf("invalid log config: %w", err)
+	}
+	log := klog.NewLogger(logCfg)
+
+	if !common.IsHexAddress(ctx.String(PortalAddrFlag.Name)) {
+		return fmt.Errorf("invalid portal address: %s", ctx.String(PortalAddrFlag.Name))
+	}
+	portalAddr := common.HexToAddress(ctx.String(PortalAddrFlag.Name))
+	txHash := common.HexToHash(ctx.String(TxHashFlag.Name))
+
+	key, err := crypto.HexToECDSA(strings.TrimPrefix(ctx.String(PrivateKeyFlag.Name), "0x"))
+	if err != nil {
+		return fmt.Errorf("invalid private key: %w", err)
+	}
+
+	l1Client, err := ethclient.DialContext(ctx.Context, ctx.String(L1RPCFlag.Name))
+	if err != nil {
+		return fmt.Errorf("failed to dial L1 RPC: %w", err)
+	}
+	defer l1Client.Close()
+
+	l2RPC, err := rpc.DialContext(ctx.Context, ctx.String(L2RPCFlag.Name))
+	if err != nil {
+		return fmt.Errorf("failed to dial L2 RPC: %w", err)
+	}
+	defer l2RPC.Close()
+	l2Client := ethclient.NewClient(l2RPC)
+
+	rollupRPC, err := rpc.DialContext(ctx.Context, ctx.String(RollupRPCFlag.Name))
+	if err != nil {
+		return fmt.Errorf("failed to dial rollup RPC: %w", err)
+	}
+	defer rollupRPC.Close()
+	rollupCfg, err := sources.NewRollupClient(client.NewBaseRPCClient(rollupRPC)).RollupConfig(ctx.Context)
+	if err != nil {
+		return fmt.Errorf("failed to get rollup config: %w", err)
+	}
+
+	portal, err := bindings.NewKromaPortal(portalAddr, l1Client)
+	if err != nil {
+		return err
+	}
+	l2OutputOracleAddr, err := portal.L2ORACLE(&bind.CallOpts{Context: ctx.Context})
+	if err != nil {
+		return fmt.Errorf("failed to get L2OutputOracle address: %w", err)
+	}
+	l2OutputOracle, err := bindings.NewL2OutputOracleCaller(l2OutputOracleAddr, l1Client)
+	if err != nil {
+		return err
+	}
+
+	chainID, err := l1Client.ChainID(ctx.Context)
+	if err != nil {
+		return fmt.Errorf("failed to get L1 chain ID: %w", err)
+	}
+	txOpts, err := bind.NewKeyedTransactorWithChainID(key, chainID)
+	if err != nil {
+		return err
+	}
+	txOpts.Context = ctx.Context
+
+	receipt, err := l2Client.TransactionReceipt(ctx.Context, txHash)
+	if err != nil {
+		return fmt.Errorf("failed to get withdrawal receipt: %w", err)
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return fmt.Errorf("withdrawal transaction %s failed on L2", txHash)
+	}
+	ev, err := withdrawals.ParseMessagePassed(receipt)
+	if err != nil {
+		return err
+	}
+	withdrawalHash := common.Hash(ev.WithdrawalHash)
+	log = log.New("withdrawalHash", withdrawalHash)
+
+	finalized, err := portal.FinalizedWithdrawals(&bind.CallOpts{Context: ctx.Context}, withdrawalHash)
+	if err != nil {
+		return fmt.Errorf("failed to get if withdrawal is finalized: %w", err)
+	}
+	if finalized {
+		log.Info("Withdrawal is already finalized")
+		return nil
+	}
+
+	proven, err := portal.ProvenWithdrawals(&bind.CallOpts{Context: ctx.Context}, withdrawalHash)
+	if err != nil {
+		return fmt.Errorf("failed to get proven withdrawal: %w", err)
+	}
+	if proven.Timestamp.Sign() == 0 {
+		log.Info("Waiting for the output that includes the withdrawal", "l2BlockNumber", receipt.BlockNumber)
+		outputBlockNumber, err := withdrawals.WaitForOutputBlock(ctx.Context, l1Client, portalAddr, receipt.BlockNumber)
+		if err != nil {
+			return fmt.Errorf("failed to wait for output: %w", err)
+		}
+
+		params, err := proveWithdrawalParameters(ctx.Context, rollupCfg, l2RPC, l2Client, txHash, outputBlockNumber, l2OutputOracle)
+		if err != nil {
+			return fmt.Errorf("failed to generate withdrawal proof: %w", err)
+		}
+
+		log.Info("Proving withdrawal", "outputIndex", params.L2OutputIndex, "outputBlockNumber", outputBlockNumber)
+		tx, err := portal.ProveWithdrawalTransaction(txOpts, withdrawalTransaction(ev), params.L2OutputIndex, params.OutputRootProof, params.WithdrawalProof)
+		if err != nil {
+			return fmt.Errorf("failed to send prove transaction: %w", err)
+		}
+		if err := waitForSuccess(ctx.Context, l1Client, tx); err != nil {
+			return fmt.Errorf("failed to prove withdrawal: %w", err)
+		}
+		log.Info("Proved withdrawal", "txHash", tx.Hash())
+
+		proven, err = portal.ProvenWithdrawals(&bind.CallOpts{Context: ctx.Context}, withdrawalHash)
+		if err != nil {
+			return fmt.Errorf("failed to get proven withdrawal: %w", err)
+		}
+	} else {
+		log.Info("Withdrawal is already proven", "outputIndex", proven.L2OutputIndex)
+	}
+
+	// The output that the withdrawal is proven against is submitted before the withdrawal is proven,
+	// so the finalization period of the proven withdrawal ends later.
+	finalizationPeriod, err := l2OutputOracle.FINALIZATIONPERIODSECONDS(&bind.CallOpts{Context: ctx.Context})
+	if err != nil {
+		return fmt.Errorf("failed to get finalization period: %w", err)
+	}
+	finalizableAt := new(big.Int).Add(proven.Timestamp, finalizationPeriod).Uint64()
+	log.Info("Waiting for the finalization period", "until", finalizableAt)
+	if err := withdrawals.WaitForL1Timestamp(ctx.Context, l1Client, finalizableAt); err != nil {
+		return fmt.Errorf("failed to wait for finalization period: %w", err)
+	}
+
+	log.Info("Finalizing withdrawal")
+	tx, err := portal.FinalizeWithdrawalTransaction(txOpts, withdrawalTransaction(ev))
+	if err != nil {
+		return fmt.Errorf("failed to send finalize transaction: %w", err)
+	}
+	if err := waitForSuccess(ctx.Context, l1Client, tx); err != nil {
+		return fmt.Errorf("failed to finalize withdrawal: %w", err)
+	}
+	log.Info("Finalized withdrawal", "txHash", tx.Hash())
+
+	return nil
+}
+
+// proveWithdrawalParameters generates the proof of the withdrawal against the output at the given L2 block number.
+func proveWithdrawalParameters(
+	ctx context.Context,
+	rollupCfg *rollup.Config,
+	l2RPC *rpc.Client,
+	l2Client *ethclient.Client,
+	txHash common.Hash,
+	outputBlockNumber *big.Int,
+	l2OutputOracle *bindings.L2OutputOracleCaller,
+) (withdrawals.ProvenWithdrawalParameters, error) {
+	header, err := l2Client.HeaderByNumber(ctx, outputBlockNumber)
+	if err != nil {
+		return withdrawals.ProvenWithdrawalParameters{}, fmt.Errorf("failed to get header of output block: %w", err)
+	}
+	nextHeader, err := l2Client.HeaderByNumber(ctx, new(big.Int).Add(outputBlockNumber, common.Big1))
+	if err != nil {
+		return withdrawals.ProvenWithdrawalParameters{}, fmt.Errorf("failed to get header of next block: %w", err)
+	}
+	version := rollup.L2OutputRootVersion(rollupCfg, header.Time)
+	return withdrawals.ProveWithdrawalParameters(ctx, version, gethclient.New(l2RPC), l2Client, txHash, header, nextHeader, l2OutputOracle)
+}
+
+func withdrawalTransaction(ev *bindings.L2ToL1MessagePasserMessagePassed) bindings.TypesWithdrawalTransaction {
+	return bindings.TypesWithdrawalTransaction{
+		Nonce:    ev.Nonce,
+		Sender:   ev.Sender,
+		Target:   ev.Target,
+		Value:    ev.Value,
+		GasLimit: ev.GasLimit,
+		Data:     ev.Data,
+	}
+}
+
+// waitForSuccess waits for the transaction to be included, and returns an error if it reverted.
+func waitForSuccess(ctx context.Context, l1Client *ethclient.Client, tx *types.Transaction) error {
+	receipt, err := bind.WaitMined(ctx, l1Client, tx)
+	if err != nil {
+		return err
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return errors.New("transaction reverted")
+	}
+	return nil
+}
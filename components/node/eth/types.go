@@ -235,6 +235,15 @@ type PayloadAttributes struct {
 	GasLimit *Uint64Quantity `json:"gasLimit,omitempty"`
 }
 
+// PayloadAttributesEvent is published by the proposer whenever it starts building a new block,
+// so external block builders can build a payload with the same attributes.
+type PayloadAttributesEvent struct {
+	// the L2 block the new payload builds on top of
+	Parent L2BlockRef `json:"parent"`
+	// the attributes of the new payload
+	Attributes *PayloadAttributes `json:"attributes"`
+}
+
 type ExecutePayloadStatus string
 
 const (
@@ -8,6 +8,7 @@ import (
 	"github.com/urfave/cli/v2"
 
 	"github.com/kroma-network/kroma/components/node/chaincfg"
+	"github.com/kroma-network/kroma/components/node/heartbeat"
 	"github.com/kroma-network/kroma/components/node/sources"
 	klog "github.com/kroma-network/kroma/utils/service/log"
 )
@@ -43,6 +44,11 @@ var (
 		Usage:   fmt.Sprintf("Predefined network selection. Available networks: %s", strings.Join(chaincfg.AvailableNetworks(), ", ")),
 		EnvVars: prefixEnvVars("NETWORK"),
 	}
+	NetworkRegistry = &cli.StringFlag{
+		Name:    "network.registry",
+		Usage:   "URL of a rollup config registry to fetch the rollup config of the selected network from, serving <registry>/<network>/rollup.json. Falls back to the predefined networks if not set.",
+		EnvVars: prefixEnvVars("NETWORK_REGISTRY"),
+	}
 	RPCListenAddr = &cli.StringFlag{
 		Name:    "rpc.addr",
 		Usage:   "RPC listening address",
@@ -60,6 +66,18 @@ var (
 	}
 
 	/* Optional Flags */
+	RPCOutputCacheSize = &cli.IntFlag{
+		Name:    "rpc.output-cache-size",
+		Usage:   "Number of computed output roots to cache in memory, to serve repeated output queries without fetching proofs again. Disabled if 0.",
+		EnvVars: prefixEnvVars("RPC_OUTPUT_CACHE_SIZE"),
+		Value:   1000,
+	}
+	RPCOutputCachePath = &cli.StringFlag{
+		Name:      "rpc.output-cache-path",
+		Usage:     "Path of the database to persist output roots of finalized blocks in. Output roots are only cached in memory if empty.",
+		EnvVars:   prefixEnvVars("RPC_OUTPUT_CACHE_PATH"),
+		TakesFile: true,
+	}
 	L1TrustRPC = &cli.BoolFlag{
 		Name:    "l1.trustrpc",
 		Usage:   "Trust the L1 RPC, sync faster at risk of malicious/buggy RPC providing bad or inconsistent L1 data",
@@ -101,6 +119,12 @@ var (
 		Value:       "",
 		Destination: new(string),
 	}
+	L2EngineSecondaryAddrs = &cli.StringSliceFlag{
+		Name: "l2.secondary",
+		Usage: "Addresses of secondary L2 Engine JSON-RPC endpoints, sharing the JWT secret of the primary. " +
+			"Engine state changes are forwarded to all engines, and reads fail over to the secondaries if the primary is unavailable.",
+		EnvVars: prefixEnvVars("L2_ENGINE_SECONDARY_RPC"),
+	}
 	SyncerL1Confs = &cli.Uint64Flag{
 		Name:     "syncer.l1-confs",
 		Usage:    "Number of L1 blocks to keep distance from the L1 head before deriving L2 data from. Reorgs are supported, but may be slow to perform.",
@@ -132,6 +156,17 @@ var (
 		Required: false,
 		Value:    4,
 	}
+	ProposerBuilderAddr = &cli.StringFlag{
+		Name:    "proposer.builder",
+		Usage:   "Address of an external block builder RPC to delegate block building to. The local engine is used as fallback.",
+		EnvVars: prefixEnvVars("PROPOSER_BUILDER"),
+	}
+	ProposerBuilderTimeout = &cli.DurationFlag{
+		Name:    "proposer.builder-timeout",
+		Usage:   "Maximum time to wait for the external block builder, before falling back to the block built by the local engine.",
+		EnvVars: prefixEnvVars("PROPOSER_BUILDER_TIMEOUT"),
+		Value:   time.Second,
+	}
 	L1EpochPollIntervalFlag = &cli.DurationFlag{
 		Name:     "l1.epoch-poll-interval",
 		Usage:    "Poll interval for retrieving new L1 epoch updates such as safe and finalized block changes. Disabled if 0 or negative.",
@@ -156,6 +191,16 @@ var (
 		Value:   7300,
 		EnvVars: prefixEnvVars("METRICS_PORT"),
 	}
+	MetricsMaxUnsafeSafeLagFlag = &cli.DurationFlag{
+		Name:    "metrics.max-unsafe-safe-lag",
+		Usage:   "Maximum lag between the unsafe and the safe L2 head in L2 block time, before the node is reported as degraded. Disabled if 0",
+		EnvVars: prefixEnvVars("METRICS_MAX_UNSAFE_SAFE_LAG"),
+	}
+	MetricsMaxSafeFinalizedLagFlag = &cli.DurationFlag{
+		Name:    "metrics.max-safe-finalized-lag",
+		Usage:   "Maximum lag between the safe and the finalized L2 head in L2 block time, before the node is reported as degraded. Disabled if 0",
+		EnvVars: prefixEnvVars("METRICS_MAX_SAFE_FINALIZED_LAG"),
+	}
 	PprofEnabledFlag = &cli.BoolFlag{
 		Name:    "pprof.enabled",
 		Usage:   "Enable the pprof server",
@@ -194,6 +239,23 @@ var (
 		EnvVars: prefixEnvVars("HEARTBEAT_URL"),
 		Value:   "https://heartbeat.kroma-main.io",
 	}
+	HeartbeatIntervalFlag = &cli.DurationFlag{
+		Name:    "heartbeat.interval",
+		Usage:   "Sets the interval between heartbeats",
+		EnvVars: prefixEnvVars("HEARTBEAT_INTERVAL"),
+		Value:   heartbeat.SendInterval,
+	}
+	HeartbeatFieldsFlag = &cli.StringSliceFlag{
+		Name:    "heartbeat.fields",
+		Usage:   fmt.Sprintf("Sets the optional fields to report in heartbeats. Available fields: %s", strings.Join(heartbeatFieldNames(heartbeat.AvailableFields), ", ")),
+		EnvVars: prefixEnvVars("HEARTBEAT_FIELDS"),
+		Value:   cli.NewStringSlice(heartbeatFieldNames(heartbeat.DefaultFields)...),
+	}
+	AltDAServerFlag = &cli.StringFlag{
+		Name:    "altda.server",
+		Usage:   "HTTP address of the DA server to fetch the data of alt-DA commitments from. Required once alt-DA commitments are scheduled.",
+		EnvVars: prefixEnvVars("ALTDA_SERVER"),
+	}
 	BackupL2UnsafeSyncRPC = &cli.StringFlag{
 		Name:     "l2.backup-unsafe-sync-rpc",
 		Usage:    "Set the backup L2 unsafe sync RPC endpoint.",
@@ -219,22 +281,30 @@ var requiredFlags = []cli.Flag{
 var optionalFlags = []cli.Flag{
 	RollupConfig,
 	Network,
+	NetworkRegistry,
 	L1TrustRPC,
 	L1RPCProviderKind,
 	L1RPCRateLimit,
 	L1RPCMaxBatchSize,
 	L1HTTPPollInterval,
 	L2EngineJWTSecret,
+	L2EngineSecondaryAddrs,
 	SyncerL1Confs,
 	ProposerEnabledFlag,
 	ProposerStoppedFlag,
 	ProposerMaxSafeLagFlag,
 	ProposerL1Confs,
+	ProposerBuilderAddr,
+	ProposerBuilderTimeout,
 	L1EpochPollIntervalFlag,
 	RPCEnableAdmin,
+	RPCOutputCacheSize,
+	RPCOutputCachePath,
 	MetricsEnabledFlag,
 	MetricsAddrFlag,
 	MetricsPortFlag,
+	MetricsMaxUnsafeSafeLagFlag,
+	MetricsMaxSafeFinalizedLagFlag,
 	PprofEnabledFlag,
 	PprofAddrFlag,
 	PprofPortFlag,
@@ -242,13 +312,24 @@ var optionalFlags = []cli.Flag{
 	HeartbeatEnabledFlag,
 	HeartbeatMonikerFlag,
 	HeartbeatURLFlag,
+	HeartbeatIntervalFlag,
+	HeartbeatFieldsFlag,
 	BackupL2UnsafeSyncRPC,
 	BackupL2UnsafeSyncRPCTrustRPC,
+	AltDAServerFlag,
 }
 
 // Flags contains the list of configuration options available to the binary.
 var Flags []cli.Flag
 
+func heartbeatFieldNames(fields []heartbeat.Field) []string {
+	names := make([]string, len(fields))
+	for i, f := range fields {
+		names[i] = string(f)
+	}
+	return names
+}
+
 func init() {
 	optionalFlags = append(optionalFlags, p2pFlags...)
 	optionalFlags = append(optionalFlags, klog.CLIFlagsV2(EnvVarPrefix)...)
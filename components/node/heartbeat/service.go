@@ -15,12 +15,59 @@ import (
 // SendInterval determines the delay between requests. This must be larger than the MinHeartbeatInterval in the server.
 const SendInterval = 10 * time.Minute
 
+// Field is an optional field of the heartbeat payload, which operators can opt in to report.
+type Field string
+
+const (
+	FieldVersion   Field = "version"
+	FieldChainID   Field = "chain_id"
+	FieldPeerCount Field = "peer_count"
+	FieldSyncState Field = "sync_state"
+)
+
+// AvailableFields are all the optional fields of the heartbeat payload.
+var AvailableFields = []Field{FieldVersion, FieldChainID, FieldPeerCount, FieldSyncState}
+
+// DefaultFields are the optional fields reported by default, matching the payload of the public heartbeat server.
+var DefaultFields = []Field{FieldVersion, FieldChainID}
+
+func ParseField(s string) (Field, error) {
+	for _, f := range AvailableFields {
+		if string(f) == s {
+			return f, nil
+		}
+	}
+	return "", fmt.Errorf("unknown heartbeat field %q", s)
+}
+
 type Payload struct {
-	Version string `json:"version"`
-	Meta    string `json:"meta"`
-	Moniker string `json:"moniker"`
-	PeerID  string `json:"peerID"`
-	ChainID uint64 `json:"chainID"`
+	Version   string     `json:"version,omitempty"`
+	Meta      string     `json:"meta,omitempty"`
+	Moniker   string     `json:"moniker"`
+	PeerID    string     `json:"peerID"`
+	ChainID   uint64     `json:"chainID,omitempty"`
+	PeerCount *int       `json:"peerCount,omitempty"`
+	SyncState *SyncState `json:"syncState,omitempty"`
+}
+
+// SyncState is a summary of the sync status of the node.
+type SyncState struct {
+	CurrentL1   uint64 `json:"currentL1"`
+	HeadL1      uint64 `json:"headL1"`
+	UnsafeL2    uint64 `json:"unsafeL2"`
+	SafeL2      uint64 `json:"safeL2"`
+	FinalizedL2 uint64 `json:"finalizedL2"`
+}
+
+// Collector provides the payload of each heartbeat.
+// It is called before every heartbeat, so the payload can report the live state of the node.
+type Collector func(ctx context.Context) (*Payload, error)
+
+type Config struct {
+	// URL of the collector to send heartbeats to
+	URL string
+	// Interval between heartbeats. SendInterval is used if zero.
+	Interval time.Duration
 }
 
 // Beat sends a heartbeat to the server at the given URL. It will send a heartbeat immediately, and then every SendInterval.
@@ -31,9 +78,17 @@ func Beat(
 	url string,
 	payload *Payload,
 ) error {
-	payloadJSON, err := json.Marshal(payload)
-	if err != nil {
-		return fmt.Errorf("telemetry crashed: %w", err)
+	return Run(ctx, log, Config{URL: url}, func(context.Context) (*Payload, error) {
+		return payload, nil
+	})
+}
+
+// Run sends a heartbeat with the payload provided by the collector to the configured URL.
+// It will send a heartbeat immediately, and then every configured interval, until the context is canceled.
+func Run(ctx context.Context, log log.Logger, cfg Config, collect Collector) error {
+	interval := cfg.Interval
+	if interval == 0 {
+		interval = SendInterval
 	}
 
 	client := &http.Client{
@@ -41,13 +96,23 @@ func Beat(
 	}
 
 	send := func() {
-		req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewReader(payloadJSON))
-		req.Header.Set("User-Agent", fmt.Sprintf("kroma-node/%s", payload.Version))
-		req.Header.Set("Content-Type", "application/json")
+		payload, err := collect(ctx)
+		if err != nil {
+			log.Warn("error collecting heartbeat payload", "err", err)
+			return
+		}
+		payloadJSON, err := json.Marshal(payload)
+		if err != nil {
+			log.Error("error encoding heartbeat payload", "err", err)
+			return
+		}
+		req, err := http.NewRequestWithContext(ctx, "POST", cfg.URL, bytes.NewReader(payloadJSON))
 		if err != nil {
 			log.Error("error creating heartbeat HTTP request", "err", err)
 			return
 		}
+		req.Header.Set("User-Agent", fmt.Sprintf("kroma-node/%s", payload.Version))
+		req.Header.Set("Content-Type", "application/json")
 		res, err := client.Do(req)
 		if err != nil {
 			log.Warn("error sending heartbeat", "err", err)
@@ -64,7 +129,7 @@ func Beat(
 	}
 
 	send()
-	tick := time.NewTicker(SendInterval)
+	tick := time.NewTicker(interval)
 	defer tick.Stop()
 	for {
 		select {
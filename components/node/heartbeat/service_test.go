@@ -2,6 +2,7 @@ package heartbeat
 
 import (
 	"context"
+	"fmt"
 	"io"
 	"net/http"
 	"net/http/httptest"
@@ -55,3 +56,52 @@ func TestBeat(t *testing.T) {
 		t.Fatalf("error: %v", ctx.Err())
 	}
 }
+
+func TestRun(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+
+	reqCh := make(chan string, 10)
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(204)
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		reqCh <- string(body)
+		r.Body.Close()
+	}))
+	defer s.Close()
+
+	beats := 0
+	collect := func(ctx context.Context) (*Payload, error) {
+		beats++
+		peerCount := beats
+		return &Payload{
+			Moniker:   "yeet",
+			PeerID:    "1UiUfoobar",
+			PeerCount: &peerCount,
+			SyncState: &SyncState{UnsafeL2: uint64(beats)},
+		}, nil
+	}
+
+	doneCh := make(chan struct{})
+	go func() {
+		_ = Run(ctx, log.Root(), Config{URL: s.URL, Interval: 10 * time.Millisecond}, collect)
+		doneCh <- struct{}{}
+	}()
+
+	for i := 1; i <= 2; i++ {
+		select {
+		case hb := <-reqCh:
+			require.JSONEq(t, fmt.Sprintf(`{
+				"moniker": "yeet",
+				"peerID": "1UiUfoobar",
+				"peerCount": %d,
+				"syncState": {"currentL1": 0, "headL1": 0, "unsafeL2": %d, "safeL2": 0, "finalizedL2": 0}
+			}`, i, i), hb)
+		case <-ctx.Done():
+			t.Fatalf("error: %v", ctx.Err())
+		}
+	}
+	cancel()
+	<-doneCh
+}
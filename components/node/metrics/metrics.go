@@ -52,6 +52,8 @@ type Metricer interface {
 	RecordUnsafePayloadsBuffer(length uint64, memSize uint64, next eth.BlockID)
 	CountSequencedTxs(count int)
 	RecordL1ReorgDepth(d uint64)
+	RecordHeadLag(name string, blocks uint64, seconds uint64)
+	SetDegraded(degraded bool)
 	RecordProposerInconsistentL1Origin(from eth.BlockID, to eth.BlockID)
 	RecordProposerReset()
 	RecordGossipEvent(evType int32)
@@ -88,6 +90,7 @@ type Metrics struct {
 
 	L1SourceCache *CacheMetrics
 	L2SourceCache *CacheMetrics
+	OutputCache   *CacheMetrics
 
 	DerivationIdle prometheus.Gauge
 
@@ -128,6 +131,10 @@ type Metrics struct {
 
 	L1ReorgDepth prometheus.Histogram
 
+	HeadLagBlocks  *prometheus.GaugeVec
+	HeadLagSeconds *prometheus.GaugeVec
+	Degraded       prometheus.Gauge
+
 	TransactionsSequencedTotal prometheus.Counter
 
 	// P2P Metrics
@@ -220,6 +227,7 @@ func NewMetrics(procName string) *Metrics {
 
 		L1SourceCache: NewCacheMetrics(factory, ns, "l1_source_cache", "L1 Source cache"),
 		L2SourceCache: NewCacheMetrics(factory, ns, "l2_source_cache", "L2 Source cache"),
+		OutputCache:   NewCacheMetrics(factory, ns, "output_cache", "Output root cache"),
 
 		DerivationIdle: factory.NewGauge(prometheus.GaugeOpts{
 			Namespace: ns,
@@ -295,6 +303,26 @@ func NewMetrics(procName string) *Metrics {
 			Help:      "Histogram of L1 Reorg Depths",
 		}),
 
+		HeadLagBlocks: factory.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: ns,
+			Name:      "head_lag_blocks",
+			Help:      "Lag between L2 heads in blocks, e.g. unsafe_safe is the number of unsafe blocks that are not safe yet",
+		}, []string{
+			"type",
+		}),
+		HeadLagSeconds: factory.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: ns,
+			Name:      "head_lag_seconds",
+			Help:      "Lag between L2 heads in seconds of L2 block time",
+		}, []string{
+			"type",
+		}),
+		Degraded: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: ns,
+			Name:      "node_degraded",
+			Help:      "1 if a lag between L2 heads exceeds its configured threshold",
+		}),
+
 		TransactionsSequencedTotal: factory.NewGauge(prometheus.GaugeOpts{
 			Namespace: ns,
 			Name:      "transactions_sequenced_total",
@@ -589,6 +617,19 @@ func (m *Metrics) RecordL1ReorgDepth(d uint64) {
 	m.L1ReorgDepth.Observe(float64(d))
 }
 
+func (m *Metrics) RecordHeadLag(name string, blocks uint64, seconds uint64) {
+	m.HeadLagBlocks.WithLabelValues(name).Set(float64(blocks))
+	m.HeadLagSeconds.WithLabelValues(name).Set(float64(seconds))
+}
+
+func (m *Metrics) SetDegraded(degraded bool) {
+	var val float64
+	if degraded {
+		val = 1
+	}
+	m.Degraded.Set(val)
+}
+
 func (m *Metrics) RecordProposerInconsistentL1Origin(from eth.BlockID, to eth.BlockID) {
 	m.ProposerInconsistentL1Origin.RecordEvent()
 	m.recordRef("l1_origin", "inconsistent_from", from.Number, 0, from.Hash)
@@ -780,6 +821,12 @@ func (n *noopMetricer) CountSequencedTxs(count int) {
 func (n *noopMetricer) RecordL1ReorgDepth(d uint64) {
 }
 
+func (n *noopMetricer) RecordHeadLag(name string, blocks uint64, seconds uint64) {
+}
+
+func (n *noopMetricer) SetDegraded(degraded bool) {
+}
+
 func (n *noopMetricer) RecordProposerInconsistentL1Origin(from eth.BlockID, to eth.BlockID) {
 }
 
@@ -3,16 +3,22 @@ package node
 import (
 	"context"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/event"
 	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rpc"
 
 	"github.com/kroma-network/kroma/bindings/bindings"
 	"github.com/kroma-network/kroma/bindings/predeploys"
 	"github.com/kroma-network/kroma/components/node/eth"
+	"github.com/kroma-network/kroma/components/node/p2p"
 	"github.com/kroma-network/kroma/components/node/rollup"
 	"github.com/kroma-network/kroma/components/node/version"
 )
@@ -31,6 +37,9 @@ type driverClient interface {
 	ResetDerivationPipeline(context.Context) error
 	StartProposer(ctx context.Context, blockHash common.Hash) error
 	StopProposer(context.Context) (common.Hash, error)
+	SubscribeSyncStatus(ch chan<- *eth.SyncStatus) event.Subscription
+	SubscribePayloadAttributes(ch chan<- *eth.PayloadAttributesEvent) event.Subscription
+	SubmitExternalPayload(ctx context.Context, payload *eth.ExecutionPayload) error
 }
 
 type rpcMetrics interface {
@@ -68,12 +77,111 @@ func (n *adminAPI) StopProposer(ctx context.Context) (common.Hash, error) {
 	return n.dr.StopProposer(ctx)
 }
 
+// SubmitExternalPayload submits an externally built payload, to be sealed by the proposer instead of its own payload.
+// The payload must match the attributes of the block the proposer is currently building.
+func (n *adminAPI) SubmitExternalPayload(ctx context.Context, payload *eth.ExecutionPayload) error {
+	recordDur := n.m.RecordRPCServerRequest("admin_submitExternalPayload")
+	defer recordDur()
+	if payload == nil {
+		return fmt.Errorf("missing payload")
+	}
+	return n.dr.SubmitExternalPayload(ctx, payload)
+}
+
+// P2PStatus is the runtime state of the p2p stack, as controlled by the p2p admin API.
+type P2PStatus struct {
+	GossipEnabled    bool `json:"gossipEnabled"`
+	DiscoveryEnabled bool `json:"discoveryEnabled"`
+	SignerEnabled    bool `json:"signerEnabled"`
+}
+
+type p2pAdminClient interface {
+	SetP2PGossipEnabled(enabled bool) error
+	SetP2PDiscoveryEnabled(enabled bool) error
+	P2PStatus() *P2PStatus
+	RotateP2PSigner(signer p2p.Signer) error
+}
+
+// p2pAdminAPI controls the p2p stack at runtime, e.g. to isolate a node for debugging without restarting it.
+// It is served in the admin namespace, next to the adminAPI.
+type p2pAdminAPI struct {
+	n p2pAdminClient
+	m rpcMetrics
+}
+
+func NewP2PAdminAPI(n p2pAdminClient, m rpcMetrics) *p2pAdminAPI {
+	return &p2pAdminAPI{
+		n: n,
+		m: m,
+	}
+}
+
+func (a *p2pAdminAPI) SetP2PGossipEnabled(_ context.Context, enabled bool) error {
+	recordDur := a.m.RecordRPCServerRequest("admin_setP2PGossipEnabled")
+	defer recordDur()
+	return a.n.SetP2PGossipEnabled(enabled)
+}
+
+func (a *p2pAdminAPI) SetP2PDiscoveryEnabled(_ context.Context, enabled bool) error {
+	recordDur := a.m.RecordRPCServerRequest("admin_setP2PDiscoveryEnabled")
+	defer recordDur()
+	return a.n.SetP2PDiscoveryEnabled(enabled)
+}
+
+func (a *p2pAdminAPI) GetP2PStatus(_ context.Context) (*P2PStatus, error) {
+	recordDur := a.m.RecordRPCServerRequest("admin_getP2PStatus")
+	defer recordDur()
+	return a.n.P2PStatus(), nil
+}
+
+// RotateP2PSigner replaces the key that blocks published over p2p are signed with by the given hex-encoded private key,
+// and returns the address of the new signer. Note that peers only accept blocks of the signer
+// registered in the SystemConfig on L1, which has to be updated as well.
+func (a *p2pAdminAPI) RotateP2PSigner(_ context.Context, key string) (common.Address, error) {
+	recordDur := a.m.RecordRPCServerRequest("admin_rotateP2PSigner")
+	defer recordDur()
+	priv, err := crypto.HexToECDSA(strings.TrimPrefix(key, "0x"))
+	if err != nil {
+		return common.Address{}, fmt.Errorf("invalid p2p signer key: %w", err)
+	}
+	if err := a.n.RotateP2PSigner(p2p.NewLocalSigner(priv)); err != nil {
+		return common.Address{}, err
+	}
+	return crypto.PubkeyToAddress(priv.PublicKey), nil
+}
+
+type engineAdminClient interface {
+	ReloadEngineJWTSecret() error
+}
+
+// engineAdminAPI manages the connection to the L2 execution engine at runtime.
+// It is served in the admin namespace, next to the adminAPI.
+type engineAdminAPI struct {
+	n engineAdminClient
+	m rpcMetrics
+}
+
+func NewEngineAdminAPI(n engineAdminClient, m rpcMetrics) *engineAdminAPI {
+	return &engineAdminAPI{
+		n: n,
+		m: m,
+	}
+}
+
+// ReloadEngineJWTSecret reloads the JWT secret of the engine API from its file, to rotate it without a restart.
+func (a *engineAdminAPI) ReloadEngineJWTSecret(_ context.Context) error {
+	recordDur := a.m.RecordRPCServerRequest("admin_reloadEngineJWTSecret")
+	defer recordDur()
+	return a.n.ReloadEngineJWTSecret()
+}
+
 type nodeAPI struct {
-	config *rollup.Config
-	client l2EthClient
-	dr     driverClient
-	log    log.Logger
-	m      rpcMetrics
+	config  *rollup.Config
+	client  l2EthClient
+	dr      driverClient
+	outputs *outputCache // optional, outputs are computed on every request if nil
+	log     log.Logger
+	m       rpcMetrics
 }
 
 func NewNodeAPI(config *rollup.Config, l2Client l2EthClient, dr driverClient, log log.Logger, m rpcMetrics) *nodeAPI {
@@ -139,6 +247,20 @@ func (n *nodeAPI) fetchOutputAtBlock(ctx context.Context, number hexutil.Uint64)
 		return nil, fmt.Errorf("failed to get L2 block ref with sync status: %w", err)
 	}
 
+	if n.outputs != nil {
+		if output, ok := n.outputs.Get(ctx, ref, nextRef); ok {
+			return &eth.OutputResponse{
+				Version:               output.Version,
+				OutputRoot:            output.OutputRoot,
+				BlockRef:              ref,
+				NextBlockRef:          nextRef,
+				WithdrawalStorageRoot: output.WithdrawalStorageRoot,
+				StateRoot:             output.StateRoot,
+				Status:                status,
+			}, nil
+		}
+	}
+
 	head, err := n.client.InfoByHash(ctx, ref.Hash)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get L2 block by hash %s: %w", ref, err)
@@ -173,6 +295,16 @@ func (n *nodeAPI) fetchOutputAtBlock(ctx context.Context, number hexutil.Uint64)
 		return nil, err
 	}
 
+	if n.outputs != nil {
+		n.outputs.Add(ctx, ref, &cachedOutput{
+			Version:               l2OutputRootVersion,
+			OutputRoot:            l2OutputRoot,
+			NextBlockHash:         nextRef.Hash,
+			WithdrawalStorageRoot: proof.StorageHash,
+			StateRoot:             head.Root(),
+		}, ref.Number <= status.FinalizedL2.Number)
+	}
+
 	return &eth.OutputResponse{
 		Version:               l2OutputRootVersion,
 		OutputRoot:            l2OutputRoot,
@@ -190,6 +322,137 @@ func (n *nodeAPI) SyncStatus(ctx context.Context) (*eth.SyncStatus, error) {
 	return n.dr.SyncStatus(ctx)
 }
 
+// SyncStatusUpdates creates a subscription that is notified with the sync status whenever it changes.
+func (n *nodeAPI) SyncStatusUpdates(ctx context.Context) (*rpc.Subscription, error) {
+	recordDur := n.m.RecordRPCServerRequest("kroma_subscribe_syncStatusUpdates")
+	defer recordDur()
+	return n.subscribeSyncStatus(ctx, func(prev, cur *eth.SyncStatus) (any, bool) {
+		return cur, *prev != *cur
+	})
+}
+
+// NewUnsafeHeads creates a subscription that is notified with the unsafe L2 head whenever it changes.
+func (n *nodeAPI) NewUnsafeHeads(ctx context.Context) (*rpc.Subscription, error) {
+	recordDur := n.m.RecordRPCServerRequest("kroma_subscribe_newUnsafeHeads")
+	defer recordDur()
+	return n.subscribeSyncStatus(ctx, l2HeadSelector(func(s *eth.SyncStatus) eth.L2BlockRef { return s.UnsafeL2 }))
+}
+
+// NewSafeHeads creates a subscription that is notified with the safe L2 head whenever it changes.
+func (n *nodeAPI) NewSafeHeads(ctx context.Context) (*rpc.Subscription, error) {
+	recordDur := n.m.RecordRPCServerRequest("kroma_subscribe_newSafeHeads")
+	defer recordDur()
+	return n.subscribeSyncStatus(ctx, l2HeadSelector(func(s *eth.SyncStatus) eth.L2BlockRef { return s.SafeL2 }))
+}
+
+// NewFinalizedHeads creates a subscription that is notified with the finalized L2 head whenever it changes.
+func (n *nodeAPI) NewFinalizedHeads(ctx context.Context) (*rpc.Subscription, error) {
+	recordDur := n.m.RecordRPCServerRequest("kroma_subscribe_newFinalizedHeads")
+	defer recordDur()
+	return n.subscribeSyncStatus(ctx, l2HeadSelector(func(s *eth.SyncStatus) eth.L2BlockRef { return s.FinalizedL2 }))
+}
+
+// PayloadAttributes creates a subscription that is notified with the attributes of every block the proposer starts building.
+func (n *nodeAPI) PayloadAttributes(ctx context.Context) (*rpc.Subscription, error) {
+	recordDur := n.m.RecordRPCServerRequest("kroma_subscribe_payloadAttributes")
+	defer recordDur()
+	notifier, supported := rpc.NotifierFromContext(ctx)
+	if !supported {
+		return &rpc.Subscription{}, rpc.ErrNotificationsUnsupported
+	}
+	rpcSub := notifier.CreateSubscription()
+
+	go func() {
+		attrsCh := make(chan *eth.PayloadAttributesEvent, 16)
+		attrsSub := n.dr.SubscribePayloadAttributes(attrsCh)
+		defer attrsSub.Unsubscribe()
+
+		for {
+			select {
+			case ev := <-attrsCh:
+				if err := notifier.Notify(rpcSub.ID, ev); err != nil {
+					n.log.Warn("failed to notify subscriber", "id", rpcSub.ID, "err", err)
+					return
+				}
+			case <-attrsSub.Err():
+				return
+			case <-rpcSub.Err():
+				return
+			case <-notifier.Closed():
+				return
+			}
+		}
+	}()
+
+	return rpcSub, nil
+}
+
+// statusSelector converts a sync status change into a subscription notification.
+// It returns false if the change is not relevant to the subscription.
+type statusSelector func(prev, cur *eth.SyncStatus) (any, bool)
+
+func l2HeadSelector(head func(s *eth.SyncStatus) eth.L2BlockRef) statusSelector {
+	return func(prev, cur *eth.SyncStatus) (any, bool) {
+		ref := head(cur)
+		return ref, ref != head(prev)
+	}
+}
+
+// subscribeSyncStatus creates a subscription that forwards the sync status changes of the driver,
+// starting with the current sync status, as notifications built by the given selector.
+func (n *nodeAPI) subscribeSyncStatus(ctx context.Context, selector statusSelector) (*rpc.Subscription, error) {
+	notifier, supported := rpc.NotifierFromContext(ctx)
+	if !supported {
+		return &rpc.Subscription{}, rpc.ErrNotificationsUnsupported
+	}
+	rpcSub := notifier.CreateSubscription()
+
+	go func() {
+		statusCh := make(chan *eth.SyncStatus, 16)
+		statusSub := n.dr.SubscribeSyncStatus(statusCh)
+		defer statusSub.Unsubscribe()
+
+		prev := new(eth.SyncStatus)
+		notify := func(cur *eth.SyncStatus) error {
+			if msg, ok := selector(prev, cur); ok {
+				if err := notifier.Notify(rpcSub.ID, msg); err != nil {
+					return err
+				}
+			}
+			prev = cur
+			return nil
+		}
+
+		fetchCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		status, err := n.dr.SyncStatus(fetchCtx)
+		cancel()
+		if err != nil {
+			n.log.Warn("failed to fetch initial sync status for subscription", "id", rpcSub.ID, "err", err)
+		} else if err := notify(status); err != nil {
+			n.log.Warn("failed to notify subscriber", "id", rpcSub.ID, "err", err)
+			return
+		}
+
+		for {
+			select {
+			case status := <-statusCh:
+				if err := notify(status); err != nil {
+					n.log.Warn("failed to notify subscriber", "id", rpcSub.ID, "err", err)
+					return
+				}
+			case <-statusSub.Err():
+				return
+			case <-rpcSub.Err():
+				return
+			case <-notifier.Closed():
+				return
+			}
+		}
+	}()
+
+	return rpcSub, nil
+}
+
 func (n *nodeAPI) RollupConfig(_ context.Context) (*rollup.Config, error) {
 	recordDur := n.m.RecordRPCServerRequest("kroma_rollupConfig")
 	defer recordDur()
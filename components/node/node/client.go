@@ -7,7 +7,6 @@ import (
 	"time"
 
 	"github.com/ethereum/go-ethereum/log"
-	gn "github.com/ethereum/go-ethereum/node"
 	"github.com/ethereum/go-ethereum/rpc"
 
 	"github.com/kroma-network/kroma/components/node/client"
@@ -39,9 +38,19 @@ type L1EndpointSetup interface {
 type L2EndpointConfig struct {
 	L2EngineAddr string // Address of L2 Engine JSON-RPC endpoint to use (engine and eth namespace required)
 
+	// Addresses of secondary L2 Engine JSON-RPC endpoints, optional.
+	// Engine state changes are forwarded to the secondaries too, and they serve reads if the primary is unavailable.
+	L2EngineSecondaryAddrs []string
+
 	// JWT secrets for L2 Engine API authentication during HTTP or initial Websocket communication.
 	// Any value for an IPC connection.
 	L2EngineJWTSecret [32]byte
+
+	// Path of the file the JWT secret was read from, optional.
+	// If set, the JWT secret can be reloaded from the file at runtime.
+	L2EngineJWTSecretPath string
+
+	jwt *engineJWT
 }
 
 var _ L2EndpointSetup = (*L2EndpointConfig)(nil)
@@ -50,6 +59,14 @@ func (cfg *L2EndpointConfig) Check() error {
 	if cfg.L2EngineAddr == "" {
 		return errors.New("empty L2 Engine Address")
 	}
+	for i, addr := range cfg.L2EngineSecondaryAddrs {
+		if addr == "" {
+			return fmt.Errorf("empty secondary L2 Engine Address at index %d", i)
+		}
+		if addr == cfg.L2EngineAddr {
+			return fmt.Errorf("secondary L2 Engine Address %s is the same as the primary", addr)
+		}
+	}
 
 	return nil
 }
@@ -58,13 +75,47 @@ func (cfg *L2EndpointConfig) Setup(ctx context.Context, log log.Logger, rollupCf
 	if err := cfg.Check(); err != nil {
 		return nil, nil, err
 	}
-	auth := rpc.WithHTTPAuth(gn.NewJWTAuth(cfg.L2EngineJWTSecret))
+	cfg.jwt = newEngineJWT(cfg.L2EngineJWTSecret)
+	auth := rpc.WithHTTPAuth(cfg.jwt.Auth)
 	l2Node, err := client.NewRPC(ctx, log, cfg.L2EngineAddr, client.WithGethRPCOptions(auth))
 	if err != nil {
 		return nil, nil, err
 	}
+	if len(cfg.L2EngineSecondaryAddrs) == 0 {
+		return l2Node, sources.EngineClientDefaultConfig(rollupCfg), nil
+	}
 
-	return l2Node, sources.EngineClientDefaultConfig(rollupCfg), nil
+	secondaries := make([]client.RPC, 0, len(cfg.L2EngineSecondaryAddrs))
+	for _, addr := range cfg.L2EngineSecondaryAddrs {
+		secondary, err := client.NewRPC(ctx, log, addr, client.WithGethRPCOptions(auth))
+		if err != nil {
+			l2Node.Close()
+			for _, s := range secondaries {
+				s.Close()
+			}
+			return nil, nil, fmt.Errorf("failed to dial secondary L2 engine %s: %w", addr, err)
+		}
+		secondaries = append(secondaries, secondary)
+	}
+	log.Info("Multiplexing engine API to secondary L2 engines", "secondaries", len(secondaries))
+	return client.NewMultiplexedRPC(log, l2Node, secondaries...), sources.EngineClientDefaultConfig(rollupCfg), nil
+}
+
+// ReloadJWTSecret reads the JWT secret file again, and authenticates all following engine API requests with it.
+// Websocket connections are only authenticated when (re)connecting.
+func (cfg *L2EndpointConfig) ReloadJWTSecret() error {
+	if cfg.L2EngineJWTSecretPath == "" {
+		return errors.New("jwt secret path is not configured")
+	}
+	if cfg.jwt == nil {
+		return errors.New("L2 endpoint is not set up")
+	}
+	secret, err := ReadJWTSecret(cfg.L2EngineJWTSecretPath)
+	if err != nil {
+		return err
+	}
+	cfg.jwt.Set(secret)
+	return nil
 }
 
 // PreparedL2Endpoints enables testing with in-process pre-setup RPC connections to L2 engines
@@ -129,6 +180,21 @@ func (cfg *PreparedL2SyncEndpoint) Check() error {
 	return nil
 }
 
+// BuilderEndpointConfig contains configuration for the external block builder of the proposer
+type BuilderEndpointConfig struct {
+	// Address of the builder RPC to delegate block building to, may be empty if block building is not delegated.
+	BuilderAddr string
+}
+
+// Setup creates an RPC client to the builder.
+// It will return nil without error if no builder is configured.
+func (cfg *BuilderEndpointConfig) Setup(ctx context.Context, log log.Logger) (client.RPC, error) {
+	if cfg.BuilderAddr == "" {
+		return nil, nil
+	}
+	return client.NewRPC(ctx, log, cfg.BuilderAddr)
+}
+
 type L1EndpointConfig struct {
 	L1NodeAddr string // Address of L1 User JSON-RPC endpoint to use (eth namespace required)
 
@@ -6,6 +6,7 @@ import (
 	"math"
 	"time"
 
+	"github.com/kroma-network/kroma/components/node/heartbeat"
 	"github.com/kroma-network/kroma/components/node/p2p"
 	"github.com/kroma-network/kroma/components/node/rollup"
 	"github.com/kroma-network/kroma/components/node/rollup/driver"
@@ -17,6 +18,9 @@ type Config struct {
 	L2     L2EndpointSetup
 	L2Sync L2SyncEndpointSetup
 
+	// Builder is the optional external block builder of the proposer
+	Builder BuilderEndpointConfig
+
 	Driver driver.Config
 
 	Rollup rollup.Config
@@ -36,6 +40,9 @@ type Config struct {
 	// Used to poll the L1 for new finalized or safe blocks
 	L1EpochPollInterval time.Duration
 
+	// AltDAServer is the HTTP address of the DA server to fetch the data of alt-DA commitments from
+	AltDAServer string
+
 	// Optional
 	Tracer    Tracer
 	Heartbeat HeartbeatConfig
@@ -45,19 +52,40 @@ type RPCConfig struct {
 	ListenAddr  string
 	ListenPort  int
 	EnableAdmin bool
+
+	// OutputCacheSize is the number of output roots to cache in memory. Disabled if 0.
+	OutputCacheSize int
+	// OutputCachePath is the path of the database to persist finalized output roots in.
+	// Output roots are only cached in memory if empty.
+	OutputCachePath string
 }
 
 func (cfg *RPCConfig) HttpEndpoint() string {
 	return fmt.Sprintf("http://%s:%d", cfg.ListenAddr, cfg.ListenPort)
 }
 
+func (cfg *RPCConfig) Check() error {
+	if cfg.OutputCacheSize < 0 {
+		return errors.New("output cache size must not be negative")
+	}
+	if cfg.OutputCacheSize == 0 && cfg.OutputCachePath != "" {
+		return errors.New("output cache path is set, but the output cache is disabled")
+	}
+	return nil
+}
+
 type MetricsConfig struct {
 	Enabled    bool
 	ListenAddr string
 	ListenPort int
+
+	HeadLagThresholds HeadLagThresholds
 }
 
 func (m MetricsConfig) Check() error {
+	if m.HeadLagThresholds.MaxUnsafeSafeLag < 0 || m.HeadLagThresholds.MaxSafeFinalizedLag < 0 {
+		return errors.New("head lag thresholds must not be negative")
+	}
 	if !m.Enabled {
 		return nil
 	}
@@ -70,9 +98,35 @@ func (m MetricsConfig) Check() error {
 }
 
 type HeartbeatConfig struct {
-	Enabled bool
-	Moniker string
-	URL     string
+	Enabled  bool
+	Moniker  string
+	URL      string
+	Interval time.Duration
+	// Fields are the optional fields to report in each heartbeat.
+	Fields []heartbeat.Field
+}
+
+func (h HeartbeatConfig) Check() error {
+	if !h.Enabled {
+		return nil
+	}
+	if h.URL == "" {
+		return errors.New("heartbeat URL must be set")
+	}
+	if h.Interval < 0 {
+		return errors.New("heartbeat interval must not be negative")
+	}
+	return nil
+}
+
+// HasField returns true if the given optional field is reported in heartbeats.
+func (h HeartbeatConfig) HasField(field heartbeat.Field) bool {
+	for _, f := range h.Fields {
+		if f == field {
+			return true
+		}
+	}
+	return false
 }
 
 // Check verifies that the given configuration makes sense
@@ -83,15 +137,27 @@ func (cfg *Config) Check() error {
 	if err := cfg.L2Sync.Check(); err != nil {
 		return fmt.Errorf("sync config error: %w", err)
 	}
+	if cfg.Builder.BuilderAddr != "" && cfg.Driver.BuilderTimeout <= 0 {
+		return errors.New("builder timeout must be positive if a builder is configured")
+	}
 	if err := cfg.Rollup.Check(); err != nil {
 		return fmt.Errorf("rollup config error: %w", err)
 	}
+	if cfg.Rollup.AltDATime != nil && cfg.AltDAServer == "" {
+		return errors.New("alt-DA server must be set if alt-DA commitments are scheduled")
+	}
+	if err := cfg.RPC.Check(); err != nil {
+		return fmt.Errorf("rpc config error: %w", err)
+	}
 	if err := cfg.Metrics.Check(); err != nil {
 		return fmt.Errorf("metrics config error: %w", err)
 	}
 	if err := cfg.Pprof.Check(); err != nil {
 		return fmt.Errorf("pprof config error: %w", err)
 	}
+	if err := cfg.Heartbeat.Check(); err != nil {
+		return fmt.Errorf("heartbeat config error: %w", err)
+	}
 	if cfg.P2P != nil {
 		if err := cfg.P2P.Check(); err != nil {
 			return fmt.Errorf("p2p config error: %w", err)
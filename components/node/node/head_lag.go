@@ -0,0 +1,76 @@
+package node
+
+import (
+	"time"
+
+	"github.com/ethereum/go-ethereum/event"
+
+	"github.com/kroma-network/kroma/components/node/eth"
+)
+
+type HeadLagMetrics interface {
+	RecordHeadLag(name string, blocks uint64, seconds uint64)
+	SetDegraded(degraded bool)
+}
+
+// HeadLagThresholds are the maximum lags between L2 heads before the node is considered degraded.
+// A zero threshold is never exceeded.
+type HeadLagThresholds struct {
+	MaxUnsafeSafeLag    time.Duration
+	MaxSafeFinalizedLag time.Duration
+}
+
+// recordHeadLag records the lags between the L2 heads of the sync status,
+// and returns true if any lag exceeds its threshold.
+func recordHeadLag(m HeadLagMetrics, thresholds HeadLagThresholds, status *eth.SyncStatus) bool {
+	unsafeSafe := recordLag(m, "unsafe_safe", status.UnsafeL2, status.SafeL2)
+	safeFinalized := recordLag(m, "safe_finalized", status.SafeL2, status.FinalizedL2)
+	degraded := exceeds(unsafeSafe, thresholds.MaxUnsafeSafeLag) || exceeds(safeFinalized, thresholds.MaxSafeFinalizedLag)
+	m.SetDegraded(degraded)
+	return degraded
+}
+
+func recordLag(m HeadLagMetrics, name string, ahead eth.L2BlockRef, behind eth.L2BlockRef) time.Duration {
+	var blocks, seconds uint64
+	// heads are updated one by one, the lag is zero until the heads are consistent again
+	if ahead.Number > behind.Number && ahead.Time > behind.Time {
+		blocks = ahead.Number - behind.Number
+		seconds = ahead.Time - behind.Time
+	}
+	m.RecordHeadLag(name, blocks, seconds)
+	return time.Duration(seconds) * time.Second
+}
+
+func exceeds(lag time.Duration, threshold time.Duration) bool {
+	return threshold != 0 && lag > threshold
+}
+
+// monitorHeadLag records the head lags on every change of the sync status, until the returned subscription is closed.
+func (n *KromaNode) monitorHeadLag(thresholds HeadLagThresholds) event.Subscription {
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		statusCh := make(chan *eth.SyncStatus, 10)
+		sub := n.l2Driver.SubscribeSyncStatus(statusCh)
+		defer sub.Unsubscribe()
+
+		wasDegraded := false
+		for {
+			select {
+			case status := <-statusCh:
+				degraded := recordHeadLag(n.metrics, thresholds, status)
+				if degraded != wasDegraded {
+					if degraded {
+						n.log.Warn("L2 head lag exceeds threshold, node is degraded", "unsafe", status.UnsafeL2,
+							"safe", status.SafeL2, "finalized", status.FinalizedL2)
+					} else {
+						n.log.Info("L2 head lag is back within threshold")
+					}
+					wasDegraded = degraded
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	})
+}
@@ -0,0 +1,48 @@
+package node
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/kroma-network/kroma/components/node/eth"
+)
+
+type headLagRecorder struct {
+	lags     map[string][2]uint64
+	degraded bool
+}
+
+func (r *headLagRecorder) RecordHeadLag(name string, blocks uint64, seconds uint64) {
+	r.lags[name] = [2]uint64{blocks, seconds}
+}
+
+func (r *headLagRecorder) SetDegraded(degraded bool) {
+	r.degraded = degraded
+}
+
+func TestRecordHeadLag(t *testing.T) {
+	status := &eth.SyncStatus{
+		UnsafeL2:    eth.L2BlockRef{Number: 110, Time: 1220},
+		SafeL2:      eth.L2BlockRef{Number: 100, Time: 1200},
+		FinalizedL2: eth.L2BlockRef{Number: 40, Time: 1080},
+	}
+
+	m := &headLagRecorder{lags: make(map[string][2]uint64)}
+	require.False(t, recordHeadLag(m, HeadLagThresholds{}, status), "zero thresholds are never exceeded")
+	require.Equal(t, [2]uint64{10, 20}, m.lags["unsafe_safe"])
+	require.Equal(t, [2]uint64{60, 120}, m.lags["safe_finalized"])
+	require.False(t, m.degraded)
+
+	require.True(t, recordHeadLag(m, HeadLagThresholds{MaxSafeFinalizedLag: time.Minute}, status))
+	require.True(t, m.degraded)
+
+	require.False(t, recordHeadLag(m, HeadLagThresholds{MaxUnsafeSafeLag: 20 * time.Second}, status))
+	require.False(t, m.degraded)
+
+	// inconsistent heads during an update are not reported as lag
+	status.SafeL2 = eth.L2BlockRef{Number: 120, Time: 1240}
+	recordHeadLag(m, HeadLagThresholds{}, status)
+	require.Equal(t, [2]uint64{0, 0}, m.lags["unsafe_safe"])
+}
@@ -0,0 +1,53 @@
+package node
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"sync/atomic"
+
+	"github.com/ethereum/go-ethereum/common"
+	gn "github.com/ethereum/go-ethereum/node"
+)
+
+// JWTSecretReloader is implemented by L2 endpoint setups that support replacing the engine JWT secret at runtime.
+type JWTSecretReloader interface {
+	ReloadJWTSecret() error
+}
+
+// ReadJWTSecret reads a hex-encoded 32 byte JWT secret from the given file.
+func ReadJWTSecret(path string) ([32]byte, error) {
+	var secret [32]byte
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return secret, fmt.Errorf("failed to read jwt secret from %s: %w", path, err)
+	}
+	jwtSecret := common.FromHex(strings.TrimSpace(string(data)))
+	if len(jwtSecret) != 32 {
+		return secret, fmt.Errorf("invalid jwt secret in path %s, not 32 hex-formatted bytes", path)
+	}
+	copy(secret[:], jwtSecret)
+	return secret, nil
+}
+
+// engineJWT authenticates engine API requests with a JWT secret that can be replaced at runtime.
+// Requests that are in flight while the secret is replaced may still use the previous secret.
+type engineJWT struct {
+	secret atomic.Pointer[[32]byte]
+}
+
+func newEngineJWT(secret [32]byte) *engineJWT {
+	j := &engineJWT{}
+	j.secret.Store(&secret)
+	return j
+}
+
+func (j *engineJWT) Set(secret [32]byte) {
+	j.secret.Store(&secret)
+}
+
+// Auth is a rpc.HTTPAuth, which adds a JWT token signed with the current secret to the request headers.
+func (j *engineJWT) Auth(h http.Header) error {
+	return gn.NewJWTAuth(*j.secret.Load())(h)
+}
@@ -0,0 +1,33 @@
+package node
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReloadJWTSecret(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "jwt.txt")
+	first, second := [32]byte{1}, [32]byte{2}
+	require.NoError(t, os.WriteFile(path, []byte(hexutil.Encode(first[:])), 0o600))
+
+	secret, err := ReadJWTSecret(path)
+	require.NoError(t, err)
+	require.Equal(t, first, secret)
+
+	cfg := &L2EndpointConfig{L2EngineJWTSecret: secret, L2EngineJWTSecretPath: path}
+	require.ErrorContains(t, cfg.ReloadJWTSecret(), "not set up")
+	cfg.jwt = newEngineJWT(cfg.L2EngineJWTSecret)
+
+	require.NoError(t, os.WriteFile(path, []byte(hexutil.Encode(second[:])+"\n"), 0o600))
+	require.NoError(t, cfg.ReloadJWTSecret())
+	require.Equal(t, second, *cfg.jwt.secret.Load())
+
+	// an invalid secret does not replace the current one
+	require.NoError(t, os.WriteFile(path, []byte("0x1234"), 0o600))
+	require.ErrorContains(t, cfg.ReloadJWTSecret(), "not 32 hex-formatted bytes")
+	require.Equal(t, second, *cfg.jwt.secret.Load())
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/ethereum/go-ethereum"
@@ -12,10 +13,13 @@ import (
 	"github.com/hashicorp/go-multierror"
 	"github.com/libp2p/go-libp2p/core/peer"
 
+	"github.com/kroma-network/kroma/components/node/altda"
 	"github.com/kroma-network/kroma/components/node/client"
 	"github.com/kroma-network/kroma/components/node/eth"
 	"github.com/kroma-network/kroma/components/node/metrics"
 	"github.com/kroma-network/kroma/components/node/p2p"
+	"github.com/kroma-network/kroma/components/node/rollup"
+	"github.com/kroma-network/kroma/components/node/rollup/derive"
 	"github.com/kroma-network/kroma/components/node/rollup/driver"
 	"github.com/kroma-network/kroma/components/node/sources"
 )
@@ -29,15 +33,26 @@ type KromaNode struct {
 	l1SafeSub      ethereum.Subscription // Subscription to get L1 safe blocks, a.k.a. justified data (polling)
 	l1FinalizedSub ethereum.Subscription // Subscription to get L1 safe blocks, a.k.a. justified data (polling)
 
-	l1Source  *sources.L1Client     // L1 Client to fetch data from
-	l2Driver  *driver.Driver        // L2 Engine to Sync
-	l2Source  *sources.EngineClient // L2 Execution Engine RPC bindings
-	rpcSync   *sources.SyncClient   // Alt-sync RPC client, optional (may be nil)
-	server    *rpcServer            // RPC server hosting the rollup-node API
-	p2pNode   *p2p.NodeP2P          // P2P node functionality
-	p2pSigner p2p.Signer            // p2p gossip application messages will be signed with this signer
-	tracer    Tracer                // tracer to get events for testing/debugging
-	runCfg    *RuntimeConfig        // runtime configurables
+	l1Source  *sources.L1Client      // L1 Client to fetch data from
+	l2Driver  *driver.Driver         // L2 Engine to Sync
+	l2Source  *sources.EngineClient  // L2 Execution Engine RPC bindings
+	l2Setup   L2EndpointSetup        // Setup of the L2 Execution Engine RPC bindings, to reload the JWT secret
+	rpcSync   *sources.SyncClient    // Alt-sync RPC client, optional (may be nil)
+	builder   *sources.BuilderClient // External block builder RPC client, optional (may be nil)
+	server    *rpcServer             // RPC server hosting the rollup-node API
+	outputs   *outputCache           // Cache of output roots served by the RPC server, optional (may be nil)
+	p2pNode   *p2p.NodeP2P           // P2P node functionality
+	p2pSigner p2p.Signer             // p2p gossip application messages will be signed with this signer
+	tracer    Tracer                 // tracer to get events for testing/debugging
+	runCfg    *RuntimeConfig         // runtime configurables
+
+	headLagSub event.Subscription // Records the lags between L2 heads on every sync status change
+
+	rollupCfg      *rollup.Config
+	p2pTargetPeers uint
+	// p2pMu guards the p2p signer and the p2p discovery process, which can be changed at runtime.
+	p2pMu              sync.Mutex
+	p2pDiscoveryCancel context.CancelFunc // stops the running p2p discovery process, nil if not running
 
 	// some resources cannot be stopped directly, like the p2p gossipsub router (not our design),
 	// and depend on this ctx to be closed.
@@ -57,6 +72,7 @@ func New(ctx context.Context, cfg *Config, log log.Logger, snapshotLog log.Logge
 		log:        log,
 		appVersion: appVersion,
 		metrics:    m,
+		rollupCfg:  &cfg.Rollup,
 	}
 	// not a context leak, gossipsub is closed with a context.
 	n.resourcesCtx, n.resourcesClose = context.WithCancel(context.Background())
@@ -186,6 +202,7 @@ func (n *KromaNode) initL2(ctx context.Context, cfg *Config, snapshotLog log.Log
 	if err != nil {
 		return fmt.Errorf("failed to setup L2 execution-engine RPC client: %w", err)
 	}
+	n.l2Setup = cfg.L2
 
 	n.l2Source, err = sources.NewEngineClient(
 		client.NewInstrumentedRPC(rpcClient, n.metrics), n.log, n.metrics.L2SourceCache, rpcCfg,
@@ -198,7 +215,24 @@ func (n *KromaNode) initL2(ctx context.Context, cfg *Config, snapshotLog log.Log
 		return err
 	}
 
-	n.l2Driver = driver.NewDriver(&cfg.Driver, &cfg.Rollup, n.l2Source, n.l1Source, n, n, n.log, snapshotLog, n.metrics)
+	var builder driver.PayloadBuilder
+	builderRPC, err := cfg.Builder.Setup(ctx, n.log)
+	if err != nil {
+		return fmt.Errorf("failed to setup builder RPC client: %w", err)
+	}
+	if builderRPC != nil {
+		n.builder = sources.NewBuilderClient(client.NewInstrumentedRPC(builderRPC, n.metrics))
+		builder = n.builder
+		n.log.Info("Block building is delegated to external builder", "timeout", cfg.Driver.BuilderTimeout)
+	}
+
+	var daFetcher derive.AltDAFetcher
+	if cfg.AltDAServer != "" {
+		daFetcher = altda.NewDAClient(cfg.AltDAServer, altda.DefaultTimeout)
+	}
+
+	n.l2Driver = driver.NewDriver(&cfg.Driver, &cfg.Rollup, n.l2Source, n.l1Source, daFetcher, builder, n, n, n.log, snapshotLog, n.metrics)
+	n.headLagSub = n.monitorHeadLag(cfg.Metrics.HeadLagThresholds)
 
 	return nil
 }
@@ -224,11 +258,24 @@ func (n *KromaNode) initRPCServer(ctx context.Context, cfg *Config) error {
 	if err != nil {
 		return err
 	}
+	if cfg.RPC.OutputCacheSize > 0 {
+		outputs, err := newOutputCache(n.log, n.metrics.OutputCache, cfg.RPC.OutputCacheSize, cfg.RPC.OutputCachePath)
+		if err != nil {
+			return err
+		}
+		n.outputs = outputs
+		server.EnableOutputCache(outputs)
+		n.log.Info("Output cache enabled", "size", cfg.RPC.OutputCacheSize, "path", cfg.RPC.OutputCachePath)
+	}
 	if n.p2pNode != nil {
 		server.EnableP2P(p2p.NewP2PAPIBackend(n.p2pNode, n.log, n.metrics))
 	}
 	if cfg.RPC.EnableAdmin {
 		server.EnableAdminAPI(NewAdminAPI(n.l2Driver, n.metrics))
+		server.EnableEngineAdminAPI(NewEngineAdminAPI(n, n.metrics))
+		if n.p2pNode != nil {
+			server.EnableP2PAdminAPI(NewP2PAdminAPI(n, n.metrics))
+		}
 		n.log.Info("Admin RPC enabled")
 	}
 	n.log.Info("Starting JSON-RPC server")
@@ -260,8 +307,9 @@ func (n *KromaNode) initP2P(ctx context.Context, cfg *Config) error {
 			return err
 		}
 		n.p2pNode = p2pNode
+		n.p2pTargetPeers = cfg.P2P.TargetPeers()
 		if n.p2pNode.Dv5Udp() != nil {
-			go n.p2pNode.DiscoveryProcess(n.resourcesCtx, n.log, &cfg.Rollup, cfg.P2P.TargetPeers())
+			n.startP2PDiscovery()
 		}
 	}
 	return nil
@@ -340,11 +388,18 @@ func (n *KromaNode) OnNewL1Finalized(ctx context.Context, sig eth.L1BlockRef) {
 func (n *KromaNode) PublishL2Payload(ctx context.Context, payload *eth.ExecutionPayload) error {
 	n.tracer.OnPublishL2Payload(ctx, payload)
 
+	n.p2pMu.Lock()
+	defer n.p2pMu.Unlock()
+
 	// publish to p2p, if we are running p2p at all
 	if n.p2pNode != nil {
 		if n.p2pSigner == nil {
 			return fmt.Errorf("node has no p2p signer, payload %s cannot be published", payload.ID())
 		}
+		if !n.p2pNode.GossipEnabled() {
+			n.log.Debug("Not publishing execution payload, p2p gossip is disabled", "id", payload.ID())
+			return nil
+		}
 		n.log.Info("Publishing signed execution payload on p2p", "id", payload.ID())
 		return n.p2pNode.GossipOut().PublishL2Payload(ctx, payload, n.p2pSigner)
 	}
@@ -383,6 +438,89 @@ func (n *KromaNode) RequestL2Range(ctx context.Context, start, end eth.L2BlockRe
 	return nil
 }
 
+// startP2PDiscovery starts the p2p discovery process. The caller must hold p2pMu, unless the node is initializing.
+func (n *KromaNode) startP2PDiscovery() {
+	ctx, cancel := context.WithCancel(n.resourcesCtx)
+	n.p2pDiscoveryCancel = cancel
+	go n.p2pNode.DiscoveryProcess(ctx, n.log, n.rollupCfg, n.p2pTargetPeers)
+}
+
+// SetP2PGossipEnabled enables or disables the publishing and processing of gossiped blocks at runtime.
+func (n *KromaNode) SetP2PGossipEnabled(enabled bool) error {
+	if n.p2pNode == nil {
+		return errors.New("p2p is not enabled")
+	}
+	n.p2pNode.SetGossipEnabled(enabled)
+	n.log.Warn("P2P gossip toggled", "enabled", enabled)
+	return nil
+}
+
+// SetP2PDiscoveryEnabled starts or stops the p2p discovery process at runtime.
+// Existing peer connections are kept when the discovery process is stopped.
+func (n *KromaNode) SetP2PDiscoveryEnabled(enabled bool) error {
+	if n.p2pNode == nil || n.p2pNode.Dv5Udp() == nil {
+		return errors.New("p2p discovery is not enabled")
+	}
+	n.p2pMu.Lock()
+	defer n.p2pMu.Unlock()
+	if enabled == (n.p2pDiscoveryCancel != nil) {
+		return nil
+	}
+	if enabled {
+		n.startP2PDiscovery()
+	} else {
+		n.p2pDiscoveryCancel()
+		n.p2pDiscoveryCancel = nil
+	}
+	n.log.Warn("P2P discovery toggled", "enabled", enabled)
+	return nil
+}
+
+// P2PStatus returns whether p2p gossip and discovery are currently enabled.
+func (n *KromaNode) P2PStatus() *P2PStatus {
+	n.p2pMu.Lock()
+	defer n.p2pMu.Unlock()
+	return &P2PStatus{
+		GossipEnabled:    n.p2pNode != nil && n.p2pNode.GossipEnabled(),
+		DiscoveryEnabled: n.p2pDiscoveryCancel != nil,
+		SignerEnabled:    n.p2pSigner != nil,
+	}
+}
+
+// RotateP2PSigner replaces the signer of published blocks, and closes the previous signer.
+func (n *KromaNode) RotateP2PSigner(signer p2p.Signer) error {
+	n.p2pMu.Lock()
+	defer n.p2pMu.Unlock()
+	prev := n.p2pSigner
+	n.p2pSigner = signer
+	n.log.Warn("P2P signer rotated")
+	if prev != nil {
+		if err := prev.Close(); err != nil {
+			return fmt.Errorf("failed to close previous p2p signer: %w", err)
+		}
+	}
+	return nil
+}
+
+// ReloadEngineJWTSecret reloads the JWT secret used to authenticate with the L2 execution engine from its file,
+// so the secret can be rotated without restarting the node.
+func (n *KromaNode) ReloadEngineJWTSecret() error {
+	reloader, ok := n.l2Setup.(JWTSecretReloader)
+	if !ok {
+		return errors.New("L2 endpoint does not support reloading the jwt secret")
+	}
+	if err := reloader.ReloadJWTSecret(); err != nil {
+		return fmt.Errorf("failed to reload engine jwt secret: %w", err)
+	}
+	n.log.Info("Reloaded engine JWT secret")
+	return nil
+}
+
+// SyncStatus returns the current sync status of the node.
+func (n *KromaNode) SyncStatus(ctx context.Context) (*eth.SyncStatus, error) {
+	return n.l2Driver.SyncStatus(ctx)
+}
+
 func (n *KromaNode) P2P() p2p.Node {
 	return n.p2pNode
 }
@@ -394,11 +532,22 @@ func (n *KromaNode) Close() error {
 	if n.server != nil {
 		n.server.Stop()
 	}
+	if n.outputs != nil {
+		if err := n.outputs.Close(); err != nil {
+			result = multierror.Append(result, fmt.Errorf("failed to close output cache: %w", err))
+		}
+	}
 	if n.p2pNode != nil {
 		if err := n.p2pNode.Close(); err != nil {
 			result = multierror.Append(result, fmt.Errorf("failed to close p2p node: %w", err))
 		}
 	}
+	n.p2pMu.Lock()
+	if n.p2pDiscoveryCancel != nil {
+		n.p2pDiscoveryCancel()
+		n.p2pDiscoveryCancel = nil
+	}
+	n.p2pMu.Unlock()
 	if n.p2pSigner != nil {
 		if err := n.p2pSigner.Close(); err != nil {
 			result = multierror.Append(result, fmt.Errorf("failed to close p2p signer: %w", err))
@@ -414,6 +563,10 @@ func (n *KromaNode) Close() error {
 		n.l1HeadsSub.Unsubscribe()
 	}
 
+	if n.headLagSub != nil {
+		n.headLagSub.Unsubscribe()
+	}
+
 	// close L2 driver
 	if n.l2Driver != nil {
 		if err := n.l2Driver.Close(); err != nil {
@@ -428,6 +581,11 @@ func (n *KromaNode) Close() error {
 		}
 	}
 
+	// close builder RPC client
+	if n.builder != nil {
+		n.builder.Close()
+	}
+
 	// close L2 engine RPC client
 	if n.l2Source != nil {
 		n.l2Source.Close()
@@ -0,0 +1,107 @@
+package node
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	ds "github.com/ipfs/go-datastore"
+	leveldb "github.com/ipfs/go-ds-leveldb"
+
+	"github.com/kroma-network/kroma/components/node/eth"
+	"github.com/kroma-network/kroma/components/node/sources/caching"
+)
+
+const outputCacheLabel = "output"
+
+// cachedOutput holds the parts of an output response that are fully determined by the L2 block hash.
+type cachedOutput struct {
+	Version               eth.Bytes32 `json:"version"`
+	OutputRoot            eth.Bytes32 `json:"outputRoot"`
+	NextBlockHash         common.Hash `json:"nextBlockHash"`
+	WithdrawalStorageRoot common.Hash `json:"withdrawalStorageRoot"`
+	StateRoot             common.Hash `json:"stateRoot"`
+}
+
+// outputCache caches computed output roots by L2 block hash, so repeated queries of historical outputs
+// do not have to fetch and verify the withdrawal storage proof again.
+// Outputs of finalized blocks are optionally persisted on disk, to survive restarts.
+type outputCache struct {
+	log   log.Logger
+	cache *caching.LRUCache
+	// store is optional, outputs are only kept in memory if nil.
+	store ds.Batching
+}
+
+// newOutputCache creates an output cache of the given size. Outputs are persisted in a leveldb database
+// at the given path, unless the path is empty.
+func newOutputCache(log log.Logger, m caching.Metrics, size int, path string) (*outputCache, error) {
+	c := &outputCache{
+		log:   log,
+		cache: caching.NewLRUCache(m, outputCacheLabel, size),
+	}
+	if path != "" {
+		store, err := leveldb.NewDatastore(path, nil) // default leveldb options are fine
+		if err != nil {
+			return nil, fmt.Errorf("failed to open leveldb db for output cache: %w", err)
+		}
+		c.store = store
+	}
+	return c, nil
+}
+
+// Get returns the cached output of the given L2 block, if it was computed with the given next L2 block.
+func (c *outputCache) Get(ctx context.Context, ref eth.L2BlockRef, nextRef eth.L2BlockRef) (*cachedOutput, bool) {
+	if v, ok := c.cache.Get(ref.Hash); ok {
+		output := v.(*cachedOutput)
+		return output, output.NextBlockHash == nextRef.Hash
+	}
+	if c.store == nil {
+		return nil, false
+	}
+	data, err := c.store.Get(ctx, outputKey(ref.Hash))
+	if err != nil {
+		if !errors.Is(err, ds.ErrNotFound) {
+			c.log.Warn("failed to read output from disk", "block", ref, "err", err)
+		}
+		return nil, false
+	}
+	var output cachedOutput
+	if err := json.Unmarshal(data, &output); err != nil {
+		c.log.Warn("failed to decode output from disk", "block", ref, "err", err)
+		return nil, false
+	}
+	c.cache.Add(ref.Hash, &output)
+	return &output, output.NextBlockHash == nextRef.Hash
+}
+
+// Add caches the output of the given L2 block. The output is persisted if the block is finalized,
+// since outputs of unfinalized blocks may be reorged out and would otherwise stay on disk forever.
+func (c *outputCache) Add(ctx context.Context, ref eth.L2BlockRef, output *cachedOutput, finalized bool) {
+	c.cache.Add(ref.Hash, output)
+	if c.store == nil || !finalized {
+		return
+	}
+	data, err := json.Marshal(output)
+	if err != nil {
+		c.log.Warn("failed to encode output", "block", ref, "err", err)
+		return
+	}
+	if err := c.store.Put(ctx, outputKey(ref.Hash), data); err != nil {
+		c.log.Warn("failed to write output to disk", "block", ref, "err", err)
+	}
+}
+
+func (c *outputCache) Close() error {
+	if c.store == nil {
+		return nil
+	}
+	return c.store.Close()
+}
+
+func outputKey(blockHash common.Hash) ds.Key {
+	return ds.NewKey("/output/" + blockHash.Hex())
+}
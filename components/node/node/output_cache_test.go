@@ -0,0 +1,55 @@
+package node
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kroma-network/kroma/components/node/eth"
+	"github.com/kroma-network/kroma/components/node/testlog"
+	"github.com/kroma-network/kroma/components/node/testutils"
+)
+
+func TestOutputCache(t *testing.T) {
+	logger := testlog.Logger(t, log.LvlError)
+	rng := rand.New(rand.NewSource(1234))
+	path := t.TempDir()
+
+	ref := testutils.RandomL2BlockRef(rng)
+	nextRef := testutils.NextRandomL2Ref(rng, 2, ref, ref.L1Origin)
+	unfinalizedRef := testutils.NextRandomL2Ref(rng, 2, nextRef, nextRef.L1Origin)
+	output := &cachedOutput{
+		OutputRoot:            eth.Bytes32(testutils.RandomHash(rng)),
+		NextBlockHash:         nextRef.Hash,
+		WithdrawalStorageRoot: testutils.RandomHash(rng),
+		StateRoot:             testutils.RandomHash(rng),
+	}
+
+	cache, err := newOutputCache(logger, nil, 10, path)
+	require.NoError(t, err)
+	cache.Add(context.Background(), ref, output, true)
+	cache.Add(context.Background(), unfinalizedRef, output, false)
+
+	got, ok := cache.Get(context.Background(), ref, nextRef)
+	require.True(t, ok)
+	require.Equal(t, output, got)
+
+	_, ok = cache.Get(context.Background(), ref, testutils.RandomL2BlockRef(rng))
+	require.False(t, ok, "output computed with another next block must not be served")
+	require.NoError(t, cache.Close())
+
+	// only the output of the finalized block is persisted
+	cache, err = newOutputCache(logger, nil, 10, path)
+	require.NoError(t, err)
+	defer cache.Close()
+
+	got, ok = cache.Get(context.Background(), ref, nextRef)
+	require.True(t, ok)
+	require.Equal(t, output, got)
+
+	_, ok = cache.Get(context.Background(), unfinalizedRef, nextRef)
+	require.False(t, ok)
+}
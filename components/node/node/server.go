@@ -6,6 +6,7 @@ import (
 	"net"
 	"net/http"
 	"strconv"
+	"strings"
 
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/ethereum/go-ethereum/node"
@@ -20,6 +21,7 @@ import (
 
 type rpcServer struct {
 	endpoint   string
+	nodeAPI    *nodeAPI
 	apis       []rpc.API
 	httpServer *http.Server
 	appVersion string
@@ -34,6 +36,7 @@ func newRPCServer(ctx context.Context, rpcCfg *RPCConfig, rollupCfg *rollup.Conf
 	endpoint := net.JoinHostPort(rpcCfg.ListenAddr, strconv.Itoa(rpcCfg.ListenPort))
 	r := &rpcServer{
 		endpoint: endpoint,
+		nodeAPI:  api,
 		apis: []rpc.API{{
 			Namespace:     "kroma",
 			Service:       api,
@@ -56,6 +59,31 @@ func (s *rpcServer) EnableAdminAPI(api *adminAPI) {
 	})
 }
 
+// EnableOutputCache makes the node API serve repeated output queries from the given cache.
+func (s *rpcServer) EnableOutputCache(cache *outputCache) {
+	s.nodeAPI.outputs = cache
+}
+
+func (s *rpcServer) EnableP2PAdminAPI(api *p2pAdminAPI) {
+	s.apis = append(s.apis, rpc.API{
+		Namespace:     "admin",
+		Version:       "",
+		Service:       api,
+		Public:        true,
+		Authenticated: false,
+	})
+}
+
+func (s *rpcServer) EnableEngineAdminAPI(api *engineAdminAPI) {
+	s.apis = append(s.apis, rpc.API{
+		Namespace:     "admin",
+		Version:       "",
+		Service:       api,
+		Public:        true,
+		Authenticated: false,
+	})
+}
+
 func (s *rpcServer) EnableP2P(backend *p2p.APIBackend) {
 	s.apis = append(s.apis, rpc.API{
 		Namespace:     p2p.NamespaceRPC,
@@ -77,9 +105,11 @@ func (s *rpcServer) Start() error {
 	// defaults to localhost, which will prevent containers from
 	// calling into the kroma-node without an "invalid host" error.
 	nodeHandler := node.NewHTTPHandlerStack(srv, []string{"*"}, []string{"*"}, nil)
+	// Websocket connections are served on the same endpoint, to support subscriptions.
+	wsHandler := node.NewWSHandlerStack(srv.WebsocketHandler([]string{"*"}), nil)
 
 	mux := http.NewServeMux()
-	mux.Handle("/", nodeHandler)
+	mux.Handle("/", withWebsocket(nodeHandler, wsHandler))
 	mux.HandleFunc("/healthz", healthzHandler(s.appVersion))
 
 	listener, err := net.Listen("tcp", s.endpoint)
@@ -105,6 +135,22 @@ func (r *rpcServer) Addr() net.Addr {
 	return r.listenAddr
 }
 
+// withWebsocket routes websocket upgrade requests to the websocket handler, and all other requests to the HTTP handler.
+func withWebsocket(httpHandler http.Handler, wsHandler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if isWebsocket(r) {
+			wsHandler.ServeHTTP(w, r)
+			return
+		}
+		httpHandler.ServeHTTP(w, r)
+	})
+}
+
+func isWebsocket(r *http.Request) bool {
+	return strings.ToLower(r.Header.Get("Upgrade")) == "websocket" &&
+		strings.Contains(strings.ToLower(r.Header.Get("Connection")), "upgrade")
+}
+
 func healthzHandler(appVersion string) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		_, _ = w.Write([]byte(appVersion))
@@ -5,10 +5,13 @@ import (
 	"encoding/json"
 	"math/rand"
 	"testing"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
 	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -219,8 +222,66 @@ func TestSyncStatus(t *testing.T) {
 	assert.Equal(t, status, out)
 }
 
+func TestSyncStatusSubscription(t *testing.T) {
+	log := testlog.Logger(t, log.LvlError)
+	l2Client := &testutils.MockL2Client{}
+	drClient := &mockDriverClient{}
+	rng := rand.New(rand.NewSource(1234))
+	status := randomSyncStatus(rng)
+	drClient.On("SyncStatus").Return(status)
+
+	rpcCfg := &RPCConfig{
+		ListenAddr: "localhost",
+		ListenPort: 0,
+	}
+	rollupCfg := &rollup.Config{
+		// ignore other rollup config info in this test
+	}
+	server, err := newRPCServer(context.Background(), rpcCfg, rollupCfg, l2Client, drClient, log, "0.0", metrics.NoopMetrics)
+	require.NoError(t, err)
+	require.NoError(t, server.Start())
+	defer server.Stop()
+
+	client, err := rpc.DialContext(context.Background(), "ws://"+server.Addr().String())
+	require.NoError(t, err)
+	defer client.Close()
+
+	statusCh := make(chan *eth.SyncStatus, 10)
+	statusSub, err := client.Subscribe(context.Background(), "kroma", statusCh, "syncStatusUpdates")
+	require.NoError(t, err)
+	defer statusSub.Unsubscribe()
+
+	headCh := make(chan eth.L2BlockRef, 10)
+	headSub, err := client.Subscribe(context.Background(), "kroma", headCh, "newSafeHeads")
+	require.NoError(t, err)
+	defer headSub.Unsubscribe()
+
+	// the current status is sent right after subscribing
+	require.Equal(t, status, <-statusCh)
+	require.Equal(t, status.SafeL2, <-headCh)
+
+	// wait for both subscriptions to be registered to the driver feed
+	require.Eventually(t, func() bool {
+		return drClient.statusFeed.Send(status) == 2
+	}, 5*time.Second, 10*time.Millisecond)
+
+	next := *status
+	next.UnsafeL2 = testutils.NextRandomL2Ref(rng, 2, status.UnsafeL2, status.UnsafeL2.L1Origin)
+	drClient.statusFeed.Send(&next)
+	require.Equal(t, &next, <-statusCh)
+
+	last := next
+	last.SafeL2 = testutils.NextRandomL2Ref(rng, 2, status.SafeL2, status.SafeL2.L1Origin)
+	drClient.statusFeed.Send(&last)
+	require.Equal(t, &last, <-statusCh)
+	// the safe head subscription ignores the unsafe head change
+	require.Equal(t, last.SafeL2, <-headCh)
+}
+
 type mockDriverClient struct {
 	mock.Mock
+	statusFeed event.Feed
+	attrsFeed  event.Feed
 }
 
 func (c *mockDriverClient) ExpectBlockRefsWithStatus(num uint64, ref, nextRef eth.L2BlockRef, status *eth.SyncStatus, err error) {
@@ -247,3 +308,15 @@ func (c *mockDriverClient) StartProposer(ctx context.Context, blockHash common.H
 func (c *mockDriverClient) StopProposer(ctx context.Context) (common.Hash, error) {
 	return c.Mock.MethodCalled("StopProposer").Get(0).(common.Hash), nil
 }
+
+func (c *mockDriverClient) SubscribeSyncStatus(ch chan<- *eth.SyncStatus) event.Subscription {
+	return c.statusFeed.Subscribe(ch)
+}
+
+func (c *mockDriverClient) SubscribePayloadAttributes(ch chan<- *eth.PayloadAttributesEvent) event.Subscription {
+	return c.attrsFeed.Subscribe(ch)
+}
+
+func (c *mockDriverClient) SubmitExternalPayload(ctx context.Context, payload *eth.ExecutionPayload) error {
+	return c.Mock.MethodCalled("SubmitExternalPayload", payload).Error(0)
+}
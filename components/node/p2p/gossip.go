@@ -7,6 +7,7 @@ import (
 	"encoding/binary"
 	"fmt"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -368,6 +369,19 @@ type GossipIn interface {
 	OnUnsafeL2Payload(ctx context.Context, from peer.ID, msg *eth.ExecutionPayload) error
 }
 
+// toggledGossipIn drops gossiped payloads while gossip is disabled.
+type toggledGossipIn struct {
+	GossipIn
+	disabled *atomic.Bool
+}
+
+func (g *toggledGossipIn) OnUnsafeL2Payload(ctx context.Context, from peer.ID, msg *eth.ExecutionPayload) error {
+	if g.disabled.Load() {
+		return nil
+	}
+	return g.GossipIn.OnUnsafeL2Payload(ctx, from, msg)
+}
+
 type GossipTopicInfo interface {
 	BlocksTopicPeers() []peer.ID
 }
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"net"
 	"strconv"
+	"sync/atomic"
 	"time"
 
 	"github.com/ethereum/go-ethereum/log"
@@ -47,6 +48,9 @@ type NodeP2P struct {
 	gsOut    GossipOut        // p2p gossip application interface for publishing
 	syncCl   *SyncClient
 	syncSrv  *ReqRespServer
+
+	// gossipDisabled is set when the publishing and processing of gossiped blocks is disabled at runtime.
+	gossipDisabled atomic.Bool
 }
 
 // NewNodeP2P creates a new p2p node, and returns a reference to it. If the p2p is disabled, it returns nil.
@@ -134,7 +138,7 @@ func (n *NodeP2P) init(resourcesCtx context.Context, rollupCfg *rollup.Config, l
 		if err != nil {
 			return fmt.Errorf("failed to start gossipsub router: %w", err)
 		}
-		n.gsOut, err = JoinGossip(resourcesCtx, n.host.ID(), n.gs, log, rollupCfg, runCfg, gossipIn)
+		n.gsOut, err = JoinGossip(resourcesCtx, n.host.ID(), n.gs, log, rollupCfg, runCfg, &toggledGossipIn{GossipIn: gossipIn, disabled: &n.gossipDisabled})
 		if err != nil {
 			return fmt.Errorf("failed to join blocks gossip topic: %w", err)
 		}
@@ -195,6 +199,16 @@ func (n *NodeP2P) GossipOut() GossipOut {
 	return n.gsOut
 }
 
+// SetGossipEnabled enables or disables the publishing and processing of gossiped blocks.
+// The node stays subscribed to the gossip topic, so gossip can be resumed without re-joining the topic.
+func (n *NodeP2P) SetGossipEnabled(enabled bool) {
+	n.gossipDisabled.Store(!enabled)
+}
+
+func (n *NodeP2P) GossipEnabled() bool {
+	return !n.gossipDisabled.Load()
+}
+
 func (n *NodeP2P) ConnectionGater() gating.BlockingConnectionGater {
 	return n.gater
 }
@@ -21,6 +21,9 @@ import (
 // BatchV1Type := 0
 // batchV1 := BatchV1Type ++ RLP([epoch, timestamp, transaction_list]
 //
+// SpanBatchType := 1
+// spanBatch := SpanBatchType ++ RLP([parent_hash, l1_origin_hash, [[epoch, timestamp, transaction_list], ...]])
+//
 // An empty input is not a valid batch.
 //
 // Note: the type system is based on L1 typed transactions.
@@ -32,6 +35,7 @@ var encodeBufferPool = sync.Pool{
 
 const (
 	BatchV1Type = iota
+	SpanBatchType
 )
 
 type BatchV1 struct {
@@ -46,6 +50,9 @@ type BatchV1 struct {
 type BatchData struct {
 	BatchV1
 	// batches may contain additional data with new upgrades
+
+	// Span is set instead of BatchV1 if the batch is a span batch, which is only valid after the span batch upgrade.
+	Span *SpanBatch
 }
 
 func (b *BatchV1) Epoch() eth.BlockID {
@@ -71,6 +78,10 @@ func (b *BatchData) MarshalBinary() ([]byte, error) {
 }
 
 func (b *BatchData) encodeTyped(buf *bytes.Buffer) error {
+	if b.Span != nil {
+		buf.WriteByte(SpanBatchType)
+		return rlp.Encode(buf, b.Span)
+	}
 	buf.WriteByte(BatchV1Type)
 	return rlp.Encode(buf, &b.BatchV1)
 }
@@ -102,6 +113,9 @@ func (b *BatchData) decodeTyped(data []byte) error {
 	switch data[0] {
 	case BatchV1Type:
 		return rlp.DecodeBytes(data[1:], &b.BatchV1)
+	case SpanBatchType:
+		b.Span = new(SpanBatch)
+		return rlp.DecodeBytes(data[1:], b.Span)
 	default:
 		return fmt.Errorf("unrecognized batch type: %d", data[0])
 	}
@@ -44,6 +44,9 @@ type BatchQueue struct {
 
 	// batches in order of when we've first seen them, grouped by L2 timestamp
 	batches map[uint64][]*BatchWithL1InclusionBlock
+
+	// lastBatch is the last derived batch, to link the batches of a span batch to the safe head.
+	lastBatch *BatchData
 }
 
 // NewBatchQueue creates a BatchQueue, which should be Reset(origin) before use.
@@ -111,6 +114,7 @@ func (bq *BatchQueue) NextBatch(ctx context.Context, safeL2Head eth.L2BlockRef)
 	} else if err != nil {
 		return nil, err
 	}
+	bq.lastBatch = batch
 	return batch, nil
 }
 
@@ -119,6 +123,7 @@ func (bq *BatchQueue) Reset(ctx context.Context, base eth.L1BlockRef, _ eth.Syst
 	// It is set in the engine queue (two stages away) such that the L2 Safe Head origin is the progress
 	bq.origin = base
 	bq.batches = make(map[uint64][]*BatchWithL1InclusionBlock)
+	bq.lastBatch = nil
 	// Include the new origin as an origin to build on
 	// Note: This is only for the initialization case. During normal resets we will later
 	// throw out this block.
@@ -131,16 +136,51 @@ func (bq *BatchQueue) AddBatch(batch *BatchData, l2SafeHead eth.L2BlockRef) {
 	if len(bq.l1Blocks) == 0 {
 		panic(fmt.Errorf("cannot add batch with timestamp %d, no origin was prepared", batch.Timestamp))
 	}
-	data := BatchWithL1InclusionBlock{
+	if batch.Span != nil {
+		bq.addSpanBatch(batch.Span, l2SafeHead)
+		return
+	}
+	bq.addBatch(&BatchWithL1InclusionBlock{
 		L1InclusionBlock: bq.origin,
 		Batch:            batch,
+	}, l2SafeHead)
+}
+
+// addSpanBatch expands the span batch into singular batches, and adds them in order.
+func (bq *BatchQueue) addSpanBatch(span *SpanBatch, l2SafeHead eth.L2BlockRef) {
+	if !bq.config.IsSpanBatch(bq.origin.Time) {
+		bq.log.Warn("dropping span batch, span batches are not active yet", "l1_inclusion_block", bq.origin.ID())
+		return
+	}
+	batches, err := span.singularBatches(bq.l1Blocks, bq.config.BlockTime)
+	if err != nil {
+		bq.log.Warn("dropping invalid span batch", "parent_hash", span.ParentHash, "blocks", len(span.Blocks), "err", err)
+		return
 	}
-	validity := CheckBatch(bq.config, bq.log, bq.l1Blocks, l2SafeHead, &data)
+	var prev *BatchData
+	for _, batch := range batches {
+		data := &BatchWithL1InclusionBlock{
+			L1InclusionBlock: bq.origin,
+			Batch:            batch,
+			spanPrev:         prev,
+		}
+		if !bq.addBatch(data, l2SafeHead) {
+			return // the remaining batches of the span batch cannot build on a dropped batch
+		}
+		prev = batch
+	}
+}
+
+// addBatch adds the batch to the queue, unless it is invalid. It returns whether the batch was added.
+func (bq *BatchQueue) addBatch(data *BatchWithL1InclusionBlock, l2SafeHead eth.L2BlockRef) bool {
+	validity := CheckBatch(bq.config, bq.log, bq.l1Blocks, l2SafeHead, data)
 	if validity == BatchDrop {
-		return // if we do drop the batch, CheckBatch will log the drop reason with WARN level.
+		return false // if we do drop the batch, CheckBatch will log the drop reason with WARN level.
 	}
+	batch := data.Batch
 	bq.log.Debug("Adding batch", "batch_timestamp", batch.Timestamp, "parent_hash", batch.ParentHash, "batch_epoch", batch.Epoch(), "txs", len(batch.Transactions))
-	bq.batches[batch.Timestamp] = append(bq.batches[batch.Timestamp], &data)
+	bq.batches[batch.Timestamp] = append(bq.batches[batch.Timestamp], data)
+	return true
 }
 
 // deriveNextBatch derives the next batch to apply on top of the current L2 safe head,
@@ -173,6 +213,10 @@ func (bq *BatchQueue) deriveNextBatch(ctx context.Context, outOfData bool, l2Saf
 	candidates := bq.batches[nextTimestamp]
 batchLoop:
 	for i, batch := range candidates {
+		if batch.spanPrev != nil && batch.spanPrev == bq.lastBatch {
+			// The preceding batch of the span batch was derived last, so the safe head is its parent.
+			batch.Batch.ParentHash = l2SafeHead.Hash
+		}
 		validity := CheckBatch(bq.config, bq.log.New("batch_index", i), bq.l1Blocks, l2SafeHead, batch)
 		switch validity {
 		case BatchFuture:
@@ -244,7 +288,7 @@ batchLoop:
 	if nextTimestamp < nextEpoch.Time || firstOfEpoch {
 		bq.log.Info("Generating next batch", "epoch", epoch, "timestamp", nextTimestamp)
 		return &BatchData{
-			BatchV1{
+			BatchV1: BatchV1{
 				ParentHash:   l2SafeHead.Hash,
 				EpochNum:     rollup.Epoch(epoch.Number),
 				EpochHash:    epoch.Hash,
@@ -48,7 +48,7 @@ func mockHash(time uint64, layer uint8) common.Hash {
 func b(timestamp uint64, epoch eth.L1BlockRef) *BatchData {
 	rng := rand.New(rand.NewSource(int64(timestamp)))
 	data := testutils.RandomData(rng, 20)
-	return &BatchData{BatchV1{
+	return &BatchData{BatchV1: BatchV1{
 		ParentHash:   mockHash(timestamp-2, 2),
 		Timestamp:    timestamp,
 		EpochNum:     rollup.Epoch(epoch.Number),
@@ -183,6 +183,70 @@ func TestBatchQueueEager(t *testing.T) {
 	}
 }
 
+// TestBatchQueueSpanBatch adds a span batch of contiguous blocks and asserts that
+// enough calls to `NextBatch` return all of its blocks as singular batches, once span batches are active.
+func TestBatchQueueSpanBatch(t *testing.T) {
+	log := testlog.Logger(t, log.LvlCrit)
+	l1 := L1Chain([]uint64{10, 20, 30})
+	expected := []*BatchData{b(12, l1[0]), b(14, l1[0]), b(16, l1[0]), b(18, l1[0]), b(20, l1[0]), b(22, l1[0]), b(24, l1[1])}
+	var span SpanBatch
+	for _, batch := range expected {
+		span.AppendBatch(&batch.BatchV1)
+	}
+
+	run := func(t *testing.T, spanBatchTime *uint64) []*BatchData {
+		safeHead := eth.L2BlockRef{
+			Hash:     mockHash(10, 2),
+			Time:     10,
+			L1Origin: l1[0].ID(),
+		}
+		cfg := &rollup.Config{
+			Genesis: rollup.Genesis{
+				L2Time: 10,
+			},
+			BlockTime:          2,
+			MaxProposerDrift:   600,
+			ProposerWindowSize: 30,
+			SpanBatchTime:      spanBatchTime,
+		}
+		input := &fakeBatchQueueInput{
+			batches: []*BatchData{{Span: &span}},
+			errors:  []error{nil},
+			origin:  l1[0],
+		}
+		bq := NewBatchQueue(log, cfg, input)
+		_ = bq.Reset(context.Background(), l1[0], eth.SystemConfig{})
+		// Advance the origin
+		input.origin = l1[1]
+
+		var out []*BatchData
+		for i := 0; i <= len(expected)+1; i++ {
+			b, err := bq.NextBatch(context.Background(), safeHead)
+			if err == io.EOF {
+				break
+			} else if b == nil {
+				require.ErrorIs(t, err, NotEnoughData)
+				continue
+			}
+			require.NoError(t, err)
+			out = append(out, b)
+			safeHead.Number += 1
+			safeHead.Time += 2
+			safeHead.Hash = mockHash(b.Timestamp, 2)
+			safeHead.L1Origin = b.Epoch()
+		}
+		return out
+	}
+
+	t.Run("active", func(t *testing.T) {
+		require.Equal(t, expected, run(t, new(uint64)))
+	})
+	t.Run("inactive", func(t *testing.T) {
+		spanBatchTime := l1[1].Time + 1
+		require.Empty(t, run(t, &spanBatchTime))
+	})
+}
+
 // TestBatchQueueInvalidInternalAdvance asserts that we do not miss an epoch when generating batches.
 // This is a regression test for CLI-3378.
 func TestBatchQueueInvalidInternalAdvance(t *testing.T) {
@@ -26,6 +26,24 @@ func TestBatchRoundTrip(t *testing.T) {
 				Transactions: []hexutil.Bytes{[]byte{0, 0, 0}, []byte{0x76, 0xfd, 0x7c}},
 			},
 		},
+		{
+			Span: &SpanBatch{
+				ParentHash:   common.Hash{31: 0x42},
+				L1OriginHash: common.Hash{31: 0x43},
+				Blocks: []SpanBatchElement{
+					{
+						EpochNum:     1,
+						Timestamp:    1647026951,
+						Transactions: []hexutil.Bytes{[]byte{0, 0, 0}},
+					},
+					{
+						EpochNum:     2,
+						Timestamp:    1647026953,
+						Transactions: []hexutil.Bytes{},
+					},
+				},
+			},
+		},
 	}
 
 	for i, batch := range batches {
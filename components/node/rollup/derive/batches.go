@@ -11,6 +11,10 @@ import (
 type BatchWithL1InclusionBlock struct {
 	L1InclusionBlock eth.L1BlockRef
 	Batch            *BatchData
+
+	// spanPrev is the preceding batch of the same span batch, if any.
+	// The parent hash of the batch is only known once spanPrev has been derived.
+	spanPrev *BatchData
 }
 
 type BatchValidity uint8
@@ -11,6 +11,7 @@ import (
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/log"
 
+	"github.com/kroma-network/kroma/components/node/altda"
 	"github.com/kroma-network/kroma/components/node/eth"
 	"github.com/kroma-network/kroma/components/node/rollup"
 )
@@ -23,22 +24,30 @@ type L1TransactionFetcher interface {
 	InfoAndTxsByHash(ctx context.Context, hash common.Hash) (eth.BlockInfo, types.Transactions, error)
 }
 
+// AltDAFetcher fetches the data of commitments to data on an external DA layer.
+type AltDAFetcher interface {
+	GetInput(ctx context.Context, comm altda.Commitment) ([]byte, error)
+}
+
 // DataSourceFactory readers raw transactions from a given block & then filters for
 // batch submitter transactions.
 // This is not a stage in the pipeline, but a wrapper for another stage in the pipeline
 type DataSourceFactory struct {
-	log     log.Logger
-	cfg     *rollup.Config
-	fetcher L1TransactionFetcher
+	log       log.Logger
+	cfg       *rollup.Config
+	fetcher   L1TransactionFetcher
+	daFetcher AltDAFetcher
 }
 
-func NewDataSourceFactory(log log.Logger, cfg *rollup.Config, fetcher L1TransactionFetcher) *DataSourceFactory {
-	return &DataSourceFactory{log: log, cfg: cfg, fetcher: fetcher}
+// NewDataSourceFactory creates a DataSourceFactory. The alt-DA fetcher is optional,
+// but it is required to derive the data of alt-DA commitments once they are active.
+func NewDataSourceFactory(log log.Logger, cfg *rollup.Config, fetcher L1TransactionFetcher, daFetcher AltDAFetcher) *DataSourceFactory {
+	return &DataSourceFactory{log: log, cfg: cfg, fetcher: fetcher, daFetcher: daFetcher}
 }
 
 // OpenData returns a DataIter. This struct implements the `Next` function.
 func (ds *DataSourceFactory) OpenData(ctx context.Context, id eth.BlockID, batcherAddr common.Address) DataIter {
-	return NewDataSource(ctx, ds.log, ds.cfg, ds.fetcher, id, batcherAddr)
+	return NewDataSource(ctx, ds.log, ds.cfg, ds.fetcher, ds.daFetcher, id, batcherAddr)
 }
 
 // DataSource is a fault tolerant approach to fetching data.
@@ -48,43 +57,47 @@ type DataSource struct {
 	// Internal state + data
 	open bool
 	data []eth.Data
+	// l1Time is the timestamp of the L1 block, to determine if alt-DA commitments are active
+	l1Time uint64
 	// Required to re-attempt fetching
-	id      eth.BlockID
-	cfg     *rollup.Config // TODO: `DataFromEVMTransactions` should probably not take the full config
-	fetcher L1TransactionFetcher
-	log     log.Logger
+	id        eth.BlockID
+	cfg       *rollup.Config // TODO: `DataFromEVMTransactions` should probably not take the full config
+	fetcher   L1TransactionFetcher
+	daFetcher AltDAFetcher
+	log       log.Logger
 
 	batcherAddr common.Address
 }
 
 // NewDataSource creates a new calldata source. It suppresses errors in fetching the L1 block if they occur.
 // If there is an error, it will attempt to fetch the result on the next call to `Next`.
-func NewDataSource(ctx context.Context, log log.Logger, cfg *rollup.Config, fetcher L1TransactionFetcher, block eth.BlockID, batcherAddr common.Address) DataIter {
-	_, txs, err := fetcher.InfoAndTxsByHash(ctx, block.Hash)
-	if err != nil {
-		return &DataSource{
-			open:        false,
-			id:          block,
-			cfg:         cfg,
-			fetcher:     fetcher,
-			log:         log,
-			batcherAddr: batcherAddr,
-		}
-	} else {
-		return &DataSource{
-			open: true,
-			data: DataFromEVMTransactions(cfg, batcherAddr, txs, log.New("origin", block)),
-		}
+func NewDataSource(ctx context.Context, log log.Logger, cfg *rollup.Config, fetcher L1TransactionFetcher, daFetcher AltDAFetcher, block eth.BlockID, batcherAddr common.Address) DataIter {
+	ds := &DataSource{
+		id:          block,
+		cfg:         cfg,
+		fetcher:     fetcher,
+		daFetcher:   daFetcher,
+		log:         log,
+		batcherAddr: batcherAddr,
+	}
+	if info, txs, err := fetcher.InfoAndTxsByHash(ctx, block.Hash); err == nil {
+		ds.open = true
+		ds.l1Time = info.Time()
+		ds.data = DataFromEVMTransactions(cfg, batcherAddr, txs, log.New("origin", block))
 	}
+	return ds
 }
 
 // Next returns the next piece of data if it has it. If the constructor failed, this
 // will attempt to reinitialize itself. If it cannot find the block it returns a ResetError
 // otherwise it returns a temporary error if fetching the block returns an error.
+// Once alt-DA commitments are active, the data of commitments is fetched from the external DA layer.
+// It returns a temporary error if the data cannot be fetched, and retries fetching it on the next call.
 func (ds *DataSource) Next(ctx context.Context) (eth.Data, error) {
 	if !ds.open {
-		if _, txs, err := ds.fetcher.InfoAndTxsByHash(ctx, ds.id.Hash); err == nil {
+		if info, txs, err := ds.fetcher.InfoAndTxsByHash(ctx, ds.id.Hash); err == nil {
 			ds.open = true
+			ds.l1Time = info.Time()
 			ds.data = DataFromEVMTransactions(ds.cfg, ds.batcherAddr, txs, log.New("origin", ds.id))
 		} else if errors.Is(err, ethereum.NotFound) {
 			return nil, NewResetError(fmt.Errorf("failed to open calldata source: %w", err))
@@ -92,17 +105,33 @@ func (ds *DataSource) Next(ctx context.Context) (eth.Data, error) {
 			return nil, NewTemporaryError(fmt.Errorf("failed to open calldata source: %w", err))
 		}
 	}
-	if len(ds.data) == 0 {
-		return nil, io.EOF
-	} else {
+	for len(ds.data) > 0 {
 		data := ds.data[0]
+		if len(data) == 0 || data[0] != DerivationVersionAltDA || !ds.cfg.IsAltDA(ds.l1Time) {
+			ds.data = ds.data[1:]
+			return data, nil
+		}
+		comm, err := altda.DecodeCommitment(data[1:])
+		if err != nil {
+			ds.log.Warn("ignoring invalid alt-DA commitment", "origin", ds.id, "err", err)
+			ds.data = ds.data[1:]
+			continue
+		}
+		if ds.daFetcher == nil {
+			return nil, NewCriticalError(fmt.Errorf("cannot fetch the data of alt-DA commitment %s without a DA server", comm))
+		}
+		input, err := ds.daFetcher.GetInput(ctx, comm)
+		if err != nil {
+			return nil, NewTemporaryError(fmt.Errorf("failed to fetch the data of alt-DA commitment %s: %w", comm, err))
+		}
 		ds.data = ds.data[1:]
-		return data, nil
+		return input, nil
 	}
+	return nil, io.EOF
 }
 
 // DataFromEVMTransactions filters all of the transactions and returns the calldata from transactions
-// that are sent to the batch inbox address from the batch sender address.
+// that are sent to the batch inbox address from the batch sender address, or from one of the extra batcher addresses.
 // This will return an empty array if no valid transactions are found.
 func DataFromEVMTransactions(config *rollup.Config, batcherAddr common.Address, txs types.Transactions, log log.Logger) []eth.Data {
 	var out []eth.Data
@@ -115,7 +144,7 @@ func DataFromEVMTransactions(config *rollup.Config, batcherAddr common.Address,
 				continue // bad signature, ignore
 			}
 			// some random L1 user might have sent a transaction to our batch inbox, ignore them
-			if seqDataSubmitter != batcherAddr {
+			if seqDataSubmitter != batcherAddr && !config.IsExtraBatcherAddr(seqDataSubmitter) {
 				log.Warn("tx in inbox with unauthorized submitter", "index", j, "err", err)
 				continue // not an authorized batch submitter, ignore
 			}
@@ -54,9 +54,11 @@ type calldataTest struct {
 func TestDataFromEVMTransactions(t *testing.T) {
 	inboxPriv := testutils.RandomKey()
 	batcherPriv := testutils.RandomKey()
+	extraBatcherPriv := testutils.RandomKey()
 	cfg := &rollup.Config{
 		L1ChainID:         big.NewInt(100),
 		BatchInboxAddress: crypto.PubkeyToAddress(inboxPriv.PublicKey),
+		ExtraBatcherAddrs: []common.Address{crypto.PubkeyToAddress(extraBatcherPriv.PublicKey)},
 	}
 	batcherAddr := crypto.PubkeyToAddress(batcherPriv.PublicKey)
 
@@ -92,6 +94,12 @@ func TestDataFromEVMTransactions(t *testing.T) {
 		{
 			name: "value tx",
 			txs:  []testTx{{to: &cfg.BatchInboxAddress, dataLen: 1234, value: 42, author: batcherPriv, good: true}}},
+		{
+			name: "extra batcher author",
+			txs:  []testTx{{to: &cfg.BatchInboxAddress, dataLen: 1234, author: extraBatcherPriv, good: true}}},
+		{
+			name: "extra batcher to other inbox",
+			txs:  []testTx{{to: &altInbox, dataLen: 1234, author: extraBatcherPriv, good: false}}},
 		{
 			name: "empty block", txs: []testTx{},
 		},
@@ -2,7 +2,6 @@ package derive
 
 import (
 	"bytes"
-	"compress/zlib"
 	"fmt"
 	"io"
 
@@ -145,9 +144,10 @@ func (ch *Channel) Reader() io.Reader {
 
 // BatchReader provides a function that iteratively consumes batches from the reader.
 // The L1Inclusion block is also provided at creation time.
-func BatchReader(r io.Reader, l1InclusionBlock eth.L1BlockRef) (func() (BatchWithL1InclusionBlock, error), error) {
+// Zstd compressed channel data is only accepted if zstdActive is true.
+func BatchReader(r io.Reader, l1InclusionBlock eth.L1BlockRef, zstdActive bool) (func() (BatchWithL1InclusionBlock, error), error) {
 	// Setup decompressor stage + RLP reader
-	zr, err := zlib.NewReader(r)
+	zr, err := DecompressChannel(r, zstdActive)
 	if err != nil {
 		return nil, err
 	}
@@ -8,6 +8,7 @@ import (
 	"github.com/ethereum/go-ethereum/log"
 
 	"github.com/kroma-network/kroma/components/node/eth"
+	"github.com/kroma-network/kroma/components/node/rollup"
 )
 
 // ChannelInReader reads a batch from the channel
@@ -16,6 +17,7 @@ import (
 // must be tagged with an L1 inclusion block to be passed to the batch queue.
 type ChannelInReader struct {
 	log log.Logger
+	cfg *rollup.Config
 
 	nextBatchFn func() (BatchWithL1InclusionBlock, error)
 
@@ -27,9 +29,10 @@ type ChannelInReader struct {
 var _ ResetableStage = (*ChannelInReader)(nil)
 
 // NewChannelInReader creates a ChannelInReader, which should be Reset(origin) before use.
-func NewChannelInReader(log log.Logger, prev *ChannelBank, metrics Metrics) *ChannelInReader {
+func NewChannelInReader(log log.Logger, cfg *rollup.Config, prev *ChannelBank, metrics Metrics) *ChannelInReader {
 	return &ChannelInReader{
 		log:     log,
+		cfg:     cfg,
 		prev:    prev,
 		metrics: metrics,
 	}
@@ -41,7 +44,7 @@ func (cr *ChannelInReader) Origin() eth.L1BlockRef {
 
 // TODO: Take full channel for better logging
 func (cr *ChannelInReader) WriteChannel(data []byte) error {
-	if f, err := BatchReader(bytes.NewBuffer(data), cr.Origin()); err == nil {
+	if f, err := BatchReader(bytes.NewBuffer(data), cr.Origin(), cr.cfg.IsZstd(cr.Origin().Time)); err == nil {
 		cr.nextBatchFn = f
 		cr.metrics.RecordChannelInputBytes(len(data))
 		return nil
@@ -2,7 +2,6 @@ package derive
 
 import (
 	"bytes"
-	"compress/zlib"
 	"crypto/rand"
 	"errors"
 	"fmt"
@@ -36,7 +35,8 @@ type ChannelOut struct {
 	rlpLength int
 
 	// Compressor stage. Write input data to it
-	compress *zlib.Writer
+	compress compressor
+	algo     CompressionAlgo
 	// post compression buffer
 	buf bytes.Buffer
 
@@ -47,18 +47,27 @@ func (co *ChannelOut) ID() ChannelID {
 	return co.id
 }
 
+// NewChannelOut creates a channel that compresses its data with zlib at the best compression level.
 func NewChannelOut() (*ChannelOut, error) {
+	return NewChannelOutWithCompression(Zlib, 0)
+}
+
+// NewChannelOutWithCompression creates a channel that compresses its data with the given algorithm and level.
+// The level is specific to the algorithm, and 0 selects the best compression level.
+func NewChannelOutWithCompression(algo CompressionAlgo, level int) (*ChannelOut, error) {
 	c := &ChannelOut{
 		id:        ChannelID{}, // TODO: use GUID here instead of fully random data
 		frame:     0,
 		rlpLength: 0,
+		algo:      algo,
 	}
 	_, err := rand.Read(c.id[:])
 	if err != nil {
 		return nil, err
 	}
 
-	compress, err := zlib.NewWriterLevel(&c.buf, zlib.BestCompression)
+	c.writeVersion()
+	compress, err := newCompressor(&c.buf, algo, level)
 	if err != nil {
 		return nil, err
 	}
@@ -67,11 +76,19 @@ func NewChannelOut() (*ChannelOut, error) {
 	return c, nil
 }
 
+// writeVersion prefixes the channel data with the version of the compression algorithm, if any.
+func (co *ChannelOut) writeVersion() {
+	if co.algo == Zstd {
+		co.buf.WriteByte(ChannelVersionZstd)
+	}
+}
+
 // TODO: reuse ChannelOut for performance
 func (co *ChannelOut) Reset() error {
 	co.frame = 0
 	co.rlpLength = 0
 	co.buf.Reset()
+	co.writeVersion()
 	co.compress.Reset(&co.buf)
 	co.closed = false
 	_, err := rand.Read(co.id[:])
@@ -222,7 +239,7 @@ func BlockToBatch(block *types.Block) (*BatchData, L1BlockInfo, error) {
 	}
 
 	return &BatchData{
-		BatchV1{
+		BatchV1: BatchV1{
 			ParentHash:   block.ParentHash(),
 			EpochNum:     rollup.Epoch(l1Info.Number),
 			EpochHash:    l1Info.BlockHash,
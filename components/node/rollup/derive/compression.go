@@ -0,0 +1,103 @@
+package derive
+
+import (
+	"bufio"
+	"compress/zlib"
+	"fmt"
+	"io"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+// CompressionAlgo is the algorithm used to compress the channel data.
+type CompressionAlgo string
+
+const (
+	Zlib CompressionAlgo = "zlib"
+	// Zstd channels can only be derived once the zstd hardfork is active, see rollup.Config.IsZstd.
+	Zstd CompressionAlgo = "zstd"
+)
+
+var CompressionAlgos = []CompressionAlgo{
+	Zlib,
+	Zstd,
+}
+
+// ChannelVersionZstd is the first byte of zstd compressed channel data.
+// Zlib compressed channel data starts with the zlib CMF byte, of which the lower nibble is always 8 (deflate),
+// so channels of both algorithms can be distinguished by their first byte.
+const ChannelVersionZstd byte = 0x01
+
+func (algo CompressionAlgo) String() string {
+	return string(algo)
+}
+
+func (algo *CompressionAlgo) Set(value string) error {
+	if !ValidCompressionAlgo(CompressionAlgo(value)) {
+		return fmt.Errorf("unknown compression algo: %q", value)
+	}
+	*algo = CompressionAlgo(value)
+	return nil
+}
+
+func ValidCompressionAlgo(value CompressionAlgo) bool {
+	for _, algo := range CompressionAlgos {
+		if algo == value {
+			return true
+		}
+	}
+	return false
+}
+
+// compressor is the compression stage of a channel.
+type compressor interface {
+	io.Writer
+	Flush() error
+	Close() error
+	Reset(w io.Writer)
+}
+
+// newCompressor creates a compressor of the given algorithm, writing to w.
+// The level is specific to the algorithm, and 0 selects the best compression level.
+func newCompressor(w io.Writer, algo CompressionAlgo, level int) (compressor, error) {
+	switch algo {
+	case Zlib:
+		if level == 0 {
+			level = zlib.BestCompression
+		}
+		return zlib.NewWriterLevel(w, level)
+	case Zstd:
+		encLevel := zstd.SpeedBestCompression
+		if level != 0 {
+			encLevel = zstd.EncoderLevelFromZstd(level)
+		}
+		return zstd.NewWriter(w, zstd.WithEncoderLevel(encLevel), zstd.WithEncoderConcurrency(1))
+	default:
+		return nil, fmt.Errorf("unknown compression algo: %q", algo)
+	}
+}
+
+// DecompressChannel detects the compression algorithm of the channel data, and returns a reader of the decompressed data.
+// Zstd compressed channels are rejected if zstd is not active yet.
+func DecompressChannel(r io.Reader, zstdActive bool) (io.Reader, error) {
+	br := bufio.NewReader(r)
+	version, err := br.Peek(1)
+	if err != nil {
+		return nil, err
+	}
+	if version[0] != ChannelVersionZstd {
+		return zlib.NewReader(br)
+	}
+	if !zstdActive {
+		return nil, fmt.Errorf("zstd compressed channel before zstd activation")
+	}
+	if _, err := br.Discard(1); err != nil {
+		return nil, err
+	}
+	// single-threaded decoding runs synchronously, without background goroutines to clean up
+	dec, err := zstd.NewReader(br, zstd.WithDecoderConcurrency(1), zstd.WithDecoderMaxMemory(MaxRLPBytesPerChannel))
+	if err != nil {
+		return nil, err
+	}
+	return dec, nil
+}
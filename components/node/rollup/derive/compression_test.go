@@ -0,0 +1,59 @@
+package derive
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kroma-network/kroma/components/node/eth"
+)
+
+func TestChannelCompression(t *testing.T) {
+	batch := &BatchData{BatchV1: BatchV1{
+		ParentHash:   common.Hash{0x1},
+		EpochNum:     12,
+		EpochHash:    common.Hash{0x2},
+		Timestamp:    1234,
+		Transactions: []hexutil.Bytes{[]byte("tx a"), []byte("tx b")},
+	}}
+	channelData := func(t *testing.T, algo CompressionAlgo, level int) []byte {
+		co, err := NewChannelOutWithCompression(algo, level)
+		require.NoError(t, err)
+		_, err = co.AddBatch(batch)
+		require.NoError(t, err)
+		require.NoError(t, co.Close())
+		return co.buf.Bytes()
+	}
+	readBatch := func(data []byte, zstdActive bool) (*BatchData, error) {
+		br, err := BatchReader(bytes.NewReader(data), eth.L1BlockRef{}, zstdActive)
+		if err != nil {
+			return nil, err
+		}
+		out, err := br()
+		return out.Batch, err
+	}
+
+	for _, level := range []int{0, 1} {
+		data := channelData(t, Zlib, level)
+		require.Equal(t, byte(8), data[0]&0x0f, "zlib data starts with the deflate compression method")
+		for _, zstdActive := range []bool{false, true} {
+			out, err := readBatch(data, zstdActive)
+			require.NoError(t, err)
+			require.Equal(t, batch, out)
+		}
+
+		data = channelData(t, Zstd, level)
+		require.Equal(t, ChannelVersionZstd, data[0])
+		out, err := readBatch(data, true)
+		require.NoError(t, err)
+		require.Equal(t, batch, out)
+		_, err = readBatch(data, false)
+		require.ErrorContains(t, err, "before zstd activation")
+	}
+
+	_, err := NewChannelOutWithCompression("brotli", 0)
+	require.ErrorContains(t, err, "unknown compression algo")
+}
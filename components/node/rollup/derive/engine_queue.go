@@ -56,6 +56,9 @@ type EngineControl interface {
 	StartPayload(ctx context.Context, parent eth.L2BlockRef, attrs *eth.PayloadAttributes, updateSafe bool) (errType BlockInsertionErrType, err error)
 	// ConfirmPayload requests the engine to complete the current block. If no block is being built, or if it fails, an error is returned.
 	ConfirmPayload(ctx context.Context) (out *eth.ExecutionPayload, errTyp BlockInsertionErrType, err error)
+	// ConfirmExternalPayload requests the engine to complete the current block with the given externally built payload,
+	// instead of the payload built by the engine. The payload must build on top of the block that is being built onto.
+	ConfirmExternalPayload(ctx context.Context, payload *eth.ExecutionPayload) (out *eth.ExecutionPayload, errTyp BlockInsertionErrType, err error)
 	// CancelPayload requests the engine to stop building the current block without making it canonical.
 	// This is optional, as the engine expires building jobs that are left uncompleted, but can still save resources.
 	CancelPayload(ctx context.Context, force bool) error
@@ -642,6 +645,34 @@ func (eq *EngineQueue) ConfirmPayload(ctx context.Context) (out *eth.ExecutionPa
 	if err != nil {
 		return nil, errTyp, fmt.Errorf("failed to complete building on top of L2 chain %s, id: %s, error (%d): %w", eq.buildingOnto, eq.buildingID, errTyp, err)
 	}
+	return eq.confirmedPayload(payload)
+}
+
+func (eq *EngineQueue) ConfirmExternalPayload(ctx context.Context, payload *eth.ExecutionPayload) (out *eth.ExecutionPayload, errTyp BlockInsertionErrType, err error) {
+	if eq.buildingID == (eth.PayloadID{}) {
+		return nil, BlockInsertPrestateErr, fmt.Errorf("cannot complete payload building: not currently building a payload")
+	}
+	if payload.ParentHash != eq.buildingOnto.Hash {
+		return nil, BlockInsertPayloadErr, fmt.Errorf("external payload %s does not build on top of %s", payload.ID(), eq.buildingOnto)
+	}
+	fc := eth.ForkchoiceState{
+		HeadBlockHash:      common.Hash{}, // gets overridden
+		SafeBlockHash:      eq.safeHead.Hash,
+		FinalizedBlockHash: eq.finalized.Hash,
+	}
+	payload, errTyp, err = InsertPayload(ctx, eq.log, eq.engine, fc, payload, eq.buildingSafe)
+	if err != nil {
+		return nil, errTyp, fmt.Errorf("failed to insert external payload on top of L2 chain %s, error (%d): %w", eq.buildingOnto, errTyp, err)
+	}
+	// the block built by the engine itself is discarded, retrieving it wraps up the building job
+	if _, err := eq.engine.GetPayload(ctx, eq.buildingID); err != nil {
+		eq.log.Warn("failed to wrap up replaced block building job", "payload", eq.buildingID, "err", err)
+	}
+	return eq.confirmedPayload(payload)
+}
+
+// confirmedPayload updates the heads after the payload of the current block building job has been inserted.
+func (eq *EngineQueue) confirmedPayload(payload *eth.ExecutionPayload) (out *eth.ExecutionPayload, errTyp BlockInsertionErrType, err error) {
 	ref, err := PayloadToBlockRef(payload, &eq.cfg.Genesis)
 	if err != nil {
 		return nil, BlockInsertPayloadErr, NewResetError(fmt.Errorf("failed to decode L2 block ref from payload: %w", err))
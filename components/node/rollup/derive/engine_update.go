@@ -123,6 +123,14 @@ func ConfirmPayload(ctx context.Context, log log.Logger, eng Engine, fc eth.Fork
 		// even if it is an input-error (unknown payload ID), it is temporary, since we will re-attempt the full payload building, not just the retrieval of the payload.
 		return nil, BlockInsertTemporaryErr, fmt.Errorf("failed to get execution payload: %w", err)
 	}
+	return InsertPayload(ctx, log, eng, fc, payload, updateSafe)
+}
+
+// InsertPayload inserts the given execution payload into the provided Engine, and persists it as the canonical head.
+// This is used to confirm payloads that were not built by the Engine itself, e.g. by an external block builder.
+// If updateSafe is true, then the payload will also be recognized as safe-head at the same time.
+// The severity of the error is distinguished to determine whether the payload was valid and can become canonical.
+func InsertPayload(ctx context.Context, log log.Logger, eng Engine, fc eth.ForkchoiceState, payload *eth.ExecutionPayload, updateSafe bool) (out *eth.ExecutionPayload, errTyp BlockInsertionErrType, err error) {
 	if err := sanityCheckPayload(payload); err != nil {
 		return nil, BlockInsertPayloadErr, err
 	}
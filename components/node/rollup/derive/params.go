@@ -19,6 +19,10 @@ func frameSize(frame Frame) uint64 {
 
 const DerivationVersion0 = 0
 
+// DerivationVersionAltDA is the version of batcher tx data that holds a commitment to data on an external DA layer,
+// instead of the channel frames. The data on the DA layer is the DerivationVersion0 data with the channel frames.
+const DerivationVersionAltDA = 1
+
 // MaxChannelBankSize is the amount of memory space, in number of bytes,
 // till the bank is pruned by removing channels,
 // starting with the oldest channel.
@@ -75,15 +75,15 @@ type DerivationPipeline struct {
 }
 
 // NewDerivationPipeline creates a derivation pipeline, which should be reset before use.
-func NewDerivationPipeline(log log.Logger, cfg *rollup.Config, l1Fetcher L1Fetcher, engine Engine, metrics Metrics) *DerivationPipeline {
+func NewDerivationPipeline(log log.Logger, cfg *rollup.Config, l1Fetcher L1Fetcher, daFetcher AltDAFetcher, engine Engine, metrics Metrics) *DerivationPipeline {
 
 	// Pull stages
 	l1Traversal := NewL1Traversal(log, cfg, l1Fetcher)
-	dataSrc := NewDataSourceFactory(log, cfg, l1Fetcher) // auxiliary stage for L1Retrieval
+	dataSrc := NewDataSourceFactory(log, cfg, l1Fetcher, daFetcher) // auxiliary stage for L1Retrieval
 	l1Src := NewL1Retrieval(log, dataSrc, l1Traversal)
 	frameQueue := NewFrameQueue(log, l1Src)
 	bank := NewChannelBank(log, cfg, frameQueue, l1Fetcher)
-	chInReader := NewChannelInReader(log, bank, metrics)
+	chInReader := NewChannelInReader(log, cfg, bank, metrics)
 	batchQueue := NewBatchQueue(log, cfg, chInReader)
 	attrBuilder := NewFetchingAttributesBuilder(cfg, l1Fetcher, engine)
 	attributesQueue := NewAttributesQueue(log, cfg, attrBuilder, batchQueue)
@@ -155,6 +155,10 @@ func (dp *DerivationPipeline) ConfirmPayload(ctx context.Context) (out *eth.Exec
 	return dp.eng.ConfirmPayload(ctx)
 }
 
+func (dp *DerivationPipeline) ConfirmExternalPayload(ctx context.Context, payload *eth.ExecutionPayload) (out *eth.ExecutionPayload, errTyp BlockInsertionErrType, err error) {
+	return dp.eng.ConfirmExternalPayload(ctx, payload)
+}
+
 func (dp *DerivationPipeline) CancelPayload(ctx context.Context, force bool) error {
 	return dp.eng.CancelPayload(ctx, force)
 }
@@ -0,0 +1,74 @@
+package derive
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/kroma-network/kroma/components/node/eth"
+	"github.com/kroma-network/kroma/components/node/rollup"
+)
+
+// ReplayedBatch is a batch read from the batch inbox, along with the L1 block that completed its channel.
+type ReplayedBatch struct {
+	L1Block eth.L1BlockRef
+	Batch   *BatchData
+}
+
+// Replay runs the L1 stages of the derivation pipeline over the L1 blocks from start up to and including end,
+// and calls fn with every batch read from the batch inbox, in the order the pipeline reads them.
+// The batches are not checked against an L2 chain, so Replay does not need an execution engine.
+// The system config at start must be provided, it is kept up to date with the L1 receipts from there on.
+// The alt-DA fetcher is only required to replay the data of alt-DA commitments.
+// Channels that started before start are incomplete, and are dropped like the channel bank drops timed out channels.
+func Replay(ctx context.Context, log log.Logger, cfg *rollup.Config, l1Fetcher L1Fetcher, daFetcher AltDAFetcher, metrics Metrics,
+	start eth.L1BlockRef, sysCfg eth.SystemConfig, end uint64, fn func(b ReplayedBatch) error) error {
+	l1Traversal := NewL1Traversal(log, cfg, l1Fetcher)
+	dataSrc := NewDataSourceFactory(log, cfg, l1Fetcher, daFetcher)
+	l1Src := NewL1Retrieval(log, dataSrc, l1Traversal)
+	frameQueue := NewFrameQueue(log, l1Src)
+	bank := NewChannelBank(log, cfg, frameQueue, l1Fetcher)
+	chInReader := NewChannelInReader(log, cfg, bank, metrics)
+
+	for _, stage := range []ResetableStage{l1Traversal, l1Src, frameQueue, bank, chInReader} {
+		if err := stage.Reset(ctx, start, sysCfg); err != io.EOF {
+			return fmt.Errorf("failed to reset stage: %w", err)
+		}
+	}
+	// The L1 retrieval already opened the data of the start block during the reset,
+	// so it must not be handed out by the L1 traversal again.
+	if _, err := l1Traversal.NextL1Block(ctx); err != nil {
+		return err
+	}
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		batch, err := chInReader.NextBatch(ctx)
+		if err == io.EOF {
+			if l1Traversal.Origin().Number >= end {
+				return nil
+			}
+			if err := l1Traversal.AdvanceL1Block(ctx); err == io.EOF {
+				return fmt.Errorf("L1 block %d not found: %w", l1Traversal.Origin().Number+1, err)
+			} else if err != nil {
+				return err
+			}
+			continue
+		} else if errors.Is(err, NotEnoughData) {
+			continue
+		} else if errors.Is(err, ErrTemporary) {
+			log.Warn("Temporary error while replaying, retrying", "origin", chInReader.Origin(), "err", err)
+			continue
+		} else if err != nil {
+			return err
+		}
+		if err := fn(ReplayedBatch{L1Block: chInReader.Origin(), Batch: batch}); err != nil {
+			return err
+		}
+	}
+}
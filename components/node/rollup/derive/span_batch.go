@@ -0,0 +1,78 @@
+package derive
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/kroma-network/kroma/components/node/eth"
+	"github.com/kroma-network/kroma/components/node/rollup"
+)
+
+// SpanBatch is a batch of consecutive L2 blocks, which amortizes the per-block overhead of singular batches:
+// the parent hash is only included for the first block, and the L1 origin hashes are looked up in the L1 chain,
+// with the L1 origin hash of the last block to check that the span batch was built on the same L1 chain.
+type SpanBatch struct {
+	ParentHash   common.Hash // parent L2 block hash of the first block
+	L1OriginHash common.Hash // L1 origin hash of the last block
+	Blocks       []SpanBatchElement
+}
+
+// SpanBatchElement is a single L2 block in a span batch.
+type SpanBatchElement struct {
+	EpochNum     rollup.Epoch // aka l1 num
+	Timestamp    uint64
+	Transactions []hexutil.Bytes
+}
+
+// AppendBatch appends a singular batch to the span batch.
+// The batch must build on top of the previously appended batch.
+func (s *SpanBatch) AppendBatch(batch *BatchV1) {
+	if len(s.Blocks) == 0 {
+		s.ParentHash = batch.ParentHash
+	}
+	s.L1OriginHash = batch.EpochHash
+	s.Blocks = append(s.Blocks, SpanBatchElement{
+		EpochNum:     batch.EpochNum,
+		Timestamp:    batch.Timestamp,
+		Transactions: batch.Transactions,
+	})
+}
+
+// singularBatches expands the span batch into singular batches, looking up the epoch hashes in the given L1 blocks.
+// Only the first batch has its parent hash set: the parent hashes of the other batches are only known
+// once the preceding batch has been derived.
+func (s *SpanBatch) singularBatches(l1Blocks []eth.L1BlockRef, blockTime uint64) ([]*BatchData, error) {
+	if len(s.Blocks) == 0 {
+		return nil, errors.New("span batch has no blocks")
+	}
+	if len(l1Blocks) == 0 {
+		return nil, errors.New("missing L1 blocks to look up the span batch epochs")
+	}
+	first := l1Blocks[0].Number
+	batches := make([]*BatchData, 0, len(s.Blocks))
+	for i, block := range s.Blocks {
+		if i > 0 && block.Timestamp != s.Blocks[i-1].Timestamp+blockTime {
+			return nil, fmt.Errorf("span batch block %d has timestamp %d, expected %d", i, block.Timestamp, s.Blocks[i-1].Timestamp+blockTime)
+		}
+		num := uint64(block.EpochNum)
+		if num < first || num-first >= uint64(len(l1Blocks)) {
+			return nil, fmt.Errorf("span batch block %d has epoch %d outside of the L1 blocks %d to %d", i, num, first, first+uint64(len(l1Blocks))-1)
+		}
+		batches = append(batches, &BatchData{
+			BatchV1: BatchV1{
+				EpochNum:     block.EpochNum,
+				EpochHash:    l1Blocks[num-first].Hash,
+				Timestamp:    block.Timestamp,
+				Transactions: block.Transactions,
+			},
+		})
+	}
+	if last := batches[len(batches)-1]; last.EpochHash != s.L1OriginHash {
+		return nil, fmt.Errorf("span batch is for different L1 chain, L1 origin hash %s does not match %s", s.L1OriginHash, last.Epoch())
+	}
+	batches[0].ParentHash = s.ParentHash
+	return batches, nil
+}
@@ -0,0 +1,111 @@
+package derive
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+// spanBatchOverhead is the upper bound of the RLP encoded size of a span batch without its blocks:
+// the batch type, the string and list headers, the parent and L1 origin hashes, and the blocks list header.
+const spanBatchOverhead = 1 + 9 + 9 + 33 + 33 + 9
+
+// SpanChannelOut is a channel that collects the batches added to it into a single span batch.
+// The span batch is only written to the channel when the channel is closed.
+type SpanChannelOut struct {
+	co   *ChannelOut
+	span SpanBatch
+	// rlpLength is the estimated uncompressed size of the span batch. Must be less than MAX_RLP_BYTES_PER_CHANNEL
+	rlpLength int
+}
+
+// NewSpanChannelOut creates a span batch channel that compresses its data with the given algorithm and level.
+func NewSpanChannelOut(algo CompressionAlgo, level int) (*SpanChannelOut, error) {
+	co, err := NewChannelOutWithCompression(algo, level)
+	if err != nil {
+		return nil, err
+	}
+	return &SpanChannelOut{co: co}, nil
+}
+
+func (s *SpanChannelOut) ID() ChannelID {
+	return s.co.ID()
+}
+
+func (s *SpanChannelOut) Reset() error {
+	s.span = SpanBatch{}
+	s.rlpLength = 0
+	return s.co.Reset()
+}
+
+// AddBlock adds a block to the span batch of the channel. See AddBatch for the returned values.
+func (s *SpanChannelOut) AddBlock(block *types.Block) (uint64, error) {
+	batch, _, err := BlockToBatch(block)
+	if err != nil {
+		return 0, err
+	}
+	return s.AddBatch(batch)
+}
+
+// AddBatch adds a batch to the span batch of the channel. The batch must build on top of the previously added batch.
+// It returns the RLP encoded byte size of the added block. The only sentinel error that it returns is ErrTooManyRLPBytes.
+// If this error is returned, the channel should be closed and a new one should be made.
+func (s *SpanChannelOut) AddBatch(batch *BatchData) (uint64, error) {
+	if s.co.closed {
+		return 0, errors.New("already closed")
+	}
+
+	data, err := rlp.EncodeToBytes(&SpanBatchElement{
+		EpochNum:     batch.EpochNum,
+		Timestamp:    batch.Timestamp,
+		Transactions: batch.Transactions,
+	})
+	if err != nil {
+		return 0, err
+	}
+	if spanBatchOverhead+s.rlpLength+len(data) > MaxRLPBytesPerChannel {
+		return 0, fmt.Errorf("could not add %d bytes to span batch of %d bytes, max is %d. err: %w",
+			len(data), s.rlpLength, MaxRLPBytesPerChannel, ErrTooManyRLPBytes)
+	}
+	s.rlpLength += len(data)
+	s.span.AppendBatch(&batch.BatchV1)
+	return uint64(len(data)), nil
+}
+
+// InputBytes returns the estimated amount of RLP-encoded input bytes.
+func (s *SpanChannelOut) InputBytes() int {
+	return s.rlpLength
+}
+
+// ReadyBytes returns the number of bytes that the channel out can immediately output into a frame.
+// This is always 0 before the channel is closed.
+func (s *SpanChannelOut) ReadyBytes() int {
+	return s.co.ReadyBytes()
+}
+
+// Flush is a no-op: the span batch is only written to the channel when it is closed.
+func (s *SpanChannelOut) Flush() error {
+	return nil
+}
+
+// Close writes the span batch to the channel, and closes it.
+func (s *SpanChannelOut) Close() error {
+	if s.co.closed {
+		return errors.New("already closed")
+	}
+	if len(s.span.Blocks) > 0 {
+		if _, err := s.co.AddBatch(&BatchData{Span: &s.span}); err != nil {
+			return err
+		}
+	}
+	return s.co.Close()
+}
+
+// OutputFrame writes a frame to w with a given max size and returns the frame number.
+// See ChannelOut.OutputFrame for details.
+func (s *SpanChannelOut) OutputFrame(w *bytes.Buffer, maxSize uint64) (uint16, error) {
+	return s.co.OutputFrame(w, maxSize)
+}
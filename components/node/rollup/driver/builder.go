@@ -0,0 +1,56 @@
+package driver
+
+import (
+	"context"
+	"time"
+
+	"github.com/kroma-network/kroma/components/node/eth"
+)
+
+// PayloadBuilder builds the payloads of the proposer, as a delegate of the local engine.
+// The local engine always builds the block as well, and its payload is sealed instead
+// if the builder fails or does not return a valid payload in time.
+type PayloadBuilder interface {
+	BuildPayload(ctx context.Context, building *eth.PayloadAttributesEvent) (*eth.ExecutionPayload, error)
+}
+
+// builderJob is a block building job delegated to the PayloadBuilder, running in the background.
+type builderJob struct {
+	result chan *eth.ExecutionPayload
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+// startBuilderJob requests the builder to build a payload for the given block building job,
+// and gives up once the timeout expires.
+func (p *Proposer) startBuilderJob(building *eth.PayloadAttributesEvent) *builderJob {
+	ctx, cancel := context.WithTimeout(context.Background(), p.builderTimeout)
+	job := &builderJob{
+		result: make(chan *eth.ExecutionPayload, 1),
+		ctx:    ctx,
+		cancel: cancel,
+	}
+	go func() {
+		start := time.Now()
+		payload, err := p.builder.BuildPayload(ctx, building)
+		if err != nil {
+			p.log.Warn("builder failed to build block", "parent", building.Parent, "err", err)
+			payload = nil
+		} else {
+			p.log.Debug("builder built block", "block", payload.ID(), "duration", time.Since(start))
+		}
+		job.result <- payload
+	}()
+	return job
+}
+
+// wait waits for the payload of the builder, and returns nil if the builder failed or timed out.
+func (job *builderJob) wait() *eth.ExecutionPayload {
+	defer job.cancel()
+	select {
+	case payload := <-job.result:
+		return payload
+	case <-job.ctx.Done():
+		return nil
+	}
+}
@@ -1,5 +1,7 @@
 package driver
 
+import "time"
+
 type Config struct {
 	// SyncerConfDepth is the distance to keep from the L1 head when reading L1 data for L2 derivation.
 	SyncerConfDepth uint64 `json:"syncer_conf_depth"`
@@ -20,4 +22,8 @@ type Config struct {
 	// ProposerMaxSafeLag is the maximum number of L2 blocks for restricting the distance between L2 safe and unsafe.
 	// Disabled if 0.
 	ProposerMaxSafeLag uint64 `json:"proposer_max_safe_lag"`
+
+	// BuilderTimeout is the maximum time to wait for the payload of the external block builder,
+	// before the proposer falls back to the payload built by the local engine.
+	BuilderTimeout time.Duration `json:"builder_timeout"`
 }
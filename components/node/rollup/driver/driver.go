@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/event"
 	"github.com/ethereum/go-ethereum/log"
 
 	"github.com/kroma-network/kroma/components/node/eth"
@@ -75,6 +76,8 @@ type ProposerIface interface {
 	PlanNextProposerAction() time.Duration
 	RunNextProposerAction(ctx context.Context) (*eth.ExecutionPayload, error)
 	BuildingOnto() eth.L2BlockRef
+	SubscribePayloadAttributes(ch chan<- *eth.PayloadAttributesEvent) event.Subscription
+	SetExternalPayload(payload *eth.ExecutionPayload) error
 }
 
 type Network interface {
@@ -102,24 +105,32 @@ type AltSync interface {
 }
 
 // NewDriver composes an events handler that tracks L1 state, triggers L2 derivation, and optionally proposes new L2 blocks.
-func NewDriver(driverCfg *Config, cfg *rollup.Config, l2 L2Chain, l1 L1Chain, altSync AltSync, network Network, log log.Logger, snapshotLog log.Logger, metrics Metrics) *Driver {
+// The builder is optional: if not nil, block building of the proposer is delegated to the builder,
+// with a fallback to the local engine. The alt-DA fetcher is optional as well, see derive.NewDataSourceFactory.
+func NewDriver(driverCfg *Config, cfg *rollup.Config, l2 L2Chain, l1 L1Chain, daFetcher derive.AltDAFetcher, builder PayloadBuilder, altSync AltSync, network Network, log log.Logger, snapshotLog log.Logger, metrics Metrics) *Driver {
 	l1State := NewL1State(log, metrics)
 	proposerConfDepth := NewConfDepth(driverCfg.ProposerConfDepth, l1State.L1Head, l1)
 	findL1Origin := NewL1OriginSelector(log, cfg, proposerConfDepth)
 	syncConfDepth := NewConfDepth(driverCfg.SyncerConfDepth, l1State.L1Head, l1)
-	derivationPipeline := derive.NewDerivationPipeline(log, cfg, syncConfDepth, l2, metrics)
+	derivationPipeline := derive.NewDerivationPipeline(log, cfg, syncConfDepth, daFetcher, l2, metrics)
 	attrBuilder := derive.NewFetchingAttributesBuilder(cfg, l1, l2)
 	engine := derivationPipeline
 	meteredEngine := NewMeteredEngine(cfg, engine, metrics, log)
 	proposer := NewProposer(log, cfg, meteredEngine, attrBuilder, findL1Origin, metrics)
+	if builder != nil {
+		proposer.builder = builder
+		proposer.builderTimeout = driverCfg.BuilderTimeout
+	}
 
 	return &Driver{
 		l1State:          l1State,
+		l1Reorgs:         NewL1ReorgTracker(log, metrics, l1),
 		derivation:       derivationPipeline,
 		stateReq:         make(chan chan struct{}),
 		forceReset:       make(chan chan struct{}, 10),
 		startProposer:    make(chan hashAndErrorChannel, 10),
 		stopProposer:     make(chan chan hashAndError, 10),
+		externalPayloads: make(chan payloadAndErrorChannel, 10),
 		config:           cfg,
 		driverConfig:     driverCfg,
 		done:             make(chan struct{}),
@@ -0,0 +1,149 @@
+package driver
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/kroma-network/kroma/components/node/eth"
+)
+
+// l1ReorgTrackerWindow is the number of recent L1 heads to track, to find the divergence point of a reorg.
+// Reorgs deeper than this are still detected, but their exact depth is unknown.
+const l1ReorgTrackerWindow = 64
+
+type L1ReorgMetrics interface {
+	RecordL1ReorgDepth(d uint64)
+}
+
+type L1BlockRefByHashFetcher interface {
+	L1BlockRefByHash(ctx context.Context, hash common.Hash) (eth.L1BlockRef, error)
+}
+
+// L1Reorg describes a reorg of the L1 chain, as observed by the L1ReorgTracker.
+type L1Reorg struct {
+	OldHead eth.L1BlockRef
+	NewHead eth.L1BlockRef
+	// CommonAncestor is the last block shared by the old and the new chain.
+	// It is zeroed if the divergence point is beyond the tracked window.
+	CommonAncestor eth.L1BlockRef
+	// Depth is the number of blocks of the old chain that were reorged out.
+	Depth uint64
+}
+
+// L1ReorgTracker keeps track of the recent canonical L1 chain, to detect reorgs of the L1 chain
+// and find the point where the new chain diverged from the old one. It is not safe for concurrent use.
+type L1ReorgTracker struct {
+	log     log.Logger
+	metrics L1ReorgMetrics
+	l1      L1BlockRefByHashFetcher
+
+	// recent canonical L1 blocks by number, contiguous up to and including the head
+	canonical map[uint64]eth.L1BlockRef
+	head      eth.L1BlockRef
+}
+
+func NewL1ReorgTracker(log log.Logger, metrics L1ReorgMetrics, l1 L1BlockRefByHashFetcher) *L1ReorgTracker {
+	return &L1ReorgTracker{
+		log:       log,
+		metrics:   metrics,
+		l1:        l1,
+		canonical: make(map[uint64]eth.L1BlockRef),
+	}
+}
+
+// OnNewHead updates the tracked canonical chain with the new L1 head.
+// It returns the reorg that the new head caused, or nil if the new head extends the tracked chain.
+func (t *L1ReorgTracker) OnNewHead(ctx context.Context, head eth.L1BlockRef) (*L1Reorg, error) {
+	if t.head == (eth.L1BlockRef{}) || head.Number > t.head.Number+l1ReorgTrackerWindow {
+		// nothing to compare against, start tracking from the new head
+		t.reset(head)
+		return nil, nil
+	}
+	if head.Hash == t.head.Hash {
+		return nil, nil
+	}
+	if head.ParentHash == t.head.Hash {
+		t.extend([]eth.L1BlockRef{head})
+		return nil, nil
+	}
+
+	// Walk back the new chain until it meets the tracked canonical chain.
+	newBlocks := []eth.L1BlockRef{head}
+	cur := head
+	for {
+		if known, ok := t.canonical[cur.Number]; ok && known.Hash == cur.Hash {
+			break
+		}
+		if _, ok := t.canonical[cur.Number]; !ok && cur.Number < t.head.Number || cur.Number == 0 {
+			// the new chain diverged before the oldest tracked block
+			reorg := &L1Reorg{
+				OldHead: t.head,
+				NewHead: head,
+				Depth:   t.head.Number - cur.Number,
+			}
+			t.record(reorg)
+			t.reset(head)
+			return reorg, nil
+		}
+		parent, err := t.l1.L1BlockRefByHash(ctx, cur.ParentHash)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch parent of L1 block %s: %w", cur, err)
+		}
+		newBlocks = append(newBlocks, parent)
+		cur = parent
+	}
+	ancestor := newBlocks[len(newBlocks)-1]
+	newBlocks = newBlocks[:len(newBlocks)-1]
+
+	// reverse to extend the canonical chain from the ancestor onwards
+	for i, j := 0, len(newBlocks)-1; i < j; i, j = i+1, j-1 {
+		newBlocks[i], newBlocks[j] = newBlocks[j], newBlocks[i]
+	}
+	if ancestor.Hash == t.head.Hash {
+		// we missed some head updates, but the chain was only extended
+		t.extend(newBlocks)
+		return nil, nil
+	}
+
+	reorg := &L1Reorg{
+		OldHead:        t.head,
+		NewHead:        head,
+		CommonAncestor: ancestor,
+		Depth:          t.head.Number - ancestor.Number,
+	}
+	t.record(reorg)
+	for num := ancestor.Number + 1; num <= t.head.Number; num++ {
+		delete(t.canonical, num)
+	}
+	t.head = ancestor
+	t.extend(newBlocks)
+	return reorg, nil
+}
+
+func (t *L1ReorgTracker) record(reorg *L1Reorg) {
+	t.metrics.RecordL1ReorgDepth(reorg.Depth)
+	t.log.Warn("L1 reorg detected",
+		"old_l1_head", reorg.OldHead, "new_l1_head", reorg.NewHead,
+		"common_ancestor", reorg.CommonAncestor, "depth", reorg.Depth)
+}
+
+func (t *L1ReorgTracker) reset(head eth.L1BlockRef) {
+	t.canonical = map[uint64]eth.L1BlockRef{head.Number: head}
+	t.head = head
+}
+
+func (t *L1ReorgTracker) extend(blocks []eth.L1BlockRef) {
+	for _, block := range blocks {
+		t.canonical[block.Number] = block
+		t.head = block
+	}
+	// prune blocks that fell out of the tracked window
+	for num := range t.canonical {
+		if num+l1ReorgTrackerWindow <= t.head.Number {
+			delete(t.canonical, num)
+		}
+	}
+}
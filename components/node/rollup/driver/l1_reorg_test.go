@@ -0,0 +1,80 @@
+package driver
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kroma-network/kroma/components/node/eth"
+	"github.com/kroma-network/kroma/components/node/testlog"
+	"github.com/kroma-network/kroma/components/node/testutils"
+)
+
+func TestL1ReorgTracker(t *testing.T) {
+	logger := testlog.Logger(t, log.LvlError)
+	rng := rand.New(rand.NewSource(1234))
+
+	genesis := testutils.RandomBlockRef(rng)
+	genesis.Number = 100
+	a1 := testutils.NextRandomRef(rng, genesis)
+	a2 := testutils.NextRandomRef(rng, a1)
+	a3 := testutils.NextRandomRef(rng, a2)
+	b2 := testutils.NextRandomRef(rng, a1)
+	b3 := testutils.NextRandomRef(rng, b2)
+	b4 := testutils.NextRandomRef(rng, b3)
+
+	var depths []uint64
+	m := &testutils.TestDerivationMetrics{FnRecordL1ReorgDepth: func(d uint64) { depths = append(depths, d) }}
+
+	t.Run("linear extension", func(t *testing.T) {
+		l1 := &testutils.MockL1Source{}
+		tracker := NewL1ReorgTracker(logger, m, l1)
+		for _, head := range []eth.L1BlockRef{genesis, a1, a2, a2, a3} {
+			reorg, err := tracker.OnNewHead(context.Background(), head)
+			require.NoError(t, err)
+			require.Nil(t, reorg)
+		}
+		l1.AssertExpectations(t)
+	})
+
+	t.Run("missed heads", func(t *testing.T) {
+		l1 := &testutils.MockL1Source{}
+		tracker := NewL1ReorgTracker(logger, m, l1)
+		_, err := tracker.OnNewHead(context.Background(), a1)
+		require.NoError(t, err)
+
+		l1.ExpectL1BlockRefByHash(a3.ParentHash, a2, nil)
+		l1.ExpectL1BlockRefByHash(a2.ParentHash, a1, nil)
+		reorg, err := tracker.OnNewHead(context.Background(), a3)
+		require.NoError(t, err)
+		require.Nil(t, reorg)
+		l1.AssertExpectations(t)
+	})
+
+	t.Run("reorg", func(t *testing.T) {
+		depths = nil
+		l1 := &testutils.MockL1Source{}
+		tracker := NewL1ReorgTracker(logger, m, l1)
+		for _, head := range []eth.L1BlockRef{genesis, a1, a2, a3} {
+			_, err := tracker.OnNewHead(context.Background(), head)
+			require.NoError(t, err)
+		}
+
+		l1.ExpectL1BlockRefByHash(b4.ParentHash, b3, nil)
+		l1.ExpectL1BlockRefByHash(b3.ParentHash, b2, nil)
+		l1.ExpectL1BlockRefByHash(b2.ParentHash, a1, nil)
+		reorg, err := tracker.OnNewHead(context.Background(), b4)
+		require.NoError(t, err)
+		require.Equal(t, &L1Reorg{OldHead: a3, NewHead: b4, CommonAncestor: a1, Depth: 2}, reorg)
+		require.Equal(t, []uint64{2}, depths)
+		l1.AssertExpectations(t)
+
+		// the new chain is tracked from now on
+		reorg, err = tracker.OnNewHead(context.Background(), testutils.NextRandomRef(rng, b4))
+		require.NoError(t, err)
+		require.Nil(t, reorg)
+	})
+}
@@ -7,7 +7,6 @@ import (
 )
 
 type L1Metrics interface {
-	RecordL1ReorgDepth(d uint64)
 	RecordL1Ref(name string, ref eth.L1BlockRef)
 }
 
@@ -40,12 +39,10 @@ func (s *L1State) HandleNewL1HeadBlock(head eth.L1BlockRef) {
 		// dealing with a linear extension (new block is the immediate child of the old one).
 		s.log.Debug("L1 head moved forward", "l1_head", head)
 	} else {
-		if s.l1Head.Number >= head.Number {
-			s.metrics.RecordL1ReorgDepth(s.l1Head.Number - head.Number)
-		}
 		// New L1 block is not the same as the current head or a single step linear extension.
 		// This could either be a long L1 extension, or a reorg, or we simply missed a head update.
-		s.log.Warn("L1 head signal indicates a possible L1 re-org", "old_l1_head", s.l1Head, "new_l1_head_parent", head.ParentHash, "new_l1_head", head)
+		// Actual reorgs are detected and reported by the L1ReorgTracker.
+		s.log.Debug("L1 head signal is not a linear extension", "old_l1_head", s.l1Head, "new_l1_head_parent", head.ParentHash, "new_l1_head", head)
 	}
 	s.metrics.RecordL1Ref("l1_head", head)
 	s.l1Head = head
@@ -67,6 +67,16 @@ func (m *MeteredEngine) ConfirmPayload(ctx context.Context) (out *eth.ExecutionP
 	sealingStart := time.Now()
 	// Actually execute the block and add it to the head of the chain.
 	payload, errType, err := m.inner.ConfirmPayload(ctx)
+	return m.recordConfirmed(sealingStart, payload, errType, err)
+}
+
+func (m *MeteredEngine) ConfirmExternalPayload(ctx context.Context, payload *eth.ExecutionPayload) (out *eth.ExecutionPayload, errTyp derive.BlockInsertionErrType, err error) {
+	sealingStart := time.Now()
+	payload, errType, err := m.inner.ConfirmExternalPayload(ctx, payload)
+	return m.recordConfirmed(sealingStart, payload, errType, err)
+}
+
+func (m *MeteredEngine) recordConfirmed(sealingStart time.Time, payload *eth.ExecutionPayload, errType derive.BlockInsertionErrType, err error) (*eth.ExecutionPayload, derive.BlockInsertionErrType, error) {
 	if err != nil {
 		m.metrics.RecordSequencingError()
 		return payload, errType, err
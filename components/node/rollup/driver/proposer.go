@@ -1,6 +1,7 @@
 package driver
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
@@ -8,6 +9,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
 	"github.com/ethereum/go-ethereum/log"
 
 	"github.com/kroma-network/kroma/components/node/eth"
@@ -45,6 +47,17 @@ type Proposer struct {
 	timeNow func() time.Time
 
 	nextAction time.Time
+
+	// building is the current block building job, as published to the payload attributes subscribers.
+	building *eth.PayloadAttributesEvent
+	// externalPayload is an externally built payload that replaces the payload of the current block building job.
+	externalPayload *eth.ExecutionPayload
+	attrsFeed       event.Feed
+
+	// builder optionally builds the payloads instead of the local engine, nil if block building is not delegated.
+	builder        PayloadBuilder
+	builderTimeout time.Duration
+	builderJob     *builderJob
 }
 
 func NewProposer(log log.Logger, cfg *rollup.Config, engine derive.ResettableEngineControl, attributesBuilder derive.AttributesBuilder, l1OriginSelector L1OriginSelectorIface, metrics ProposerMetrics) *Proposer {
@@ -100,6 +113,16 @@ func (p *Proposer) StartBuildingBlock(ctx context.Context) error {
 	if err != nil {
 		return fmt.Errorf("failed to start building on top of L2 chain %s, error (%d): %w", l2Head, errTyp, err)
 	}
+	p.building = &eth.PayloadAttributesEvent{Parent: l2Head, Attributes: attrs}
+	p.externalPayload = nil
+	p.attrsFeed.Send(p.building)
+	if p.builderJob != nil {
+		p.builderJob.cancel()
+		p.builderJob = nil
+	}
+	if p.builder != nil {
+		p.builderJob = p.startBuilderJob(p.building)
+	}
 	return nil
 }
 
@@ -107,6 +130,30 @@ func (p *Proposer) StartBuildingBlock(ctx context.Context) error {
 // Warning: the safe and finalized L2 blocks as viewed during the initiation of the block building are reused for completion of the block building.
 // The Execution engine should not change the safe and finalized blocks between start and completion of block building.
 func (p *Proposer) CompleteBuildingBlock(ctx context.Context) (*eth.ExecutionPayload, error) {
+	if job := p.builderJob; job != nil {
+		p.builderJob = nil
+		// a payload submitted through the API takes precedence over the payload of the builder
+		if payload := job.wait(); payload != nil && p.externalPayload == nil {
+			if err := p.SetExternalPayload(payload); err != nil {
+				p.log.Warn("builder built invalid block, falling back to locally built block", "err", err)
+			}
+		} else if payload == nil {
+			p.log.Warn("builder did not build block, falling back to locally built block", "timeout", p.builderTimeout)
+		}
+	}
+	if external := p.externalPayload; external != nil {
+		p.externalPayload = nil
+		payload, errTyp, err := p.engine.ConfirmExternalPayload(ctx, external)
+		if err == nil {
+			p.log.Info("proposer inserted externally built block", "block", payload.ID())
+			return payload, nil
+		}
+		if errors.Is(err, derive.ErrCritical) {
+			return nil, err
+		}
+		p.log.Warn("failed to insert externally built block, falling back to locally built block",
+			"block", external.ID(), "err_type", errTyp, "err", err)
+	}
 	payload, errTyp, err := p.engine.ConfirmPayload(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to complete building block: error (%d): %w", errTyp, err)
@@ -117,10 +164,73 @@ func (p *Proposer) CompleteBuildingBlock(ctx context.Context) (*eth.ExecutionPay
 // CancelBuildingBlock cancels the current open block building job.
 // This proposer only maintains one block building job at a time.
 func (p *Proposer) CancelBuildingBlock(ctx context.Context) {
+	if p.builderJob != nil {
+		p.builderJob.cancel()
+		p.builderJob = nil
+	}
 	// force-cancel, we can always continue block building, and any error is logged by the engine state
 	_ = p.engine.CancelPayload(ctx, true)
 }
 
+// SubscribePayloadAttributes subscribes the given channel to the attributes of every block the proposer starts building.
+func (p *Proposer) SubscribePayloadAttributes(ch chan<- *eth.PayloadAttributesEvent) event.Subscription {
+	return p.attrsFeed.Subscribe(ch)
+}
+
+// SetExternalPayload sets an externally built payload to be sealed instead of the locally built payload,
+// if it is consistent with the attributes of the current block building job.
+// If the external payload cannot be inserted when sealing, the locally built payload is sealed instead.
+func (p *Proposer) SetExternalPayload(payload *eth.ExecutionPayload) error {
+	onto, buildingID, _ := p.engine.BuildingPayload()
+	if buildingID == (eth.PayloadID{}) || p.building == nil || p.building.Parent.Hash != onto.Hash {
+		return errors.New("proposer is not building a block")
+	}
+	if err := checkExternalPayload(p.building, payload); err != nil {
+		return fmt.Errorf("invalid external payload %s: %w", payload.ID(), err)
+	}
+	p.externalPayload = payload
+	p.log.Info("accepted externally built block", "block", payload.ID(), "txs", len(payload.Transactions))
+	return nil
+}
+
+// checkExternalPayload checks that the externally built payload is consistent with the given block building job.
+func checkExternalPayload(building *eth.PayloadAttributesEvent, payload *eth.ExecutionPayload) error {
+	attrs := building.Attributes
+	if payload.ParentHash != building.Parent.Hash {
+		return fmt.Errorf("parent hash %s does not match %s", payload.ParentHash, building.Parent.Hash)
+	}
+	if uint64(payload.BlockNumber) != building.Parent.Number+1 {
+		return fmt.Errorf("block number %d does not follow parent %d", payload.BlockNumber, building.Parent.Number)
+	}
+	if payload.Timestamp != attrs.Timestamp {
+		return fmt.Errorf("timestamp %d does not match %d", payload.Timestamp, attrs.Timestamp)
+	}
+	if payload.PrevRandao != attrs.PrevRandao {
+		return fmt.Errorf("prev randao %s does not match %s", payload.PrevRandao, attrs.PrevRandao)
+	}
+	if payload.FeeRecipient != attrs.SuggestedFeeRecipient {
+		return fmt.Errorf("fee recipient %s does not match %s", payload.FeeRecipient, attrs.SuggestedFeeRecipient)
+	}
+	if attrs.GasLimit != nil && payload.GasLimit != *attrs.GasLimit {
+		return fmt.Errorf("gas limit %d does not match %d", payload.GasLimit, *attrs.GasLimit)
+	}
+	if len(payload.Transactions) < len(attrs.Transactions) {
+		return fmt.Errorf("payload has %d transactions, but attributes force %d", len(payload.Transactions), len(attrs.Transactions))
+	}
+	if attrs.NoTxPool && len(payload.Transactions) != len(attrs.Transactions) {
+		return errors.New("payload includes transactions, but the tx pool is disabled")
+	}
+	for i, tx := range attrs.Transactions {
+		if !bytes.Equal(payload.Transactions[i], tx) {
+			return fmt.Errorf("transaction %d does not match forced transaction", i)
+		}
+	}
+	if actual, ok := payload.CheckBlockHash(); !ok {
+		return fmt.Errorf("block hash %s does not match computed hash %s", payload.BlockHash, actual)
+	}
+	return nil
+}
+
 // PlanNextProposerAction returns a desired delay till the RunNextProposerAction call.
 func (p *Proposer) PlanNextProposerAction() time.Duration {
 	// If the engine is busy building safe blocks (and thus changing the head that we would sync on top of),
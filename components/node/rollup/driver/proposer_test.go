@@ -95,6 +95,25 @@ func (m *FakeEngineControl) ConfirmPayload(ctx context.Context) (out *eth.Execut
 	return payload, derive.BlockInsertOK, nil
 }
 
+func (m *FakeEngineControl) ConfirmExternalPayload(ctx context.Context, payload *eth.ExecutionPayload) (out *eth.ExecutionPayload, errTyp derive.BlockInsertionErrType, err error) {
+	if m.err != nil {
+		return nil, m.errTyp, m.err
+	}
+	if payload.ParentHash != m.buildingOnto.Hash {
+		return nil, derive.BlockInsertPayloadErr, fmt.Errorf("external payload does not build on top of %s", m.buildingOnto)
+	}
+	ref, err := derive.PayloadToBlockRef(payload, &m.cfg.Genesis)
+	if err != nil {
+		return nil, derive.BlockInsertPayloadErr, err
+	}
+	m.unsafe = ref
+	if m.buildingSafe {
+		m.safe = ref
+	}
+	m.resetBuildingState()
+	return payload, derive.BlockInsertOK, nil
+}
+
 func (m *FakeEngineControl) CancelPayload(ctx context.Context, force bool) error {
 	if force {
 		m.resetBuildingState()
@@ -382,3 +401,165 @@ func TestProposerChaosMonkey(t *testing.T) {
 	require.Greater(t, engControl.avgBuildingTime(), time.Second, "With 2 second block time and 1 second error backoff and healthy-on-average errors, building time should at least be a second")
 	require.Greater(t, engControl.avgTxsPerBlock(), 3.0, "We expect at least 1 system tx per block, but with a mocked 0-10 txs we expect an higher avg")
 }
+
+// externalPayloadTestSetup creates a proposer building on top of a genesis block,
+// and a valid externally built payload for the first block.
+func externalPayloadTestSetup(t *testing.T) (*Proposer, *FakeEngineControl, *eth.ExecutionPayload) {
+	cfg := &rollup.Config{
+		Genesis: rollup.Genesis{
+			L1:     eth.BlockID{Hash: common.Hash{0xa}, Number: 100},
+			L2:     eth.BlockID{Hash: common.Hash{0xb}, Number: 200},
+			L2Time: 1000,
+		},
+		BlockTime:        2,
+		MaxProposerDrift: 30,
+	}
+	l1Origin := eth.L1BlockRef{Hash: cfg.Genesis.L1.Hash, Number: cfg.Genesis.L1.Number, Time: 990}
+	genesisL2 := eth.L2BlockRef{
+		Hash:     cfg.Genesis.L2.Hash,
+		Number:   cfg.Genesis.L2.Number,
+		Time:     cfg.Genesis.L2Time,
+		L1Origin: cfg.Genesis.L1,
+	}
+	engControl := &FakeEngineControl{
+		finalized: genesisL2,
+		safe:      genesisL2,
+		unsafe:    genesisL2,
+		cfg:       cfg,
+		timeNow:   time.Now,
+	}
+	engControl.makePayload = func(onto eth.L2BlockRef, attrs *eth.PayloadAttributes) *eth.ExecutionPayload {
+		payload := &eth.ExecutionPayload{
+			ParentHash:   onto.Hash,
+			FeeRecipient: attrs.SuggestedFeeRecipient,
+			PrevRandao:   attrs.PrevRandao,
+			BlockNumber:  eth.Uint64Quantity(onto.Number + 1),
+			GasLimit:     *attrs.GasLimit,
+			Timestamp:    attrs.Timestamp,
+			Transactions: attrs.Transactions,
+		}
+		payload.BlockHash, _ = payload.CheckBlockHash()
+		return payload
+	}
+
+	infoDep, err := derive.L1InfoDepositBytes(1, &testutils.MockBlockInfo{
+		InfoHash:    l1Origin.Hash,
+		InfoNum:     l1Origin.Number,
+		InfoTime:    l1Origin.Time,
+		InfoBaseFee: big.NewInt(1234),
+	}, cfg.Genesis.SystemConfig)
+	require.NoError(t, err)
+	gasLimit := eth.Uint64Quantity(10_000_000)
+	attrBuilder := testAttrBuilderFn(func(ctx context.Context, l2Parent eth.L2BlockRef, epoch eth.BlockID) (*eth.PayloadAttributes, error) {
+		return &eth.PayloadAttributes{
+			Timestamp:             eth.Uint64Quantity(l2Parent.Time + cfg.BlockTime),
+			PrevRandao:            eth.Bytes32{0x1},
+			SuggestedFeeRecipient: common.Address{0x2},
+			Transactions:          []eth.Data{infoDep},
+			GasLimit:              &gasLimit,
+		}, nil
+	})
+	originSelector := testOriginSelectorFn(func(ctx context.Context, l2Head eth.L2BlockRef) (eth.L1BlockRef, error) {
+		return l1Origin, nil
+	})
+	proposer := NewProposer(testlog.Logger(t, log.LvlCrit), cfg, engControl, attrBuilder, originSelector, metrics.NoopMetrics)
+
+	external := &eth.ExecutionPayload{
+		ParentHash:   genesisL2.Hash,
+		FeeRecipient: common.Address{0x2},
+		PrevRandao:   eth.Bytes32{0x1},
+		BlockNumber:  eth.Uint64Quantity(genesisL2.Number + 1),
+		GasLimit:     gasLimit,
+		Timestamp:    eth.Uint64Quantity(genesisL2.Time + cfg.BlockTime),
+		Transactions: []eth.Data{infoDep, []byte("external tx")},
+	}
+	external.BlockHash, _ = external.CheckBlockHash()
+	return proposer, engControl, external
+}
+
+func TestProposerExternalPayload(t *testing.T) {
+	proposer, engControl, external := externalPayloadTestSetup(t)
+	genesisL2 := engControl.UnsafeL2Head()
+
+	require.ErrorContains(t, proposer.SetExternalPayload(external), "not building")
+
+	attrsCh := make(chan *eth.PayloadAttributesEvent, 1)
+	sub := proposer.SubscribePayloadAttributes(attrsCh)
+	defer sub.Unsubscribe()
+	require.NoError(t, proposer.StartBuildingBlock(context.Background()))
+	ev := <-attrsCh
+	require.Equal(t, genesisL2, ev.Parent)
+	require.Equal(t, external.Timestamp, ev.Attributes.Timestamp)
+
+	invalid := *external
+	invalid.Transactions = []eth.Data{[]byte("external tx")}
+	invalid.BlockHash, _ = invalid.CheckBlockHash()
+	require.ErrorContains(t, proposer.SetExternalPayload(&invalid), "forced transaction")
+
+	invalid = *external
+	invalid.BlockHash = common.Hash{0xc}
+	require.ErrorContains(t, proposer.SetExternalPayload(&invalid), "block hash")
+
+	require.NoError(t, proposer.SetExternalPayload(external))
+	payload, err := proposer.CompleteBuildingBlock(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, external, payload)
+	require.Equal(t, external.BlockHash, engControl.UnsafeL2Head().Hash)
+	require.ErrorContains(t, proposer.SetExternalPayload(external), "not building")
+}
+
+type testBuilderFn func(ctx context.Context, building *eth.PayloadAttributesEvent) (*eth.ExecutionPayload, error)
+
+func (fn testBuilderFn) BuildPayload(ctx context.Context, building *eth.PayloadAttributesEvent) (*eth.ExecutionPayload, error) {
+	return fn(ctx, building)
+}
+
+func TestProposerBuilder(t *testing.T) {
+	t.Run("builder payload", func(t *testing.T) {
+		proposer, _, external := externalPayloadTestSetup(t)
+		proposer.builderTimeout = time.Second
+		proposer.builder = testBuilderFn(func(ctx context.Context, building *eth.PayloadAttributesEvent) (*eth.ExecutionPayload, error) {
+			return external, nil
+		})
+		require.NoError(t, proposer.StartBuildingBlock(context.Background()))
+		payload, err := proposer.CompleteBuildingBlock(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, external, payload)
+	})
+	t.Run("builder error", func(t *testing.T) {
+		proposer, _, external := externalPayloadTestSetup(t)
+		proposer.builderTimeout = time.Second
+		proposer.builder = testBuilderFn(func(ctx context.Context, building *eth.PayloadAttributesEvent) (*eth.ExecutionPayload, error) {
+			return nil, errors.New("builder down")
+		})
+		require.NoError(t, proposer.StartBuildingBlock(context.Background()))
+		payload, err := proposer.CompleteBuildingBlock(context.Background())
+		require.NoError(t, err)
+		require.NotEqual(t, external.BlockHash, payload.BlockHash, "local payload is sealed")
+	})
+	t.Run("builder timeout", func(t *testing.T) {
+		proposer, _, external := externalPayloadTestSetup(t)
+		proposer.builderTimeout = 10 * time.Millisecond
+		proposer.builder = testBuilderFn(func(ctx context.Context, building *eth.PayloadAttributesEvent) (*eth.ExecutionPayload, error) {
+			<-ctx.Done()
+			return external, nil
+		})
+		require.NoError(t, proposer.StartBuildingBlock(context.Background()))
+		payload, err := proposer.CompleteBuildingBlock(context.Background())
+		require.NoError(t, err)
+		require.NotEqual(t, external.BlockHash, payload.BlockHash, "local payload is sealed")
+	})
+	t.Run("invalid builder payload", func(t *testing.T) {
+		proposer, _, external := externalPayloadTestSetup(t)
+		proposer.builderTimeout = time.Second
+		invalid := *external
+		invalid.Timestamp++
+		proposer.builder = testBuilderFn(func(ctx context.Context, building *eth.PayloadAttributesEvent) (*eth.ExecutionPayload, error) {
+			return &invalid, nil
+		})
+		require.NoError(t, proposer.StartBuildingBlock(context.Background()))
+		payload, err := proposer.CompleteBuildingBlock(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, external.Timestamp, payload.Timestamp, "local payload is sealed")
+	})
+}
@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/event"
 	"github.com/ethereum/go-ethereum/log"
 
 	"github.com/kroma-network/kroma/components/node/eth"
@@ -28,6 +29,9 @@ const sealingDuration = time.Millisecond * 50
 type Driver struct {
 	l1State L1StateIface
 
+	// Tracks the recent canonical L1 chain, to detect L1 reorgs as soon as a new L1 head is signalled.
+	l1Reorgs *L1ReorgTracker
+
 	// The derivation pipeline is reset whenever we reorg.
 	// The derivation pipeline determines the new l2Safe.
 	derivation DerivationPipeline
@@ -47,6 +51,10 @@ type Driver struct {
 	// It tells the caller that the proposer stopped by returning the latest proposed L2 block hash.
 	stopProposer chan chan hashAndError
 
+	// Upon receiving an externally built payload in this channel, the proposer seals it instead of its own payload.
+	// It tells the caller whether the payload was accepted by returning an error or nil.
+	externalPayloads chan payloadAndErrorChannel
+
 	// Rollup config: rollup chain configuration
 	config *rollup.Config
 
@@ -69,6 +77,9 @@ type Driver struct {
 	// L2 Signals:
 	unsafeL2Payloads chan *eth.ExecutionPayload
 
+	// statusFeed notifies subscribers of every change of the sync status, synchronously with the event loop.
+	statusFeed event.Feed
+
 	l1       L1Chain
 	l2       L2Chain
 	proposer ProposerIface
@@ -205,7 +216,13 @@ func (d *Driver) eventLoop() {
 	defer altSyncTicker.Stop()
 	lastUnsafeL2 := d.derivation.UnsafeL2Head()
 
+	// lastStatus is the latest sync status published to the subscribers of the status feed.
+	var lastStatus eth.SyncStatus
+
 	for {
+		// Publish the sync status if it was changed by the previously processed event.
+		d.publishSyncStatus(&lastStatus)
+
 		// If we are proposing, and the L1 state is ready, update the trigger for the next proposer action.
 		// This may adjust at any time based on fork-choice changes or previous errors.
 		// And avoid sequencing if the derivation pipeline indicates the engine is not ready.
@@ -275,6 +292,7 @@ func (d *Driver) eventLoop() {
 
 		case newL1Head := <-d.l1HeadSig:
 			d.l1State.HandleNewL1HeadBlock(newL1Head)
+			d.handleL1Reorg(ctx, newL1Head)
 			reqStep() // a new L1 head may mean we have the data to not get an EOF again.
 		case newL1Safe := <-d.l1SafeSig:
 			d.l1State.HandleNewL1SafeBlock(newL1Safe)
@@ -348,12 +366,42 @@ func (d *Driver) eventLoop() {
 				d.driverConfig.ProposerStopped = true
 				respCh <- hashAndError{hash: d.derivation.UnsafeL2Head().Hash}
 			}
+		case resp := <-d.externalPayloads:
+			if d.driverConfig.ProposerStopped {
+				resp.err <- errors.New("proposer not running")
+			} else {
+				resp.err <- d.proposer.SetExternalPayload(resp.payload)
+			}
 		case <-d.done:
 			return
 		}
 	}
 }
 
+// handleL1Reorg checks if the new L1 head reorged out L1 blocks that the derivation pipeline already consumed,
+// and resets the pipeline to re-derive from the divergence point if so.
+func (d *Driver) handleL1Reorg(ctx context.Context, newL1Head eth.L1BlockRef) {
+	ctx, cancel := context.WithTimeout(ctx, time.Second*10)
+	defer cancel()
+	reorg, err := d.l1Reorgs.OnNewHead(ctx, newL1Head)
+	if err != nil {
+		d.log.Warn("failed to check for L1 reorg", "l1_head", newL1Head, "err", err)
+		return
+	}
+	if reorg == nil {
+		return
+	}
+	origin := d.derivation.Origin()
+	if reorg.CommonAncestor != (eth.L1BlockRef{}) && origin.Number <= reorg.CommonAncestor.Number {
+		// the pipeline did not consume any of the reorged out blocks yet
+		return
+	}
+	d.log.Warn("Derivation pipeline is reset due to L1 reorg", "origin", origin,
+		"common_ancestor", reorg.CommonAncestor, "depth", reorg.Depth)
+	d.derivation.Reset()
+	d.metrics.RecordPipelineReset()
+}
+
 // ResetDerivationPipeline forces a reset of the derivation pipeline.
 // It waits for the reset to occur. It simply unblocks the caller rather
 // than fully cancelling the reset request upon a context cancellation.
@@ -411,6 +459,34 @@ func (d *Driver) StopProposer(ctx context.Context) (common.Hash, error) {
 	}
 }
 
+// SubscribePayloadAttributes subscribes the given channel to the attributes of every block the proposer starts building.
+func (d *Driver) SubscribePayloadAttributes(ch chan<- *eth.PayloadAttributesEvent) event.Subscription {
+	return d.proposer.SubscribePayloadAttributes(ch)
+}
+
+// SubmitExternalPayload submits an externally built payload to be sealed by the proposer
+// instead of the payload it is building itself. The payload must match the attributes of the current block building job.
+func (d *Driver) SubmitExternalPayload(ctx context.Context, payload *eth.ExecutionPayload) error {
+	if !d.driverConfig.ProposerEnabled {
+		return errors.New("proposer is not enabled")
+	}
+	p := payloadAndErrorChannel{
+		payload: payload,
+		err:     make(chan error, 1),
+	}
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case d.externalPayloads <- p:
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case e := <-p.err:
+			return e
+		}
+	}
+}
+
 // syncStatus returns the current sync status, and should only be called synchronously with
 // the driver event loop to avoid retrieval of an inconsistent status.
 func (d *Driver) syncStatus() *eth.SyncStatus {
@@ -427,6 +503,23 @@ func (d *Driver) syncStatus() *eth.SyncStatus {
 	}
 }
 
+// publishSyncStatus sends the current sync status to the status feed subscribers if it differs from the last one.
+// It should only be called synchronously with the driver event loop.
+func (d *Driver) publishSyncStatus(last *eth.SyncStatus) {
+	status := d.syncStatus()
+	if *status == *last {
+		return
+	}
+	*last = *status
+	d.statusFeed.Send(status)
+}
+
+// SubscribeSyncStatus subscribes the given channel to sync status changes.
+// The driver event loop blocks on delivery, so the channel should be buffered and drained promptly.
+func (d *Driver) SubscribeSyncStatus(ch chan<- *eth.SyncStatus) event.Subscription {
+	return d.statusFeed.Subscribe(ch)
+}
+
 // SyncStatus blocks the driver event loop and captures the syncing status.
 // If the event loop is too busy and the context expires, a context error is returned.
 func (d *Driver) SyncStatus(ctx context.Context) (*eth.SyncStatus, error) {
@@ -493,6 +586,11 @@ type hashAndErrorChannel struct {
 	err  chan error
 }
 
+type payloadAndErrorChannel struct {
+	payload *eth.ExecutionPayload
+	err     chan error
+}
+
 // checkForGapInUnsafeQueue checks if there is a gap in the unsafe queue and attempts to retrieve the missing payloads from an alt-sync method.
 // WARNING: This is only an outgoing signal, the blocks are not guaranteed to be retrieved.
 // Results are received through OnUnsafeL2Payload.
@@ -78,6 +78,19 @@ type Config struct {
 	DepositContractAddress common.Address `json:"deposit_contract_address"`
 	// L1 System Config Address
 	L1SystemConfigAddress common.Address `json:"l1_system_config_address"`
+	// L1 addresses that are authorized to submit batches in addition to the batcher address of the system config,
+	// so that a batcher can submit batches from multiple accounts in parallel.
+	ExtraBatcherAddrs []common.Address `json:"extra_batcher_addresses,omitempty"`
+
+	// ZstdTime sets the activation time of zstd channel compression, by the time of the L1 inclusion block of a channel.
+	// Active if ZstdTime != nil && L1 block timestamp >= *ZstdTime, inactive otherwise.
+	ZstdTime *uint64 `json:"zstd_time,omitempty"`
+	// SpanBatchTime sets the activation time of span batches, by the time of the L1 inclusion block of a batch.
+	// Active if SpanBatchTime != nil && L1 block timestamp >= *SpanBatchTime, inactive otherwise.
+	SpanBatchTime *uint64 `json:"span_batch_time,omitempty"`
+	// AltDATime sets the activation time of alt-DA commitments, by the time of the L1 inclusion block of a batcher tx.
+	// Active if AltDATime != nil && L1 block timestamp >= *AltDATime, inactive otherwise.
+	AltDATime *uint64 `json:"alt_da_time,omitempty"`
 }
 
 // ValidateL1Config checks L1 config variables for errors.
@@ -245,6 +258,33 @@ func (cfg *Config) L1Signer() types.Signer {
 	return types.NewLondonSigner(cfg.L1ChainID)
 }
 
+// IsExtraBatcherAddr returns true if the address is authorized to submit batches in addition to
+// the batcher address of the system config.
+func (cfg *Config) IsExtraBatcherAddr(addr common.Address) bool {
+	for _, a := range cfg.ExtraBatcherAddrs {
+		if a == addr {
+			return true
+		}
+	}
+	return false
+}
+
+// IsZstd returns true if zstd compressed channels may be included in the L1 block with the given timestamp.
+func (cfg *Config) IsZstd(l1Timestamp uint64) bool {
+	return cfg.ZstdTime != nil && l1Timestamp >= *cfg.ZstdTime
+}
+
+// IsSpanBatch returns true if span batches may be included in the L1 block with the given timestamp.
+func (cfg *Config) IsSpanBatch(l1Timestamp uint64) bool {
+	return cfg.SpanBatchTime != nil && l1Timestamp >= *cfg.SpanBatchTime
+}
+
+// IsAltDA returns true if batcher txs in the L1 block with the given timestamp may hold commitments
+// to data on an external DA layer, instead of the channel frames.
+func (cfg *Config) IsAltDA(l1Timestamp uint64) bool {
+	return cfg.AltDATime != nil && l1Timestamp >= *cfg.AltDATime
+}
+
 func (cfg *Config) ComputeTimestamp(blockNum uint64) uint64 {
 	return cfg.Genesis.L2Time + blockNum*cfg.BlockTime
 }
@@ -273,6 +313,11 @@ func (cfg *Config) Description(l2Chains map[string]string) string {
 	banner += fmt.Sprintf("  L2 starting time: %d ~ %s\n", cfg.Genesis.L2Time, fmtTime(cfg.Genesis.L2Time))
 	banner += fmt.Sprintf("  L2 block: %s %d\n", cfg.Genesis.L2.Hash, cfg.Genesis.L2.Number)
 	banner += fmt.Sprintf("  L1 block: %s %d\n", cfg.Genesis.L1.Hash, cfg.Genesis.L1.Number)
+	// Report the upgrade configuration
+	banner += "Post-genesis upgrades:\n"
+	banner += fmt.Sprintf("  - Zstd channel compression: %s\n", fmtForkTimeOrUnset(cfg.ZstdTime))
+	banner += fmt.Sprintf("  - Span batches: %s\n", fmtForkTimeOrUnset(cfg.SpanBatchTime))
+	banner += fmt.Sprintf("  - Alt-DA commitments: %s\n", fmtForkTimeOrUnset(cfg.AltDATime))
 	return banner
 }
 
@@ -295,7 +340,8 @@ func (cfg *Config) LogDescription(log log.Logger, l2Chains map[string]string) {
 	log.Info("Rollup Config", "l2_chain_id", cfg.L2ChainID, "l2_network", networkL2, "l1_chain_id", cfg.L1ChainID,
 		"l1_network", networkL1, "l2_start_time", cfg.Genesis.L2Time, "l2_block_hash", cfg.Genesis.L2.Hash.String(),
 		"l2_block_number", cfg.Genesis.L2.Number, "l1_block_hash", cfg.Genesis.L1.Hash.String(),
-		"l1_block_number", cfg.Genesis.L1.Number)
+		"l1_block_number", cfg.Genesis.L1.Number, "zstd_time", fmtForkTimeOrUnset(cfg.ZstdTime),
+		"span_batch_time", fmtForkTimeOrUnset(cfg.SpanBatchTime), "alt_da_time", fmtForkTimeOrUnset(cfg.AltDATime))
 }
 
 func fmtForkTimeOrUnset(v *uint64) string {
@@ -8,13 +8,13 @@ import (
 	"os"
 	"strings"
 
-	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/urfave/cli/v2"
 
 	"github.com/kroma-network/kroma/components/node/chaincfg"
 	"github.com/kroma-network/kroma/components/node/flags"
+	"github.com/kroma-network/kroma/components/node/heartbeat"
 	"github.com/kroma-network/kroma/components/node/node"
 	p2pcli "github.com/kroma-network/kroma/components/node/p2p/cli"
 	"github.com/kroma-network/kroma/components/node/rollup"
@@ -46,6 +46,11 @@ func NewConfig(ctx *cli.Context, log log.Logger) (*node.Config, error) {
 		return nil, fmt.Errorf("failed to load p2p config: %w", err)
 	}
 
+	heartbeatConfig, err := NewHeartbeatConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	l1Endpoint := NewL1EndpointConfig(ctx)
 
 	l2Endpoint, err := NewL2EndpointConfig(ctx, log)
@@ -59,17 +64,27 @@ func NewConfig(ctx *cli.Context, log log.Logger) (*node.Config, error) {
 		L1:     l1Endpoint,
 		L2:     l2Endpoint,
 		L2Sync: l2SyncEndpoint,
+		Builder: node.BuilderEndpointConfig{
+			BuilderAddr: ctx.String(flags.ProposerBuilderAddr.Name),
+		},
 		Rollup: *rollupConfig,
 		Driver: *driverConfig,
 		RPC: node.RPCConfig{
 			ListenAddr:  ctx.String(flags.RPCListenAddr.Name),
 			ListenPort:  ctx.Int(flags.RPCListenPort.Name),
 			EnableAdmin: ctx.Bool(flags.RPCEnableAdmin.Name),
+
+			OutputCacheSize: ctx.Int(flags.RPCOutputCacheSize.Name),
+			OutputCachePath: ctx.String(flags.RPCOutputCachePath.Name),
 		},
 		Metrics: node.MetricsConfig{
 			Enabled:    ctx.Bool(flags.MetricsEnabledFlag.Name),
 			ListenAddr: ctx.String(flags.MetricsAddrFlag.Name),
 			ListenPort: ctx.Int(flags.MetricsPortFlag.Name),
+			HeadLagThresholds: node.HeadLagThresholds{
+				MaxUnsafeSafeLag:    ctx.Duration(flags.MetricsMaxUnsafeSafeLagFlag.Name),
+				MaxSafeFinalizedLag: ctx.Duration(flags.MetricsMaxSafeFinalizedLagFlag.Name),
+			},
 		},
 		Pprof: kpprof.CLIConfig{
 			Enabled:    ctx.Bool(flags.PprofEnabledFlag.Name),
@@ -79,11 +94,8 @@ func NewConfig(ctx *cli.Context, log log.Logger) (*node.Config, error) {
 		P2P:                 p2pConfig,
 		P2PSigner:           p2pSignerSetup,
 		L1EpochPollInterval: ctx.Duration(flags.L1EpochPollIntervalFlag.Name),
-		Heartbeat: node.HeartbeatConfig{
-			Enabled: ctx.Bool(flags.HeartbeatEnabledFlag.Name),
-			Moniker: ctx.String(flags.HeartbeatMonikerFlag.Name),
-			URL:     ctx.String(flags.HeartbeatURLFlag.Name),
-		},
+		AltDAServer:         ctx.String(flags.AltDAServerFlag.Name),
+		Heartbeat:           heartbeatConfig,
 	}
 	if err := cfg.Check(); err != nil {
 		return nil, err
@@ -91,6 +103,24 @@ func NewConfig(ctx *cli.Context, log log.Logger) (*node.Config, error) {
 	return cfg, nil
 }
 
+func NewHeartbeatConfig(ctx *cli.Context) (node.HeartbeatConfig, error) {
+	var fields []heartbeat.Field
+	for _, name := range ctx.StringSlice(flags.HeartbeatFieldsFlag.Name) {
+		field, err := heartbeat.ParseField(name)
+		if err != nil {
+			return node.HeartbeatConfig{}, err
+		}
+		fields = append(fields, field)
+	}
+	return node.HeartbeatConfig{
+		Enabled:  ctx.Bool(flags.HeartbeatEnabledFlag.Name),
+		Moniker:  ctx.String(flags.HeartbeatMonikerFlag.Name),
+		URL:      ctx.String(flags.HeartbeatURLFlag.Name),
+		Interval: ctx.Duration(flags.HeartbeatIntervalFlag.Name),
+		Fields:   fields,
+	}, nil
+}
+
 func NewL1EndpointConfig(ctx *cli.Context) *node.L1EndpointConfig {
 	return &node.L1EndpointConfig{
 		L1NodeAddr:       ctx.String(flags.L1NodeAddr.Name),
@@ -110,12 +140,10 @@ func NewL2EndpointConfig(ctx *cli.Context, log log.Logger) (*node.L2EndpointConf
 	if fileName == "" {
 		return nil, fmt.Errorf("file-name of jwt secret is empty")
 	}
-	if data, err := os.ReadFile(fileName); err == nil {
-		jwtSecret := common.FromHex(strings.TrimSpace(string(data)))
-		if len(jwtSecret) != 32 {
-			return nil, fmt.Errorf("invalid jwt secret in path %s, not 32 hex-formatted bytes", fileName)
+	if _, err := os.Stat(fileName); err == nil {
+		if secret, err = node.ReadJWTSecret(fileName); err != nil {
+			return nil, err
 		}
-		copy(secret[:], jwtSecret)
 	} else {
 		log.Warn("Failed to read JWT secret from file, generating a new one now. Configure L2 geth with --authrpc.jwt-secret=" + fmt.Sprintf("%q", fileName))
 		if _, err := io.ReadFull(rand.Reader, secret[:]); err != nil {
@@ -127,8 +155,10 @@ func NewL2EndpointConfig(ctx *cli.Context, log log.Logger) (*node.L2EndpointConf
 	}
 
 	return &node.L2EndpointConfig{
-		L2EngineAddr:      l2Addr,
-		L2EngineJWTSecret: secret,
+		L2EngineAddr:           l2Addr,
+		L2EngineSecondaryAddrs: ctx.StringSlice(flags.L2EngineSecondaryAddrs.Name),
+		L2EngineJWTSecret:      secret,
+		L2EngineJWTSecretPath:  fileName,
 	}, nil
 }
 
@@ -148,12 +178,22 @@ func NewDriverConfig(ctx *cli.Context) *driver.Config {
 		ProposerEnabled:    ctx.Bool(flags.ProposerEnabledFlag.Name),
 		ProposerStopped:    ctx.Bool(flags.ProposerStoppedFlag.Name),
 		ProposerMaxSafeLag: ctx.Uint64(flags.ProposerMaxSafeLagFlag.Name),
+		BuilderTimeout:     ctx.Duration(flags.ProposerBuilderTimeout.Name),
 	}
 }
 
 func NewRollupConfig(ctx *cli.Context) (*rollup.Config, error) {
 	network := ctx.String(flags.Network.Name)
 	if network != "" {
+		if registry := ctx.String(flags.NetworkRegistry.Name); registry != "" {
+			config, err := chaincfg.FetchRollupConfig(ctx.Context, registry, network)
+			if err != nil {
+				return nil, err
+			}
+
+			return &config, nil
+		}
+
 		config, err := chaincfg.GetRollupConfig(network)
 		if err != nil {
 			return nil, err
@@ -0,0 +1,35 @@
+package sources
+
+import (
+	"context"
+	"errors"
+
+	"github.com/kroma-network/kroma/components/node/client"
+	"github.com/kroma-network/kroma/components/node/eth"
+)
+
+// BuilderClient delegates block construction to a remote block builder over RPC.
+// The builder is requested to build a payload for the attributes of every block the proposer starts building.
+type BuilderClient struct {
+	rpc client.RPC
+}
+
+func NewBuilderClient(rpc client.RPC) *BuilderClient {
+	return &BuilderClient{rpc}
+}
+
+// BuildPayload requests the remote builder to build a payload with the given attributes, on top of the given parent.
+func (b *BuilderClient) BuildPayload(ctx context.Context, building *eth.PayloadAttributesEvent) (*eth.ExecutionPayload, error) {
+	var payload *eth.ExecutionPayload
+	if err := b.rpc.CallContext(ctx, &payload, "builder_buildPayload", building); err != nil {
+		return nil, err
+	}
+	if payload == nil {
+		return nil, errors.New("builder did not return a payload")
+	}
+	return payload, nil
+}
+
+func (b *BuilderClient) Close() {
+	b.rpc.Close()
+}
@@ -27,24 +27,50 @@ var MessagePassedTopic = crypto.Keccak256Hash([]byte("MessagePassed(uint256,addr
 // This functions polls and can block for a very long time if used on mainnet.
 // This returns the block number to use for the proof generation.
 func WaitForFinalizationPeriod(ctx context.Context, client *ethclient.Client, portalAddr common.Address, l2BlockNumber *big.Int) (uint64, error) {
-	l2BlockNumber = new(big.Int).Set(l2BlockNumber) // Don't clobber caller owned l2BlockNumber
-	opts := &bind.CallOpts{Context: ctx}
+	l2BlockNumber, err := WaitForOutputBlock(ctx, client, portalAddr, l2BlockNumber)
+	if err != nil {
+		return 0, err
+	}
 
-	portal, err := bindings.NewKromaPortalCaller(portalAddr, client)
+	opts := &bind.CallOpts{Context: ctx}
+	l2OO, err := l2OutputOracleOf(opts, client, portalAddr)
 	if err != nil {
 		return 0, err
 	}
-	l2OOAddress, err := portal.L2ORACLE(opts)
+	finalizationPeriod, err := l2OO.FINALIZATIONPERIODSECONDS(opts)
 	if err != nil {
 		return 0, err
 	}
-	l2OO, err := bindings.NewL2OutputOracleCaller(l2OOAddress, client)
+
+	// Now wait for it to be finalized
+	output, err := l2OO.GetL2OutputAfter(opts, l2BlockNumber)
 	if err != nil {
 		return 0, err
 	}
+	if output.OutputRoot == [32]byte{} {
+		return 0, errors.New("empty output root. likely no output at timestamp")
+	}
+	targetTimestamp := new(big.Int).Add(output.Timestamp, finalizationPeriod)
+	if err := WaitForL1Timestamp(ctx, client, targetTimestamp.Uint64()); err != nil {
+		return 0, err
+	}
+	return l2BlockNumber.Uint64(), nil
+}
+
+// WaitForOutputBlock waits until there is OutputProof for an L2 block number larger than the supplied l2BlockNumber,
+// without waiting for the output to be finalized.
+// This returns the block number of the output, on the submission interval boundary, to use for the proof generation.
+func WaitForOutputBlock(ctx context.Context, client *ethclient.Client, portalAddr common.Address, l2BlockNumber *big.Int) (*big.Int, error) {
+	l2BlockNumber = new(big.Int).Set(l2BlockNumber) // Don't clobber caller owned l2BlockNumber
+	opts := &bind.CallOpts{Context: ctx}
+
+	l2OO, err := l2OutputOracleOf(opts, client, portalAddr)
+	if err != nil {
+		return nil, err
+	}
 	submissionInterval, err := l2OO.SUBMISSIONINTERVAL(opts)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
 	// Convert blockNumber to submission interval boundary
 	rem := new(big.Int)
@@ -54,14 +80,9 @@ func WaitForFinalizationPeriod(ctx context.Context, client *ethclient.Client, po
 	}
 	l2BlockNumber = l2BlockNumber.Mul(l2BlockNumber, submissionInterval)
 
-	finalizationPeriod, err := l2OO.FINALIZATIONPERIODSECONDS(opts)
-	if err != nil {
-		return 0, err
-	}
-
 	latest, err := l2OO.LatestBlockNumber(opts)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
 
 	// Now poll for the output to be submitted on chain
@@ -72,53 +93,63 @@ func WaitForFinalizationPeriod(ctx context.Context, client *ethclient.Client, po
 	} else {
 		ticker = time.NewTicker(time.Second)
 	}
+	defer ticker.Stop()
 
-loop:
 	for {
 		select {
 		case <-ticker.C:
 			latest, err = l2OO.LatestBlockNumber(opts)
 			if err != nil {
-				return 0, err
+				return nil, err
 			}
 			// Already passed the submitted block (likely just equals rather than >= here).
 			if latest.Cmp(l2BlockNumber) >= 0 {
-				break loop
+				return l2BlockNumber, nil
 			}
 		case <-ctx.Done():
-			return 0, ctx.Err()
+			return nil, ctx.Err()
 		}
 	}
+}
 
-	// Now wait for it to be finalized
-	output, err := l2OO.GetL2OutputAfter(opts, l2BlockNumber)
-	if err != nil {
-		return 0, err
-	}
-	if output.OutputRoot == [32]byte{} {
-		return 0, errors.New("empty output root. likely no output at timestamp")
-	}
-	targetTimestamp := new(big.Int).Add(output.Timestamp, finalizationPeriod)
-	targetTime := time.Unix(targetTimestamp.Int64(), 0)
+// WaitForL1Timestamp waits until the L1 head has a time greater than the target timestamp.
+func WaitForL1Timestamp(ctx context.Context, client *ethclient.Client, targetTimestamp uint64) error {
+	targetTime := time.Unix(int64(targetTimestamp), 0)
 	// Assume clock is relatively correct
-	time.Sleep(time.Until(targetTime))
+	select {
+	case <-time.After(time.Until(targetTime)):
+	case <-ctx.Done():
+		return ctx.Err()
+	}
 	// Poll for L1 Block to have a time greater than the target time
-	ticker = time.NewTicker(time.Second)
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
 	for {
 		select {
 		case <-ticker.C:
 			header, err := client.HeaderByNumber(ctx, nil)
 			if err != nil {
-				return 0, err
+				return err
 			}
-			if header.Time > targetTimestamp.Uint64() {
-				return l2BlockNumber.Uint64(), nil
+			if header.Time > targetTimestamp {
+				return nil
 			}
 		case <-ctx.Done():
-			return 0, ctx.Err()
+			return ctx.Err()
 		}
 	}
+}
 
+func l2OutputOracleOf(opts *bind.CallOpts, client *ethclient.Client, portalAddr common.Address) (*bindings.L2OutputOracleCaller, error) {
+	portal, err := bindings.NewKromaPortalCaller(portalAddr, client)
+	if err != nil {
+		return nil, err
+	}
+	l2OOAddress, err := portal.L2ORACLE(opts)
+	if err != nil {
+		return nil, err
+	}
+	return bindings.NewL2OutputOracleCaller(l2OOAddress, client)
 }
 
 type ProofClient interface {
@@ -0,0 +1,74 @@
+package challenge
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// DefaultBackend is the backend of the kroma-prover, the zkEVM prover served over JSON-RPC.
+const DefaultBackend = "kroma-prover"
+
+// ProofBackend generates the proofs of the blocks to prove the faults with.
+type ProofBackend interface {
+	FetchProofAndPair(ctx context.Context, trace string) (*ProofAndPair, error)
+}
+
+// BackendConfig is the config of the provers that a ProofBackend requests the proofs from.
+type BackendConfig struct {
+	RPCURLs         []string
+	Timeout         time.Duration
+	AdaptiveTimeout bool
+	MaxRetries      int
+}
+
+// BackendFactory creates a ProofBackend.
+type BackendFactory func(cfg BackendConfig, logger log.Logger, m Metricer) (ProofBackend, error)
+
+var (
+	backendsMu sync.RWMutex
+	backends   = map[string]BackendFactory{
+		DefaultBackend: func(cfg BackendConfig, logger log.Logger, m Metricer) (ProofBackend, error) {
+			return NewFetcher(cfg.RPCURLs, cfg.Timeout, cfg.AdaptiveTimeout, cfg.MaxRetries, logger, m)
+		},
+	}
+)
+
+// RegisterBackend makes the backend available by the given name, so that the provers can be swapped by config,
+// e.g. to upgrade the prover or to compare another prover. It panics if the name is registered already.
+func RegisterBackend(name string, factory BackendFactory) {
+	backendsMu.Lock()
+	defer backendsMu.Unlock()
+	if _, ok := backends[name]; ok {
+		panic(fmt.Sprintf("proof backend %q is registered twice", name))
+	}
+	backends[name] = factory
+}
+
+// NewBackend creates the backend registered by the given name.
+func NewBackend(name string, cfg BackendConfig, logger log.Logger, m Metricer) (ProofBackend, error) {
+	backendsMu.RLock()
+	factory, ok := backends[name]
+	backendsMu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("unknown proof backend %q, available: %s", name, strings.Join(Backends(), ", "))
+	}
+	return factory(cfg, logger.New("backend", name), m)
+}
+
+// Backends returns the names of the registered backends.
+func Backends() []string {
+	backendsMu.RLock()
+	defer backendsMu.RUnlock()
+	names := make([]string, 0, len(backends))
+	for name := range backends {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
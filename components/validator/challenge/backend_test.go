@@ -0,0 +1,39 @@
+package challenge
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+type testBackend struct{}
+
+func (testBackend) FetchProofAndPair(ctx context.Context, trace string) (*ProofAndPair, error) {
+	return &ProofAndPair{}, nil
+}
+
+func TestNewBackend(t *testing.T) {
+	cfg := BackendConfig{RPCURLs: []string{"http://prover"}, Timeout: time.Second}
+
+	backend, err := NewBackend(DefaultBackend, cfg, log.New(), noopMetricer{})
+	require.NoError(t, err)
+	require.IsType(t, &Fetcher{}, backend)
+
+	_, err = NewBackend("unknown", cfg, log.New(), noopMetricer{})
+	require.ErrorContains(t, err, DefaultBackend)
+
+	RegisterBackend("test", func(cfg BackendConfig, logger log.Logger, m Metricer) (ProofBackend, error) {
+		return testBackend{}, nil
+	})
+	require.Contains(t, Backends(), "test")
+	backend, err = NewBackend("test", cfg, log.New(), noopMetricer{})
+	require.NoError(t, err)
+	require.IsType(t, testBackend{}, backend)
+
+	require.Panics(t, func() {
+		RegisterBackend("test", nil)
+	})
+}
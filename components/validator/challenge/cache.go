@@ -0,0 +1,86 @@
+package challenge
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// ProofCache caches the witnesses and proofs on disk, keyed by the L2 block number and output root of the proven block,
+// so that restarting the validator or handling a challenge again does not generate the same proof again.
+type ProofCache struct {
+	dir string
+}
+
+// NewProofCache creates a ProofCache that stores the witnesses and proofs in the given directory.
+func NewProofCache(dir string) (*ProofCache, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create proof cache directory: %w", err)
+	}
+	return &ProofCache{dir: dir}, nil
+}
+
+func (c *ProofCache) path(kind string, blockNumber uint64, outputRoot common.Hash) string {
+	return filepath.Join(c.dir, fmt.Sprintf("%d-%s.%s.json", blockNumber, outputRoot.Hex(), kind))
+}
+
+// GetWitness returns the cached witness of the block, or nil if it is not cached.
+func (c *ProofCache) GetWitness(blockNumber uint64, outputRoot common.Hash) ([]byte, error) {
+	data, err := os.ReadFile(c.path("witness", blockNumber, outputRoot))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to read cached witness: %w", err)
+	}
+	return data, nil
+}
+
+// PutWitness caches the witness of the block.
+func (c *ProofCache) PutWitness(blockNumber uint64, outputRoot common.Hash, witness []byte) error {
+	return c.write(c.path("witness", blockNumber, outputRoot), witness)
+}
+
+// GetProof returns the cached proof of the block, or nil if it is not cached.
+func (c *ProofCache) GetProof(blockNumber uint64, outputRoot common.Hash) (*ProofAndPair, error) {
+	data, err := os.ReadFile(c.path("proof", blockNumber, outputRoot))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to read cached proof: %w", err)
+	}
+	var proof ProofAndPair
+	if err := json.Unmarshal(data, &proof); err != nil {
+		return nil, fmt.Errorf("failed to decode cached proof: %w", err)
+	}
+	return &proof, nil
+}
+
+// PutProof caches the proof of the block.
+func (c *ProofCache) PutProof(blockNumber uint64, outputRoot common.Hash, proof *ProofAndPair) error {
+	data, err := json.Marshal(proof)
+	if err != nil {
+		return fmt.Errorf("failed to encode proof: %w", err)
+	}
+	return c.write(c.path("proof", blockNumber, outputRoot), data)
+}
+
+// write writes the data to the given file. The file is replaced atomically, so that a partially written file is never read.
+func (c *ProofCache) write(path string, data []byte) error {
+	tmp, err := os.CreateTemp(c.dir, filepath.Base(path)+".tmp")
+	if err != nil {
+		return fmt.Errorf("failed to create proof cache file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("failed to write proof cache file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write proof cache file: %w", err)
+	}
+	return os.Rename(tmp.Name(), path)
+}
@@ -0,0 +1,43 @@
+package challenge
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProofCache(t *testing.T) {
+	cache, err := NewProofCache(t.TempDir())
+	require.NoError(t, err)
+
+	root := common.HexToHash("0x01")
+	otherRoot := common.HexToHash("0x02")
+
+	witness, err := cache.GetWitness(10, root)
+	require.NoError(t, err)
+	require.Nil(t, witness)
+	proof, err := cache.GetProof(10, root)
+	require.NoError(t, err)
+	require.Nil(t, proof)
+
+	require.NoError(t, cache.PutWitness(10, root, []byte(`{"trace":1}`)))
+	expected := &ProofAndPair{
+		Proof: []*big.Int{big.NewInt(1), new(big.Int).Lsh(big.NewInt(1), 255)},
+		Pair:  []*big.Int{big.NewInt(2)},
+	}
+	require.NoError(t, cache.PutProof(10, root, expected))
+
+	witness, err = cache.GetWitness(10, root)
+	require.NoError(t, err)
+	require.Equal(t, []byte(`{"trace":1}`), witness)
+	proof, err = cache.GetProof(10, root)
+	require.NoError(t, err)
+	require.Equal(t, expected, proof)
+
+	// the cache is keyed by the output root as well
+	proof, err = cache.GetProof(10, otherRoot)
+	require.NoError(t, err)
+	require.Nil(t, proof)
+}
@@ -4,10 +4,12 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"math/big"
 	"net/http"
+	"sync/atomic"
 	"time"
 
 	"github.com/ethereum/go-ethereum/log"
@@ -36,24 +38,96 @@ type (
 
 	ProverClient interface {
 		Prove(ctx context.Context, traceString string, proofType ProofType) (*ProveResponse, error)
+		// Probe checks if the prover is reachable.
+		Probe(ctx context.Context) error
 	}
 
+	// Metricer records the durations and the failures of the proof generation.
+	Metricer interface {
+		RecordProverStep(step string, duration time.Duration)
+		RecordProverFailure(reason string)
+		RecordProverTimeout(timeout time.Duration)
+	}
+
+	// Fetcher fetches proofs from a pool of provers. The provers are tried in the configured order,
+	// with the unhealthy provers tried last, and a prover is only requested after its probe succeeds.
 	Fetcher struct {
-		Client  JsonRPCProverClient
-		logger  log.Logger
-		timeout time.Duration
+		provers       []*prover
+		logger        log.Logger
+		metr          Metricer
+		timeout       time.Duration
+		maxRetries    int
+		retryInterval time.Duration
+		// timeouts adapts the timeout of the proof requests to the observed proving times, if enabled
+		timeouts *adaptiveTimeout
+	}
+
+	prover struct {
+		url     string
+		client  ProverClient
+		healthy atomic.Bool
 	}
 )
 
-func NewFetcher(rpcURL string, timeout time.Duration, logger log.Logger) (*Fetcher, error) {
-	if rpcURL == "" {
+const (
+	// proberTimeout is the timeout of the health probe of a prover.
+	proberTimeout = 10 * time.Second
+	// DefaultRetryInterval is the delay before retrying all the provers once none of them could return a proof.
+	DefaultRetryInterval = 10 * time.Second
+)
+
+// The steps of the proof generation.
+const (
+	ProverStepWitness     = "witness"
+	ProverStepQueue       = "queue"
+	ProverStepPublicInput = "public_input"
+	ProverStepProbe       = "probe"
+	ProverStepProve       = "prove"
+)
+
+// The reasons of the failed proof requests.
+const (
+	// ProverFailureUnreachable is the failure of the probe.
+	ProverFailureUnreachable = "unreachable"
+	// ProverFailureTimeout is the proof request timed out.
+	ProverFailureTimeout = "timeout"
+	// ProverFailureRejected is the error returned by the prover.
+	ProverFailureRejected = "rejected"
+	// ProverFailureTransport is the failure to send the request or to read the response.
+	ProverFailureTransport = "transport"
+)
+
+// NewFetcher creates a Fetcher for the given prover RPC URLs. Each proof request to a prover times out after
+// the given timeout, and all provers are retried up to maxRetries times if none of them could return a proof.
+// If adaptive is set, the timeout is adapted to the observed proving times, bounded by the given timeout.
+func NewFetcher(rpcURLs []string, timeout time.Duration, adaptive bool, maxRetries int, logger log.Logger, m Metricer) (*Fetcher, error) {
+	if len(rpcURLs) == 0 {
 		return nil, fmt.Errorf("no RPC URL specified")
 	}
 
+	provers := make([]*prover, 0, len(rpcURLs))
+	for _, url := range rpcURLs {
+		if url == "" {
+			return nil, fmt.Errorf("empty RPC URL specified")
+		}
+		p := &prover{url: url, client: JsonRPCProverClient{url}}
+		p.healthy.Store(true)
+		provers = append(provers, p)
+	}
+
+	var timeouts *adaptiveTimeout
+	if adaptive {
+		timeouts = newAdaptiveTimeout(timeout)
+	}
+
 	return &Fetcher{
-		Client:  JsonRPCProverClient{rpcURL},
-		logger:  logger,
-		timeout: timeout,
+		provers:       provers,
+		logger:        logger,
+		metr:          m,
+		timeout:       timeout,
+		maxRetries:    maxRetries,
+		retryInterval: DefaultRetryInterval,
+		timeouts:      timeouts,
 	}, nil
 }
 
@@ -63,23 +137,114 @@ type ProofAndPair struct {
 }
 
 func (f *Fetcher) FetchProofAndPair(ctx context.Context, trace string) (*ProofAndPair, error) {
-	cCtx, cCancel := context.WithTimeout(ctx, f.timeout)
+	var lastErr error
+	for attempt := 0; attempt <= f.maxRetries; attempt++ {
+		if attempt > 0 {
+			f.logger.Warn("retrying to fetch proof from provers", "attempt", attempt, "err", lastErr)
+			select {
+			case <-time.After(f.retryInterval):
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			}
+		}
+
+		for _, p := range f.candidates() {
+			resp, err := f.prove(ctx, p, trace)
+			if err == nil {
+				return &ProofAndPair{
+					Proof: Decode(resp.Proof),
+					Pair:  Decode(resp.FinalPair),
+				}, nil
+			}
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+			if p.healthy.Swap(false) {
+				f.logger.Warn("prover is unhealthy, failing over to the next prover", "url", p.url, "err", err)
+			}
+			lastErr = err
+		}
+	}
+
+	f.logger.Error("could not request fault proof", "err", lastErr)
+	return nil, fmt.Errorf("failed to fetch proof from %d provers: %w", len(f.provers), lastErr)
+}
+
+// candidates returns the provers in the order to request them: the healthy provers first, then the unhealthy ones.
+func (f *Fetcher) candidates() []*prover {
+	out := make([]*prover, 0, len(f.provers))
+	for _, p := range f.provers {
+		if p.healthy.Load() {
+			out = append(out, p)
+		}
+	}
+	for _, p := range f.provers {
+		if !p.healthy.Load() {
+			out = append(out, p)
+		}
+	}
+	return out
+}
+
+// prove probes the prover, and requests the proof from it if it is reachable.
+func (f *Fetcher) prove(ctx context.Context, p *prover, trace string) (*ProveResponse, error) {
+	probeStart := time.Now()
+	pCtx, pCancel := context.WithTimeout(ctx, proberTimeout)
+	defer pCancel()
+	if err := p.client.Probe(pCtx); err != nil {
+		f.metr.RecordProverFailure(ProverFailureUnreachable)
+		return nil, fmt.Errorf("failed to probe prover %s: %w", p.url, err)
+	}
+	f.metr.RecordProverStep(ProverStepProbe, time.Since(probeStart))
+	if p.healthy.CompareAndSwap(false, true) {
+		f.logger.Info("prover is healthy again", "url", p.url)
+	}
+
+	timeout := f.proveTimeout()
+	f.metr.RecordProverTimeout(timeout)
+	cCtx, cCancel := context.WithTimeout(ctx, timeout)
 	defer cCancel()
 
 	// NOTE(0xHansLee): only ProofType_AGG(4) is used for proof.
 	// https://github.com/kroma-network/kroma-prover/blob/dev/prover-server/src/spec.rs#L10-L16
-	resp, err := f.Client.Prove(cCtx, trace, 4)
+	proveStart := time.Now()
+	resp, err := p.client.Prove(cCtx, trace, 4)
+	elapsed := time.Since(proveStart)
 	if err != nil {
-		f.logger.Error("could not request fault proof", "err", err)
-		return nil, err
+		reason := classifyProverFailure(err)
+		f.metr.RecordProverFailure(reason)
+		// the proving time is at least the timeout, which lets the adaptive timeout grow
+		if reason == ProverFailureTimeout && ctx.Err() == nil && f.timeouts != nil {
+			f.timeouts.observe(elapsed)
+		}
+		return nil, fmt.Errorf("failed to request proof from prover %s: %w", p.url, err)
+	}
+	f.metr.RecordProverStep(ProverStepProve, elapsed)
+	if f.timeouts != nil {
+		f.timeouts.observe(elapsed)
 	}
+	return resp, nil
+}
 
-	result := &ProofAndPair{
-		Proof: Decode(resp.Proof),
-		Pair:  Decode(resp.FinalPair),
+// proveTimeout returns the timeout of a proof request.
+func (f *Fetcher) proveTimeout() time.Duration {
+	if f.timeouts == nil {
+		return f.timeout
 	}
+	return f.timeouts.timeout()
+}
 
-	return result, nil
+// classifyProverFailure returns the reason of the failed proof request.
+func classifyProverFailure(err error) string {
+	var rpcErr *JsonRpcError
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		return ProverFailureTimeout
+	case errors.As(err, &rpcErr):
+		return ProverFailureRejected
+	default:
+		return ProverFailureTransport
+	}
 }
 
 func Decode(data []byte) []*big.Int {
@@ -142,10 +307,26 @@ func (c JsonRPCProverClient) Prove(ctx context.Context, traceString string, proo
 	}
 
 	if resp.Error != nil {
-		return nil, fmt.Errorf("error occurs from zk prover: %s", resp.Error)
+		return nil, fmt.Errorf("error occurs from zk prover: %w", resp.Error)
 	}
 
 	return resp.Result, nil
 }
 
+// Probe checks if the prover server is reachable. Any HTTP response is accepted,
+// as the prover server does not serve a health endpoint.
+func (c JsonRPCProverClient) Probe(ctx context.Context) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.address, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create probe request: %w", err)
+	}
+
+	cli := http.Client{}
+	res, err := cli.Do(req)
+	if err != nil {
+		return err
+	}
+	return res.Body.Close()
+}
+
 func (j *JsonRpcError) Error() string { return fmt.Sprintf("[%d] %s", j.Code, j.Message) }
@@ -0,0 +1,95 @@
+package challenge
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+type mockProverClient struct {
+	probeErr error
+	proveErr error
+	calls    int
+}
+
+func (c *mockProverClient) Prove(ctx context.Context, traceString string, proofType ProofType) (*ProveResponse, error) {
+	c.calls++
+	if c.proveErr != nil {
+		return nil, c.proveErr
+	}
+	return &ProveResponse{Proof: make([]byte, 32), FinalPair: make([]byte, 64)}, nil
+}
+
+func (c *mockProverClient) Probe(ctx context.Context) error {
+	return c.probeErr
+}
+
+type noopMetricer struct{}
+
+func (noopMetricer) RecordProverStep(step string, duration time.Duration) {}
+func (noopMetricer) RecordProverFailure(reason string)                    {}
+func (noopMetricer) RecordProverTimeout(timeout time.Duration)            {}
+
+func newTestFetcher(t *testing.T, clients ...*mockProverClient) *Fetcher {
+	urls := make([]string, len(clients))
+	for i := range clients {
+		urls[i] = "http://prover"
+	}
+	f, err := NewFetcher(urls, time.Second, false, 1, log.New(), noopMetricer{})
+	require.NoError(t, err)
+	for i, c := range clients {
+		f.provers[i].client = c
+	}
+	f.retryInterval = time.Millisecond
+	return f
+}
+
+func TestFetcherFailover(t *testing.T) {
+	unreachable := &mockProverClient{probeErr: errors.New("connection refused")}
+	failing := &mockProverClient{proveErr: errors.New("prover error")}
+	healthy := &mockProverClient{}
+	f := newTestFetcher(t, unreachable, failing, healthy)
+
+	res, err := f.FetchProofAndPair(context.Background(), "trace")
+	require.NoError(t, err)
+	require.Len(t, res.Proof, 1)
+	require.Len(t, res.Pair, 2)
+	require.Equal(t, 0, unreachable.calls, "unreachable prover must not be requested")
+	require.Equal(t, 1, failing.calls)
+	require.Equal(t, 1, healthy.calls)
+
+	// the failed provers are tried last
+	require.False(t, f.provers[0].healthy.Load())
+	require.False(t, f.provers[1].healthy.Load())
+	require.Equal(t, f.provers[2], f.candidates()[0])
+
+	_, err = f.FetchProofAndPair(context.Background(), "trace")
+	require.NoError(t, err)
+	require.Equal(t, 1, failing.calls)
+	require.Equal(t, 2, healthy.calls)
+}
+
+func TestFetcherRetry(t *testing.T) {
+	failing := &mockProverClient{proveErr: errors.New("prover error")}
+	f := newTestFetcher(t, failing)
+
+	_, err := f.FetchProofAndPair(context.Background(), "trace")
+	require.ErrorIs(t, err, failing.proveErr)
+	require.Equal(t, 2, failing.calls, "expected the prover to be retried once")
+}
+
+func TestNewFetcherNoURL(t *testing.T) {
+	_, err := NewFetcher(nil, time.Second, false, 1, log.New(), noopMetricer{})
+	require.Error(t, err)
+}
+
+func TestClassifyProverFailure(t *testing.T) {
+	require.Equal(t, ProverFailureTimeout, classifyProverFailure(fmt.Errorf("request: %w", context.DeadlineExceeded)))
+	require.Equal(t, ProverFailureRejected, classifyProverFailure(fmt.Errorf("error occurs from zk prover: %w", &JsonRpcError{Code: -32000})))
+	require.Equal(t, ProverFailureTransport, classifyProverFailure(errors.New("connection reset")))
+}
@@ -0,0 +1,163 @@
+package challenge
+
+import (
+	"container/heap"
+	"context"
+	"sort"
+	"sync"
+	"time"
+)
+
+// ProofRequest is a request to generate a proof, queued in the ProofQueue.
+type ProofRequest struct {
+	OutputIndex uint64
+	BlockNumber uint64
+	// Deadline is the time by which the proof must be submitted. Zero if the request has no deadline.
+	Deadline time.Time
+	// Speculative is true if the proof is precomputed, not required by a challenge yet.
+	Speculative bool
+	// EnqueuedAt is the time the request is queued at.
+	EnqueuedAt time.Time
+	// Running is true if the proof is being requested from the provers.
+	Running bool
+}
+
+// before returns true if the request is prioritized over the other request. The requests of the challenges
+// are prioritized over the speculative ones, then the request nearing its deadline first, then the older one.
+func (r *ProofRequest) before(other *ProofRequest) bool {
+	if r.Speculative != other.Speculative {
+		return !r.Speculative
+	}
+	if !r.Deadline.Equal(other.Deadline) {
+		if r.Deadline.IsZero() || other.Deadline.IsZero() {
+			return other.Deadline.IsZero()
+		}
+		return r.Deadline.Before(other.Deadline)
+	}
+	return r.EnqueuedAt.Before(other.EnqueuedAt)
+}
+
+type queuedRequest struct {
+	ProofRequest
+	ready chan struct{}
+	index int
+}
+
+type requestHeap []*queuedRequest
+
+func (h requestHeap) Len() int           { return len(h) }
+func (h requestHeap) Less(i, j int) bool { return h[i].before(&h[j].ProofRequest) }
+func (h requestHeap) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+	h[i].index = i
+	h[j].index = j
+}
+
+func (h *requestHeap) Push(x any) {
+	r := x.(*queuedRequest)
+	r.index = len(*h)
+	*h = append(*h, r)
+}
+
+func (h *requestHeap) Pop() any {
+	old := *h
+	n := len(old)
+	r := old[n-1]
+	old[n-1] = nil
+	r.index = -1
+	*h = old[:n-1]
+	return r
+}
+
+// ProofQueue bounds the number of proofs requested from the provers concurrently,
+// and serves the waiting requests in the order of priority.
+type ProofQueue struct {
+	mu      sync.Mutex
+	slots   int
+	running []*queuedRequest
+	waiting requestHeap
+}
+
+// NewProofQueue creates a ProofQueue that runs up to the given number of requests concurrently.
+func NewProofQueue(slots int) *ProofQueue {
+	if slots < 1 {
+		slots = 1
+	}
+	return &ProofQueue{slots: slots}
+}
+
+// Acquire waits until the request is run, and returns the function to release the slot of the request with.
+// The request is removed from the queue if the context is done before.
+func (q *ProofQueue) Acquire(ctx context.Context, req ProofRequest) (func(), error) {
+	r := &queuedRequest{ProofRequest: req, ready: make(chan struct{})}
+	r.EnqueuedAt = time.Now()
+	r.Running = false
+
+	q.mu.Lock()
+	heap.Push(&q.waiting, r)
+	q.dispatch()
+	q.mu.Unlock()
+
+	var once sync.Once
+	release := func() {
+		once.Do(func() { q.release(r) })
+	}
+
+	select {
+	case <-r.ready:
+		return release, nil
+	case <-ctx.Done():
+		q.mu.Lock()
+		if r.Running {
+			q.mu.Unlock()
+			release()
+		} else {
+			heap.Remove(&q.waiting, r.index)
+			q.mu.Unlock()
+		}
+		return nil, ctx.Err()
+	}
+}
+
+// Requests returns the running requests, then the waiting requests in the order of priority.
+func (q *ProofQueue) Requests() []ProofRequest {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	waiting := make([]*queuedRequest, len(q.waiting))
+	copy(waiting, q.waiting)
+	sort.Slice(waiting, func(i, j int) bool { return waiting[i].before(&waiting[j].ProofRequest) })
+
+	requests := make([]ProofRequest, 0, len(q.running)+len(waiting))
+	for _, r := range q.running {
+		requests = append(requests, r.ProofRequest)
+	}
+	for _, r := range waiting {
+		requests = append(requests, r.ProofRequest)
+	}
+	return requests
+}
+
+// dispatch runs the waiting requests in the order of priority while there is a free slot.
+// It must be called with the lock held.
+func (q *ProofQueue) dispatch() {
+	for len(q.running) < q.slots && q.waiting.Len() > 0 {
+		r := heap.Pop(&q.waiting).(*queuedRequest)
+		r.Running = true
+		q.running = append(q.running, r)
+		close(r.ready)
+	}
+}
+
+func (q *ProofQueue) release(r *queuedRequest) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	for i, running := range q.running {
+		if running == r {
+			q.running = append(q.running[:i], q.running[i+1:]...)
+			break
+		}
+	}
+	q.dispatch()
+}
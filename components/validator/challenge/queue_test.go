@@ -0,0 +1,65 @@
+package challenge
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestProofQueuePriority(t *testing.T) {
+	q := NewProofQueue(1)
+	now := time.Now()
+
+	release, err := q.Acquire(context.Background(), ProofRequest{BlockNumber: 1})
+	require.NoError(t, err)
+
+	order := make(chan uint64, 3)
+	requests := []ProofRequest{
+		{BlockNumber: 2, Speculative: true},
+		{BlockNumber: 3, Deadline: now.Add(2 * time.Hour)},
+		{BlockNumber: 4, Deadline: now.Add(time.Hour)},
+	}
+	for _, req := range requests {
+		go func(req ProofRequest) {
+			release, err := q.Acquire(context.Background(), req)
+			require.NoError(t, err)
+			order <- req.BlockNumber
+			release()
+		}(req)
+	}
+	require.Eventually(t, func() bool { return len(q.Requests()) == 4 }, time.Second, time.Millisecond)
+
+	queued := q.Requests()
+	require.True(t, queued[0].Running)
+	require.Equal(t, []uint64{1, 4, 3, 2}, []uint64{queued[0].BlockNumber, queued[1].BlockNumber, queued[2].BlockNumber, queued[3].BlockNumber})
+
+	release()
+	require.Equal(t, uint64(4), <-order)
+	require.Equal(t, uint64(3), <-order)
+	require.Equal(t, uint64(2), <-order)
+	require.Empty(t, q.Requests())
+}
+
+func TestProofQueueCancel(t *testing.T) {
+	q := NewProofQueue(1)
+
+	release, err := q.Acquire(context.Background(), ProofRequest{BlockNumber: 1})
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = q.Acquire(ctx, ProofRequest{BlockNumber: 2})
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.Len(t, q.Requests(), 1)
+
+	release()
+	// releasing twice has no effect
+	release()
+	require.Empty(t, q.Requests())
+
+	release, err = q.Acquire(context.Background(), ProofRequest{BlockNumber: 3})
+	require.NoError(t, err)
+	release()
+}
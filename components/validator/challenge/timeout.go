@@ -0,0 +1,75 @@
+package challenge
+
+import (
+	"math"
+	"sort"
+	"sync"
+	"time"
+)
+
+const (
+	// adaptiveTimeoutSamples is the number of the recent proving times to adapt the timeout to.
+	adaptiveTimeoutSamples = 32
+	// adaptiveTimeoutMinSamples is the number of the proving times to observe before adapting the timeout.
+	adaptiveTimeoutMinSamples = 5
+	// adaptiveTimeoutQuantile is the quantile of the proving times that the timeout is based on.
+	adaptiveTimeoutQuantile = 0.95
+	// adaptiveTimeoutFactor is the margin of the timeout over the quantile of the proving times.
+	adaptiveTimeoutFactor = 2
+	// MinAdaptiveTimeout is the lower bound of the adaptive timeout.
+	MinAdaptiveTimeout = 5 * time.Minute
+)
+
+// adaptiveTimeout adapts the timeout of the proof requests to the distribution of the recent proving times.
+// The timeout is twice the 95th percentile of the recent proving times, bounded by MinAdaptiveTimeout and
+// the configured timeout. The configured timeout is used until enough proving times are observed.
+type adaptiveTimeout struct {
+	mu      sync.Mutex
+	max     time.Duration
+	samples []time.Duration
+	next    int
+}
+
+func newAdaptiveTimeout(max time.Duration) *adaptiveTimeout {
+	return &adaptiveTimeout{
+		max:     max,
+		samples: make([]time.Duration, 0, adaptiveTimeoutSamples),
+	}
+}
+
+// observe adds the proving time, replacing the oldest one if the window is full.
+func (a *adaptiveTimeout) observe(d time.Duration) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if len(a.samples) < adaptiveTimeoutSamples {
+		a.samples = append(a.samples, d)
+		return
+	}
+	a.samples[a.next] = d
+	a.next = (a.next + 1) % adaptiveTimeoutSamples
+}
+
+// timeout returns the timeout of the next proof request.
+func (a *adaptiveTimeout) timeout() time.Duration {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if len(a.samples) < adaptiveTimeoutMinSamples {
+		return a.max
+	}
+
+	sorted := make([]time.Duration, len(a.samples))
+	copy(sorted, a.samples)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	idx := int(math.Ceil(adaptiveTimeoutQuantile*float64(len(sorted)))) - 1
+	timeout := sorted[idx] * adaptiveTimeoutFactor
+	if timeout < MinAdaptiveTimeout {
+		timeout = MinAdaptiveTimeout
+	}
+	if timeout > a.max {
+		timeout = a.max
+	}
+	return timeout
+}
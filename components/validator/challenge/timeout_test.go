@@ -0,0 +1,39 @@
+package challenge
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdaptiveTimeout(t *testing.T) {
+	a := newAdaptiveTimeout(2 * time.Hour)
+
+	// the configured timeout is used until enough proving times are observed
+	for i := 0; i < adaptiveTimeoutMinSamples-1; i++ {
+		a.observe(10 * time.Minute)
+	}
+	require.Equal(t, 2*time.Hour, a.timeout())
+
+	a.observe(10 * time.Minute)
+	require.Equal(t, 20*time.Minute, a.timeout())
+
+	// bounded by the min adaptive timeout
+	a = newAdaptiveTimeout(2 * time.Hour)
+	for i := 0; i < adaptiveTimeoutMinSamples; i++ {
+		a.observe(time.Second)
+	}
+	require.Equal(t, MinAdaptiveTimeout, a.timeout())
+
+	// bounded by the configured timeout
+	a.observe(3 * time.Hour)
+	require.Equal(t, 2*time.Hour, a.timeout())
+
+	// the oldest proving times are replaced
+	for i := 0; i < adaptiveTimeoutSamples; i++ {
+		a.observe(30 * time.Minute)
+	}
+	require.Len(t, a.samples, adaptiveTimeoutSamples)
+	require.Equal(t, time.Hour, a.timeout())
+}
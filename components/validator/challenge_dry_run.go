@@ -0,0 +1,107 @@
+package validator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	chal "github.com/kroma-network/kroma/components/validator/challenge"
+)
+
+// DryRunResult is the result of a challenge simulated locally by DryRun.
+type DryRunResult struct {
+	OutputIndex *big.Int
+	// Valid is true if the submitted output is equal to the local output.
+	Valid bool
+	// Turns is the number of bisection turns until the fault block is found.
+	Turns uint8
+	// FaultBlockNumber is the block number of the last output both sides agree on.
+	// The block after it is proven.
+	FaultBlockNumber uint64
+
+	BisectionDuration   time.Duration
+	PublicInputDuration time.Duration
+	WitnessDuration     time.Duration
+	ProofDuration       time.Duration
+}
+
+// DryRun simulates the challenge of the output at the given index locally, without sending any transaction.
+// The segments of every turn are built from the rollup node, and the asserter is assumed to agree on all the segments
+// but the last one, which is the longest bisection. The proof of the fault block is requested from the provers,
+// so that the prover setup is validated and the proving time is measured.
+// The proof cache is not used, to measure the proving time.
+func (c *Challenger) DryRun(ctx context.Context, outputIndex *big.Int) (*DryRunResult, error) {
+	if c.cfg.ProofBackend == nil {
+		return nil, errors.New("no prover is configured")
+	}
+
+	outputs, err := c.OutputsAtIndex(ctx, outputIndex)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get outputs(outputIndex: %d): %w", outputIndex, err)
+	}
+	if IsOutputDeleted(outputs.RemoteOutput.OutputRoot) {
+		return nil, fmt.Errorf("output is already deleted(outputIndex: %d)", outputIndex)
+	}
+
+	result := &DryRunResult{
+		OutputIndex: outputIndex,
+		Valid:       c.ValidateOutput(outputIndex, outputs) == nil,
+	}
+
+	start := outputs.RemoteOutput.L2BlockNumber.Uint64() - c.submissionInterval.Uint64()
+	size := c.submissionInterval.Uint64()
+
+	bisectionStart := time.Now()
+	for turn := uint8(1); ; turn++ {
+		segments, err := c.BuildSegments(ctx, turn, start, size)
+		if err != nil {
+			return nil, err
+		}
+		c.log.Info("built segments", "turn", turn, "start", segments.Start, "size", segments.Size, "degree", segments.Degree)
+		result.Turns = turn
+
+		// the fault is assumed to be in the last section
+		position := uint64(len(segments.Hashes)) - 2
+		if segments.Degree <= 1 {
+			result.FaultBlockNumber = segments.Start + position*segments.Degree
+			break
+		}
+		start, size = segments.NextSegmentsRange(position)
+	}
+	result.BisectionDuration = time.Since(bisectionStart)
+
+	publicInputStart := time.Now()
+	if _, err := c.PublicInputProof(ctx, result.FaultBlockNumber); err != nil {
+		return nil, fmt.Errorf("failed to get public input proof(fault position blockNumber: %d): %w", result.FaultBlockNumber, err)
+	}
+	result.PublicInputDuration = time.Since(publicInputStart)
+
+	targetBlockNumber := new(big.Int).SetUint64(result.FaultBlockNumber + 1)
+	witnessStart := time.Now()
+	traceBz, err := c.fetchWitness(ctx, targetBlockNumber, common.Hash{})
+	if err != nil {
+		return nil, err
+	}
+	result.WitnessDuration = time.Since(witnessStart)
+
+	proofStart := time.Now()
+	release, err := c.proofQueue.Acquire(ctx, chal.ProofRequest{
+		OutputIndex: outputIndex.Uint64(),
+		BlockNumber: targetBlockNumber.Uint64(),
+		Speculative: true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to wait in proof queue(fault position blockNumber: %d): %w", targetBlockNumber, err)
+	}
+	defer release()
+	if _, err := c.cfg.ProofBackend.FetchProofAndPair(ctx, string(traceBz)); err != nil {
+		return nil, fmt.Errorf("failed to fetch proof and pair(fault position blockNumber: %d): %w", targetBlockNumber, err)
+	}
+	result.ProofDuration = time.Since(proofStart)
+
+	return result, nil
+}
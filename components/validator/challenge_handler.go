@@ -0,0 +1,249 @@
+package validator
+
+import (
+	"math/big"
+	"sort"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/kroma-network/kroma/bindings/bindings"
+	chal "github.com/kroma-network/kroma/components/validator/challenge"
+	"github.com/kroma-network/kroma/components/validator/rpc"
+)
+
+// challengeKey identifies a challenge, as there is at most one challenge per output index and challenger.
+type challengeKey struct {
+	outputIndex uint64
+	challenger  common.Address
+}
+
+// challengeHandler is the state of a related challenge that is handled. Each poll, the handler takes
+// a step on the challenge according to the status of the challenge and the role of the validator.
+type challengeHandler struct {
+	outputIndex  *big.Int
+	asserter     common.Address
+	challenger   common.Address
+	isAsserter   bool
+	isChallenger bool
+	// status is the challenge status observed at the last step, guarded by Challenger.challengesMu
+	status uint8
+}
+
+// startChallengeHandler starts handling the challenge in the background, unless the challenge is handled already.
+func (c *Challenger) startChallengeHandler(outputIndex *big.Int, asserter common.Address, challenger common.Address) {
+	key := challengeKey{outputIndex: outputIndex.Uint64(), challenger: challenger}
+
+	c.challengesMu.Lock()
+	defer c.challengesMu.Unlock()
+	if _, ok := c.challenges[key]; ok {
+		c.log.Debug("challenge is already handled", "outputIndex", outputIndex, "challenger", challenger)
+		return
+	}
+	h := &challengeHandler{
+		outputIndex:  new(big.Int).Set(outputIndex),
+		asserter:     asserter,
+		challenger:   challenger,
+		isAsserter:   c.cfg.txManagerOf(asserter) != nil,
+		isChallenger: c.cfg.txManagerOf(challenger) != nil,
+		status:       chal.StatusNone,
+	}
+	c.challenges[key] = h
+
+	c.wg.Add(1)
+	go c.handleChallenge(key, h)
+}
+
+// handleChallenge handles related challenge according to its status and role when challenge created.
+// The challenges are handled in parallel, but each step on a challenge takes a worker from the worker pool,
+// which bounds the number of concurrent steps.
+func (c *Challenger) handleChallenge(key challengeKey, h *challengeHandler) {
+	c.log.Info("handling related challenge", "outputIndex", h.outputIndex, "asserter", h.asserter, "challenger", h.challenger)
+	defer c.wg.Done()
+	defer func() {
+		c.challengesMu.Lock()
+		delete(c.challenges, key)
+		c.challengesMu.Unlock()
+	}()
+
+	ticker := time.NewTicker(c.cfg.ChallengerPollInterval)
+	defer ticker.Stop()
+
+	for ; ; <-ticker.C {
+		queued := time.Now()
+		select {
+		case <-c.ctx.Done():
+			return
+		case c.workers <- struct{}{}:
+		}
+		c.metr.RecordChallengeQueueWait(time.Since(queued))
+		if c.paused.Load() {
+			<-c.workers
+			c.log.Debug("challenger is paused, not handling challenge", "outputIndex", h.outputIndex, "challenger", h.challenger)
+			continue
+		}
+		done := c.stepChallenge(h)
+		<-c.workers
+		if done {
+			return
+		}
+	}
+}
+
+// stepChallenge takes the next step on the challenge. It returns true if the challenge does not need to be handled anymore.
+func (c *Challenger) stepChallenge(h *challengeHandler) bool {
+	// check the status of challenge
+	status, err := c.GetChallengeStatus(c.ctx, h.outputIndex, h.challenger)
+	if err != nil {
+		c.log.Error("unable to get challenge status", "err", err, "outputIndex", h.outputIndex, "challenger", h.challenger)
+		return false
+	}
+	if status != h.status {
+		c.log.Info("challenge status changed", "outputIndex", h.outputIndex, "challenger", h.challenger, "from", h.status, "to", status)
+		c.challengesMu.Lock()
+		h.status = status
+		c.challengesMu.Unlock()
+	}
+	// if challenge is not in progress, terminate handling
+	if status == chal.StatusNone {
+		c.log.Info("challenge is not in progress", "outputIndex", h.outputIndex, "challenger", h.challenger)
+		return true
+	}
+
+	outputs, err := c.OutputsAtIndex(c.ctx, h.outputIndex)
+	if err != nil {
+		c.log.Error("unable to get outputs when handling challenge", "err", err, "outputIndex", h.outputIndex)
+		return false
+	}
+	isOutputDeleted := IsOutputDeleted(outputs.RemoteOutput.OutputRoot)
+
+	isOutputFinalized, err := c.IsOutputFinalized(c.ctx, h.outputIndex)
+	if err != nil {
+		c.log.Error("unable to get if output is finalized when handling challenge", "err", err, "outputIndex", h.outputIndex)
+		return false
+	}
+
+	// if asserter
+	if h.isAsserter {
+		// if output is already deleted, asserter has no incentives to handle challenge any further
+		if isOutputDeleted {
+			c.log.Info("do nothing because output is already deleted", "outputIndex", h.outputIndex, "challenger", h.challenger)
+			return true
+		}
+		// if output is already finalized and not `ChallengerTimeout` status, terminate handling
+		if isOutputFinalized && status != chal.StatusChallengerTimeout {
+			c.log.Info("output is already finalized when handling challenge", "outputIndex", h.outputIndex, "challenger", h.challenger)
+			return true
+		}
+		switch status {
+		case chal.StatusAsserterTurn:
+			tx, err := c.Bisect(c.ctx, h.outputIndex, h.challenger)
+			if err != nil {
+				c.log.Error("failed to create bisect tx", "err", bindings.WrapRevertError(err), "outputIndex", h.outputIndex, "challenger", h.challenger)
+				return false
+			}
+			if err := c.submitChallengeTx(h.asserter, tx); err != nil {
+				c.log.Error("failed to submit bisect tx", "err", err, "outputIndex", h.outputIndex, "challenger", h.challenger)
+				return false
+			}
+		case chal.StatusChallengerTimeout:
+			// call challenger timeout to increase bond from pending bond
+			tx, err := c.ChallengerTimeout(c.ctx, h.outputIndex, h.challenger)
+			if err != nil {
+				c.log.Error("failed to create challenger timeout tx", "err", bindings.WrapRevertError(err), "outputIndex", h.outputIndex, "challenger", h.challenger)
+				return false
+			}
+			if err := c.submitChallengeTx(h.asserter, tx); err != nil {
+				c.log.Error("failed to submit challenger timeout tx", "err", err, "outputIndex", h.outputIndex, "challenger", h.challenger)
+				return false
+			}
+		}
+	}
+
+	// if challenger
+	if h.isChallenger && c.cfg.ChallengerEnabled {
+		// if output has been already deleted, cancel challenge to refund pending bond
+		if isOutputDeleted && status != chal.StatusChallengerTimeout {
+			tx, err := c.CancelChallenge(c.ctx, h.outputIndex, h.challenger)
+			if err != nil {
+				c.log.Error("failed to create cancel challenge tx", "err", bindings.WrapRevertError(err), "outputIndex", h.outputIndex)
+				return false
+			}
+			if err := c.submitChallengeTx(h.challenger, tx); err != nil {
+				c.log.Error("failed to submit cancel challenge tx", "err", err, "outputIndex", h.outputIndex)
+				return false
+			}
+		}
+
+		// if output is already finalized, terminate handling
+		if isOutputFinalized {
+			c.log.Info("output is already finalized when handling challenge", "outputIndex", h.outputIndex)
+			return true
+		}
+
+		// Challenger doesn't need to check if output is already deleted or not. Because when trying to bisect or prove fault with deleted output index,
+		// the contract automatically cancels the challenge.
+		switch status {
+		case chal.StatusChallengerTurn:
+			tx, err := c.Bisect(c.ctx, h.outputIndex, h.challenger)
+			if err != nil {
+				c.log.Error("failed to create bisect tx", "err", bindings.WrapRevertError(err), "outputIndex", h.outputIndex)
+				return false
+			}
+			if err := c.submitChallengeTx(h.challenger, tx); err != nil {
+				c.log.Error("failed to submit bisect tx", "err", err, "outputIndex", h.outputIndex)
+				return false
+			}
+		case chal.StatusAsserterTimeout, chal.StatusReadyToProve:
+			skipSelectFaultPosition := status == chal.StatusAsserterTimeout
+			tx, err := c.ProveFault(c.ctx, h.outputIndex, h.challenger, skipSelectFaultPosition)
+			if err != nil {
+				c.log.Error("failed to create prove fault tx", "err", bindings.WrapRevertError(err), "outputIndex", h.outputIndex)
+				return false
+			}
+			if err := c.submitChallengeTx(h.challenger, tx); err != nil {
+				c.log.Error("failed to submit prove fault tx", "err", err, "outputIndex", h.outputIndex)
+				return false
+			}
+		}
+	}
+	return false
+}
+
+// ActiveChallenges returns the related challenges that are handled.
+func (c *Challenger) ActiveChallenges() []rpc.ChallengeStatus {
+	c.challengesMu.Lock()
+	defer c.challengesMu.Unlock()
+	out := make([]rpc.ChallengeStatus, 0, len(c.challenges))
+	for _, h := range c.challenges {
+		out = append(out, rpc.ChallengeStatus{
+			OutputIndex: h.outputIndex.Uint64(),
+			Asserter:    h.asserter,
+			Challenger:  h.challenger,
+			Status:      h.status,
+		})
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].OutputIndex < out[j].OutputIndex })
+	return out
+}
+
+// ProofQueue returns the running proof requests, then the waiting ones in the order of priority.
+func (c *Challenger) ProofQueue() []rpc.ProofRequestStatus {
+	requests := c.proofQueue.Requests()
+	out := make([]rpc.ProofRequestStatus, 0, len(requests))
+	for _, r := range requests {
+		var deadline uint64
+		if !r.Deadline.IsZero() {
+			deadline = uint64(r.Deadline.Unix())
+		}
+		out = append(out, rpc.ProofRequestStatus{
+			OutputIndex: r.OutputIndex,
+			BlockNumber: r.BlockNumber,
+			Deadline:    deadline,
+			Speculative: r.Speculative,
+			EnqueuedAt:  uint64(r.EnqueuedAt.Unix()),
+			Running:     r.Running,
+		})
+	}
+	return out
+}
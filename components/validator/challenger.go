@@ -8,10 +8,12 @@ import (
 	"fmt"
 	"math/big"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/math"
 	"github.com/ethereum/go-ethereum/core/types"
@@ -28,10 +30,6 @@ import (
 
 var deletedOutputRoot = [32]byte{}
 
-type ProofFetcher interface {
-	FetchProofAndPair(ctx context.Context, trace string) (*chal.ProofAndPair, error)
-}
-
 type Challenger struct {
 	log    log.Logger
 	cfg    Config
@@ -60,10 +58,26 @@ type Challenger struct {
 	l2OutputSubmittedEventChan chan *bindings.L2OutputOracleOutputSubmitted
 	challengeCreatedEventChan  chan *bindings.ColosseumChallengeCreated
 
+	// challenges are the related challenges that are handled, to not handle the same challenge twice
+	challenges   map[challengeKey]*challengeHandler
+	challengesMu sync.Mutex
+	// workers bounds the number of concurrent steps on the handled challenges
+	workers chan struct{}
+	// paused is set while the participation in challenges is paused through the admin API
+	paused atomic.Bool
+	// pendingProofs is the number of proofs that are requested from the provers and not returned yet
+	pendingProofs atomic.Int32
+	// proofQueue bounds the number of proofs requested concurrently, prioritizing the challenges nearing their deadline
+	proofQueue *chal.ProofQueue
+
 	wg sync.WaitGroup
 }
 
 func NewChallenger(ctx context.Context, cfg Config, l log.Logger, m metrics.Metricer) (*Challenger, error) {
+	if cfg.ChallengerMaxConcurrency < 1 {
+		cfg.ChallengerMaxConcurrency = 1
+	}
+
 	colosseumContract, err := bindings.NewColosseum(cfg.ColosseumAddr, cfg.L1Client)
 	if err != nil {
 		return nil, err
@@ -135,14 +149,23 @@ func NewChallenger(ctx context.Context, cfg Config, l log.Logger, m metrics.Metr
 		finalizationPeriodSeconds: finalizationPeriodSeconds,
 		l2BlockTime:               l2BlockTime,
 		requiredBondAmount:        requiredBondAmount,
+
+		challenges: make(map[challengeKey]*challengeHandler),
+		workers:    make(chan struct{}, cfg.ChallengerMaxConcurrency),
+		proofQueue: chal.NewProofQueue(cfg.ProverMaxConcurrency),
 	}, nil
 }
 
+// watchOutputs returns true if the submitted outputs are validated, in challenger or watcher mode.
+func (c *Challenger) watchOutputs() bool {
+	return c.cfg.ChallengerEnabled || c.cfg.WatcherEnabled
+}
+
 // initSub initialize subscriptions
 func (c *Challenger) initSub() {
 	opts := utils.NewSimpleWatchOpts(c.ctx)
 
-	if c.cfg.ChallengerEnabled {
+	if c.watchOutputs() {
 		c.l2OutputSubmittedEventChan = make(chan *bindings.L2OutputOracleOutputSubmitted)
 		c.l2OutputSubmittedSub = event.ResubscribeErr(time.Second*10, func(ctx context.Context, err error) (event.Subscription, error) {
 			if err != nil {
@@ -152,6 +175,11 @@ func (c *Challenger) initSub() {
 		})
 	}
 
+	// the watcher is never involved in challenges
+	if c.cfg.WatcherEnabled {
+		return
+	}
+
 	c.challengeCreatedEventChan = make(chan *bindings.ColosseumChallengeCreated)
 	c.challengeCreatedSub = event.ResubscribeErr(time.Second*10, func(ctx context.Context, err error) (event.Subscription, error) {
 		if err != nil {
@@ -176,7 +204,9 @@ func (c *Challenger) Stop() error {
 	if c.l2OutputSubmittedSub != nil {
 		c.l2OutputSubmittedSub.Unsubscribe()
 	}
-	c.challengeCreatedSub.Unsubscribe()
+	if c.challengeCreatedSub != nil {
+		c.challengeCreatedSub.Unsubscribe()
+	}
 
 	c.cancel()
 	c.wg.Wait()
@@ -184,7 +214,9 @@ func (c *Challenger) Stop() error {
 	if c.l2OutputSubmittedEventChan != nil {
 		close(c.l2OutputSubmittedEventChan)
 	}
-	close(c.challengeCreatedEventChan)
+	if c.challengeCreatedEventChan != nil {
+		close(c.challengeCreatedEventChan)
+	}
 
 	return nil
 }
@@ -200,7 +232,7 @@ func (c *Challenger) loop() {
 		case <-c.ctx.Done():
 			return
 		default:
-			if c.cfg.ChallengerEnabled {
+			if c.watchOutputs() {
 				if err := c.updateCheckpoint(); err != nil {
 					c.log.Error(err.Error())
 					continue
@@ -212,15 +244,17 @@ func (c *Challenger) loop() {
 				continue
 			}
 
-			// if challenge mode on, subscribe L2 output submission events
-			if c.cfg.ChallengerEnabled {
+			// if challenge or watcher mode on, subscribe L2 output submission events
+			if c.watchOutputs() {
 				c.wg.Add(1)
 				go c.subscribeL2OutputSubmitted()
 			}
 
 			// subscribe challenge creation events
-			c.wg.Add(1)
-			go c.subscribeChallengeCreated()
+			if !c.cfg.WatcherEnabled {
+				c.wg.Add(1)
+				go c.subscribeChallengeCreated()
+			}
 
 			return
 		}
@@ -265,11 +299,17 @@ func (c *Challenger) scanPrevOutputs() error {
 	outputSubmittedEvent := c.l2ooABI.Events[KeyEventOutputSubmitted]
 	challengeCreatedEvent := c.colosseumABI.Events[KeyEventChallengeCreated]
 
-	addresses := []common.Address{c.cfg.ColosseumAddr}
-	topics := []common.Hash{challengeCreatedEvent.ID}
+	var addresses []common.Address
+	var topics []common.Hash
 
-	// scan OutputSubmittedEvents only when challenger mode is on
-	if c.cfg.ChallengerEnabled {
+	// scan ChallengeCreatedEvents only when not in watcher mode
+	if !c.cfg.WatcherEnabled {
+		addresses = append(addresses, c.cfg.ColosseumAddr)
+		topics = append(topics, challengeCreatedEvent.ID)
+	}
+
+	// scan OutputSubmittedEvents only when challenger or watcher mode is on
+	if c.watchOutputs() {
 		addresses = append(addresses, c.cfg.L2OutputOracleAddr)
 		topics = append(topics, outputSubmittedEvent.ID)
 	}
@@ -298,8 +338,7 @@ func (c *Challenger) scanPrevOutputs() error {
 		case c.cfg.ColosseumAddr:
 			ev := NewChallengeCreatedEvent(vLog)
 			if ev.OutputIndex.Sign() == 1 && c.isRelatedChallenge(ev.Asserter, ev.Challenger) {
-				c.wg.Add(1)
-				go c.handleChallenge(ev.OutputIndex, ev.Asserter, ev.Challenger)
+				c.startChallengeHandler(ev.OutputIndex, ev.Asserter, ev.Challenger)
 			}
 		default:
 			c.log.Warn("unknown event log", "logs", vLog)
@@ -311,8 +350,8 @@ func (c *Challenger) scanPrevOutputs() error {
 
 // subscribeL2OutputSubmitted subscribes the OutputSubmitted event from L2OutputOracle contract.
 // It handles all the outputs between the checkpoint output index and the output index from the watched event.
-// If the L2 output root is invalid, create challenge.
-// This function should be called only when challenger mode is on.
+// If the L2 output root is invalid, create challenge, or only report it in watcher mode.
+// This function should be called only when challenger or watcher mode is on.
 func (c *Challenger) subscribeL2OutputSubmitted() {
 	defer c.wg.Done()
 
@@ -349,8 +388,7 @@ func (c *Challenger) subscribeChallengeCreated() {
 			c.log.Info("watched challenge created event", "outputIndex", ev.OutputIndex, "challenger", ev.Challenger)
 			// when challenge created, handle it
 			if ev.OutputIndex.Sign() == 1 && c.isRelatedChallenge(ev.Asserter, ev.Challenger) {
-				c.wg.Add(1)
-				go c.handleChallenge(ev.OutputIndex, ev.Asserter, ev.Challenger)
+				c.startChallengeHandler(ev.OutputIndex, ev.Asserter, ev.Challenger)
 			}
 		case <-c.ctx.Done():
 			return
@@ -359,7 +397,8 @@ func (c *Challenger) subscribeChallengeCreated() {
 }
 
 // handleOutput handles output when output submitted, creates challenge if the output is invalid.
-// This function should be called only when challenger mode is on.
+// In watcher mode, the invalid output is only reported.
+// This function should be called only when challenger or watcher mode is on.
 func (c *Challenger) handleOutput(outputIndex *big.Int) {
 	c.log.Info("handling output to detect invalid output", "outputIndex", outputIndex)
 	defer c.wg.Done()
@@ -394,6 +433,7 @@ func (c *Challenger) handleOutput(outputIndex *big.Int) {
 			// if output is valid, terminate handling
 			if outputRange == nil {
 				c.log.Info("output is validated", "outputIndex", outputIndex)
+				c.metr.RecordOutputValidated(outputIndex)
 				return
 			}
 
@@ -403,8 +443,19 @@ func (c *Challenger) handleOutput(outputIndex *big.Int) {
 				return
 			}
 
-			// check the status of my challenge
-			status, err := c.GetChallengeStatus(c.ctx, outputIndex, c.cfg.TxManager.From())
+			c.metr.RecordInvalidOutput(outputIndex)
+
+			// in watcher mode, only report the invalid output
+			if c.cfg.WatcherEnabled {
+				c.log.Error("found invalid output, not challenging in watcher mode", "outputIndex", outputIndex,
+					"blockNumber", outputs.RemoteOutput.L2BlockNumber,
+					"local", outputs.LocalOutput.OutputRoot, "invalid", common.BytesToHash(outputs.RemoteOutput.OutputRoot[:]))
+				return
+			}
+
+			// check the status of my challenge, with the key to challenge the output with
+			from := c.cfg.txManagerAt(outputRange.EndBlock).From()
+			status, err := c.GetChallengeStatus(c.ctx, outputIndex, from)
 			if err != nil {
 				c.log.Error("unable to get challenge status", "err", err, "outputIndex", outputIndex)
 				continue
@@ -415,7 +466,7 @@ func (c *Challenger) handleOutput(outputIndex *big.Int) {
 				return
 			}
 
-			hasEnoughDeposit, err := c.HasEnoughDeposit(c.ctx)
+			hasEnoughDeposit, err := c.HasEnoughDeposit(c.ctx, from)
 			if err != nil {
 				c.log.Error(err.Error())
 				continue
@@ -424,14 +475,19 @@ func (c *Challenger) handleOutput(outputIndex *big.Int) {
 				continue
 			}
 
+			if c.paused.Load() {
+				c.log.Warn("found invalid output, but challenger is paused", "outputIndex", outputIndex)
+				continue
+			}
+
 			// if all of the above conditions are satisfied, create a new challenge
 			tx, err := c.CreateChallenge(c.ctx, outputRange)
 			if err != nil {
-				c.log.Error("failed to create createChallenge tx", "err", err, "outputIndex", outputIndex)
+				c.log.Error("failed to create createChallenge tx", "err", bindings.WrapRevertError(err), "outputIndex", outputIndex)
 				continue
 			}
 
-			if err := c.submitChallengeTx(tx); err != nil {
+			if err := c.submitChallengeTx(from, tx); err != nil {
 				c.log.Error("failed to submit create challenge tx", "err", err, "outputIndex", outputIndex)
 				continue
 			}
@@ -442,144 +498,49 @@ func (c *Challenger) handleOutput(outputIndex *big.Int) {
 	}
 }
 
-// handleChallenge handles related challenge according to its status and role when challenge created.
-func (c *Challenger) handleChallenge(outputIndex *big.Int, asserter common.Address, challenger common.Address) {
-	c.log.Info("handling related challenge", "outputIndex", outputIndex, "asserter", asserter, "challenger", challenger)
-	defer c.wg.Done()
-
-	isAsserter := asserter == c.cfg.TxManager.From()
-	isChallenger := challenger == c.cfg.TxManager.From()
-
-	ticker := time.NewTicker(c.cfg.ChallengerPollInterval)
-	defer ticker.Stop()
-
-	for ; ; <-ticker.C {
-		select {
-		case <-c.ctx.Done():
-			return
-		default:
-			// check the status of challenge
-			status, err := c.GetChallengeStatus(c.ctx, outputIndex, challenger)
-			if err != nil {
-				c.log.Error("unable to get challenge status", "err", err, "outputIndex", outputIndex, "challenger", challenger)
-				continue
-			}
-			// if challenge is not in progress, terminate handling
-			if status == chal.StatusNone {
-				c.log.Info("challenge is not in progress", "outputIndex", outputIndex, "challenger", challenger)
-				return
-			}
-
-			outputs, err := c.OutputsAtIndex(c.ctx, outputIndex)
-			if err != nil {
-				c.log.Error("unable to get outputs when handling challenge", "err", err, "outputIndex", outputIndex)
-				continue
-			}
-			isOutputDeleted := IsOutputDeleted(outputs.RemoteOutput.OutputRoot)
-
-			isOutputFinalized, err := c.IsOutputFinalized(c.ctx, outputIndex)
-			if err != nil {
-				c.log.Error("unable to get if output is finalized when handling challenge", "err", err, "outputIndex", outputIndex)
-				continue
-			}
+// Pause pauses the creation of challenges and the steps on the related challenges.
+func (c *Challenger) Pause() {
+	c.paused.Store(true)
+}
 
-			// if asserter
-			if isAsserter {
-				// if output is already deleted, asserter has no incentives to handle challenge any further
-				if isOutputDeleted {
-					c.log.Info("do nothing because output is already deleted", "outputIndex", outputIndex, "challenger", challenger)
-					return
-				}
-				// if output is already finalized and not `ChallengerTimeout` status, terminate handling
-				if isOutputFinalized && status != chal.StatusChallengerTimeout {
-					c.log.Info("output is already finalized when handling challenge", "outputIndex", outputIndex, "challenger", challenger)
-					return
-				}
-				switch status {
-				case chal.StatusAsserterTurn:
-					tx, err := c.Bisect(c.ctx, outputIndex, challenger)
-					if err != nil {
-						c.log.Error("failed to create bisect tx", "err", err, "outputIndex", outputIndex, "challenger", challenger)
-						continue
-					}
-					if err := c.submitChallengeTx(tx); err != nil {
-						c.log.Error("failed to submit bisect tx", "err", err, "outputIndex", outputIndex, "challenger", challenger)
-						continue
-					}
-				case chal.StatusChallengerTimeout:
-					// call challenger timeout to increase bond from pending bond
-					tx, err := c.ChallengerTimeout(c.ctx, outputIndex, challenger)
-					if err != nil {
-						c.log.Error("failed to create challenger timeout tx", "err", err, "outputIndex", outputIndex, "challenger", challenger)
-						continue
-					}
-					if err := c.submitChallengeTx(tx); err != nil {
-						c.log.Error("failed to submit challenger timeout tx", "err", err, "outputIndex", outputIndex, "challenger", challenger)
-						continue
-					}
-				}
-			}
+// Resume resumes the participation in challenges after a pause.
+func (c *Challenger) Resume() {
+	c.paused.Store(false)
+}
 
-			// if challenger
-			if isChallenger && c.cfg.ChallengerEnabled {
-				// if output has been already deleted, cancel challenge to refund pending bond
-				if isOutputDeleted && status != chal.StatusChallengerTimeout {
-					tx, err := c.CancelChallenge(c.ctx, outputIndex)
-					if err != nil {
-						c.log.Error("failed to create cancel challenge tx", "err", err, "outputIndex", outputIndex)
-						continue
-					}
-					if err := c.submitChallengeTx(tx); err != nil {
-						c.log.Error("failed to submit cancel challenge tx", "err", err, "outputIndex", outputIndex)
-						continue
-					}
-				}
+// Paused returns true if the participation in challenges is paused.
+func (c *Challenger) Paused() bool {
+	return c.paused.Load()
+}
 
-				// if output is already finalized, terminate handling
-				if isOutputFinalized {
-					c.log.Info("output is already finalized when handling challenge", "outputIndex", outputIndex)
-					return
-				}
+// PendingProofs returns the number of proofs that are requested from the provers and not returned yet.
+func (c *Challenger) PendingProofs() int {
+	return int(c.pendingProofs.Load())
+}
 
-				// Challenger doesn't need to check if output is already deleted or not. Because when trying to bisect or prove fault with deleted output index,
-				// the contract automatically cancels the challenge.
-				switch status {
-				case chal.StatusChallengerTurn:
-					tx, err := c.Bisect(c.ctx, outputIndex, challenger)
-					if err != nil {
-						c.log.Error("failed to create bisect tx", "err", err, "outputIndex", outputIndex)
-						continue
-					}
-					if err := c.submitChallengeTx(tx); err != nil {
-						c.log.Error("failed to submit bisect tx", "err", err, "outputIndex", outputIndex)
-						continue
-					}
-				case chal.StatusAsserterTimeout, chal.StatusReadyToProve:
-					skipSelectFaultPosition := status == chal.StatusAsserterTimeout
-					tx, err := c.ProveFault(c.ctx, outputIndex, challenger, skipSelectFaultPosition)
-					if err != nil {
-						c.log.Error("failed to create prove fault tx", "err", err, "outputIndex", outputIndex)
-						continue
-					}
-					if err := c.submitChallengeTx(tx); err != nil {
-						c.log.Error("failed to submit prove fault tx", "err", err, "outputIndex", outputIndex)
-						continue
-					}
-				}
-			}
-		}
+// submitChallengeTx submits the challenge tx with the key of the given address.
+func (c *Challenger) submitChallengeTx(from common.Address, tx *types.Transaction) error {
+	txMgr := c.cfg.txManagerOf(from)
+	if txMgr == nil {
+		return fmt.Errorf("%s is not a key of the validator", from)
 	}
+	return txMgr.SendTransaction(c.ctx, tx).Err
 }
 
-func (c *Challenger) submitChallengeTx(tx *types.Transaction) error {
-	return c.cfg.TxManager.SendTransaction(c.ctx, tx).Err
+// txOpts returns the options to craft a challenge tx sent from the key of the given address.
+func (c *Challenger) txOpts(ctx context.Context, from common.Address) (*bind.TransactOpts, error) {
+	txMgr := c.cfg.txManagerOf(from)
+	if txMgr == nil {
+		return nil, fmt.Errorf("%s is not a key of the validator", from)
+	}
+	return utils.NewSimpleTxOpts(ctx, txMgr.From(), txMgr.Signer), nil
 }
 
-// HasEnoughDeposit checks if challenger has enough deposit to bond when creating challenge.
-func (c *Challenger) HasEnoughDeposit(ctx context.Context) (bool, error) {
+// HasEnoughDeposit checks if the challenger key of the given address has enough deposit to bond when creating challenge.
+func (c *Challenger) HasEnoughDeposit(ctx context.Context, from common.Address) (bool, error) {
 	cCtx, cCancel := context.WithTimeout(ctx, c.cfg.NetworkTimeout)
 	defer cCancel()
-	balance, err := c.valpoolContract.BalanceOf(utils.NewSimpleCallOpts(cCtx), c.cfg.TxManager.From())
+	balance, err := c.valpoolContract.BalanceOf(utils.NewSimpleCallOpts(cCtx), from)
 	if err != nil {
 		return false, fmt.Errorf("failed to fetch deposit amount: %w", err)
 	}
@@ -726,7 +687,7 @@ func (c *Challenger) ValidateOutput(outputIndex *big.Int, outputs *Outputs) *Out
 }
 
 func (c *Challenger) isRelatedChallenge(asserter common.Address, challenger common.Address) bool {
-	return c.cfg.TxManager.From() == asserter || c.cfg.TxManager.From() == challenger
+	return c.cfg.txManagerOf(asserter) != nil || c.cfg.txManagerOf(challenger) != nil
 }
 
 func (c *Challenger) GetChallengeStatus(ctx context.Context, outputIndex *big.Int, challenger common.Address) (uint8, error) {
@@ -791,7 +752,9 @@ func (c *Challenger) CreateChallenge(ctx context.Context, outputRange *OutputRan
 		return nil, err
 	}
 
-	txOpts := utils.NewSimpleTxOpts(ctx, c.cfg.TxManager.From(), c.cfg.TxManager.Signer)
+	// the challenge is created with the key to submit the output at the same block number with
+	txMgr := c.cfg.txManagerAt(outputRange.EndBlock)
+	txOpts := utils.NewSimpleTxOpts(ctx, txMgr.From(), txMgr.Signer)
 	return c.colosseumContract.CreateChallenge(txOpts, outputIndex, l1BlockHash, l1BlockNumber, segments.Hashes)
 }
 
@@ -820,7 +783,15 @@ func (c *Challenger) Bisect(ctx context.Context, outputIndex *big.Int, challenge
 		return nil, err
 	}
 
-	txOpts := utils.NewSimpleTxOpts(ctx, c.cfg.TxManager.From(), c.cfg.TxManager.Signer)
+	// the asserter and the challenger bisect in turn, the challenger on odd turns
+	from := challenge.Asserter
+	if nextTurn%2 == 1 {
+		from = challenge.Challenger
+	}
+	txOpts, err := c.txOpts(ctx, from)
+	if err != nil {
+		return nil, err
+	}
 	return c.colosseumContract.Bisect(txOpts, outputIndex, challenger, position, nextSegments.Hashes)
 }
 
@@ -831,10 +802,13 @@ func (c *Challenger) ChallengerTimeout(ctx context.Context, outputIndex *big.Int
 	return c.colosseumContract.ChallengerTimeout(txOpts, outputIndex, challenger)
 }
 
-func (c *Challenger) CancelChallenge(ctx context.Context, outputIndex *big.Int) (*types.Transaction, error) {
-	c.log.Info("crafting cancel challenge tx", "outputIndex", outputIndex)
+func (c *Challenger) CancelChallenge(ctx context.Context, outputIndex *big.Int, challenger common.Address) (*types.Transaction, error) {
+	c.log.Info("crafting cancel challenge tx", "outputIndex", outputIndex, "challenger", challenger)
 
-	txOpts := utils.NewSimpleTxOpts(ctx, c.cfg.TxManager.From(), c.cfg.TxManager.Signer)
+	txOpts, err := c.txOpts(ctx, challenger)
+	if err != nil {
+		return nil, err
+	}
 	return c.colosseumContract.CancelChallenge(txOpts, outputIndex)
 }
 
@@ -861,30 +835,27 @@ func (c *Challenger) ProveFault(ctx context.Context, outputIndex *big.Int, chall
 		blockNumber = new(big.Int).Add(blockNumber, position)
 	}
 
+	publicInputStart := time.Now()
 	proof, err := c.PublicInputProof(ctx, blockNumber.Uint64())
 	if err != nil {
 		return nil, fmt.Errorf("failed to get public input proof(fault position blockNumber: %d): %w", blockNumber.Uint64(), err)
 	}
+	c.metr.RecordProverStep(chal.ProverStepPublicInput, time.Since(publicInputStart))
 
 	targetBlockNumber := new(big.Int).Add(blockNumber, common.Big1)
-	cCtx, cCancel := context.WithTimeout(ctx, c.cfg.NetworkTimeout)
-	defer cCancel()
-	trace, err := c.l2Client.GetBlockTraceByNumber(cCtx, targetBlockNumber)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get block trace(fault position blockNumber: %d): %w", targetBlockNumber.Uint64(), err)
-	}
-
-	traceBz, err := json.Marshal(trace)
+	fetchResult, err := c.fetchProof(ctx, targetBlockNumber, chal.ProofRequest{
+		OutputIndex: outputIndex.Uint64(),
+		BlockNumber: targetBlockNumber.Uint64(),
+		Deadline:    time.Unix(int64(challenge.TimeoutAt), 0),
+	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to marshal block trace(fault position blockNumber: %d): %w", targetBlockNumber.Uint64(), err)
+		return nil, err
 	}
 
-	fetchResult, err := c.cfg.ProofFetcher.FetchProofAndPair(ctx, string(traceBz))
+	txOpts, err := c.txOpts(ctx, challenger)
 	if err != nil {
-		return nil, fmt.Errorf("failed to fetch proof and pair(fault position blockNumber: %d): %w", targetBlockNumber.Uint64(), err)
+		return nil, err
 	}
-
-	txOpts := utils.NewSimpleTxOpts(ctx, c.cfg.TxManager.From(), c.cfg.TxManager.Signer)
 	return c.colosseumContract.ProveFault(
 		txOpts,
 		outputIndex,
@@ -897,6 +868,89 @@ func (c *Challenger) ProveFault(ctx context.Context, outputIndex *big.Int, chall
 	)
 }
 
+// fetchProof fetches the proof of the given block from the prover. If the proof cache is enabled,
+// the witness and the proof are looked up in and stored to the cache, keyed by the block and its output root.
+// The proof is requested once the request is taken from the proof queue.
+func (c *Challenger) fetchProof(ctx context.Context, blockNumber *big.Int, req chal.ProofRequest) (*chal.ProofAndPair, error) {
+	var outputRoot common.Hash
+	if c.cfg.ProofCache != nil {
+		output, err := c.OutputAtBlockSafe(ctx, blockNumber.Uint64())
+		if err != nil {
+			return nil, fmt.Errorf("failed to get output(fault position blockNumber: %d): %w", blockNumber.Uint64(), err)
+		}
+		outputRoot = common.Hash(output.OutputRoot)
+
+		proof, err := c.cfg.ProofCache.GetProof(blockNumber.Uint64(), outputRoot)
+		if err != nil {
+			c.log.Warn("failed to get cached proof", "err", err, "blockNumber", blockNumber)
+		} else if proof != nil {
+			c.log.Info("using cached proof", "blockNumber", blockNumber, "outputRoot", outputRoot)
+			return proof, nil
+		}
+	}
+
+	traceBz, err := c.fetchWitness(ctx, blockNumber, outputRoot)
+	if err != nil {
+		return nil, err
+	}
+
+	queued := time.Now()
+	release, err := c.proofQueue.Acquire(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to wait in proof queue(fault position blockNumber: %d): %w", blockNumber.Uint64(), err)
+	}
+	c.metr.RecordProverStep(chal.ProverStepQueue, time.Since(queued))
+
+	c.pendingProofs.Add(1)
+	fetchResult, err := c.cfg.ProofBackend.FetchProofAndPair(ctx, string(traceBz))
+	c.pendingProofs.Add(-1)
+	release()
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch proof and pair(fault position blockNumber: %d): %w", blockNumber.Uint64(), err)
+	}
+
+	if c.cfg.ProofCache != nil {
+		if err := c.cfg.ProofCache.PutProof(blockNumber.Uint64(), outputRoot, fetchResult); err != nil {
+			c.log.Warn("failed to cache proof", "err", err, "blockNumber", blockNumber)
+		}
+	}
+	return fetchResult, nil
+}
+
+// fetchWitness fetches the block trace of the given block, which is the witness to prove the block.
+// The output root is only used as the cache key if the proof cache is enabled.
+func (c *Challenger) fetchWitness(ctx context.Context, blockNumber *big.Int, outputRoot common.Hash) ([]byte, error) {
+	if c.cfg.ProofCache != nil {
+		witness, err := c.cfg.ProofCache.GetWitness(blockNumber.Uint64(), outputRoot)
+		if err != nil {
+			c.log.Warn("failed to get cached witness", "err", err, "blockNumber", blockNumber)
+		} else if witness != nil {
+			return witness, nil
+		}
+	}
+
+	witnessStart := time.Now()
+	cCtx, cCancel := context.WithTimeout(ctx, c.cfg.NetworkTimeout)
+	defer cCancel()
+	trace, err := c.l2Client.GetBlockTraceByNumber(cCtx, blockNumber)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get block trace(fault position blockNumber: %d): %w", blockNumber.Uint64(), err)
+	}
+	c.metr.RecordProverStep(chal.ProverStepWitness, time.Since(witnessStart))
+
+	traceBz, err := json.Marshal(trace)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal block trace(fault position blockNumber: %d): %w", blockNumber.Uint64(), err)
+	}
+
+	if c.cfg.ProofCache != nil {
+		if err := c.cfg.ProofCache.PutWitness(blockNumber.Uint64(), outputRoot, traceBz); err != nil {
+			c.log.Warn("failed to cache witness", "err", err, "blockNumber", blockNumber)
+		}
+	}
+	return traceBz, nil
+}
+
 // IsOutputDeleted checks if the output is deleted.
 func IsOutputDeleted(outputRoot [32]byte) bool {
 	return bytes.Equal(outputRoot[:], deletedOutputRoot[:])
@@ -0,0 +1,64 @@
+package challenge
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/urfave/cli"
+
+	"github.com/kroma-network/kroma/components/validator"
+	"github.com/kroma-network/kroma/components/validator/metrics"
+)
+
+// DryRun simulates the challenge of the given output locally without sending any transaction,
+// to validate the prover setup and to estimate the proving time.
+func DryRun(ctx *cli.Context) error {
+	outputIndex := new(big.Int).SetUint64(ctx.Uint64("output-index"))
+
+	cliCfg := validator.NewCLIConfig(ctx)
+	if len(cliCfg.ProverRPCs) == 0 {
+		return errors.New("ProverRPC is required to dry-run a challenge, but given empty")
+	}
+	// No transaction is sent, so the validator runs as a watcher without a key.
+	cliCfg.OutputSubmitterEnabled = false
+	cliCfg.ChallengerEnabled = false
+	cliCfg.GuardianEnabled = false
+	cliCfg.WatcherEnabled = true
+	cliCfg.KeyRotationPrivateKey = ""
+	cliCfg.PenaltyMonitorEnabled = false
+	cliCfg.RewardSweeperEnabled = false
+	// The proofs are not cached, to measure the proving time.
+	cliCfg.ProofCacheDir = ""
+
+	l := log.New()
+	cfg, err := validator.NewValidatorConfig(cliCfg, l, metrics.NoopMetrics)
+	if err != nil {
+		return fmt.Errorf("unable to create validator config: %w", err)
+	}
+
+	challenger, err := validator.NewChallenger(context.Background(), *cfg, l, metrics.NoopMetrics)
+	if err != nil {
+		return fmt.Errorf("failed to create challenger: %w", err)
+	}
+
+	result, err := challenger.DryRun(context.Background(), outputIndex)
+	if err != nil {
+		return fmt.Errorf("failed to dry-run challenge: %w", err)
+	}
+
+	log.Info("challenge dry-run finished",
+		"outputIndex", result.OutputIndex,
+		"valid", result.Valid,
+		"turns", result.Turns,
+		"faultBlockNumber", result.FaultBlockNumber,
+		"bisection", result.BisectionDuration,
+		"publicInput", result.PublicInputDuration,
+		"witness", result.WitnessDuration,
+		"proof", result.ProofDuration,
+	)
+
+	return nil
+}
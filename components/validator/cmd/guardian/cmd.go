@@ -0,0 +1,265 @@
+package guardian
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/urfave/cli"
+
+	"github.com/kroma-network/kroma/bindings/bindings"
+	"github.com/kroma-network/kroma/components/node/eth"
+	"github.com/kroma-network/kroma/components/validator/flags"
+	"github.com/kroma-network/kroma/utils"
+	"github.com/kroma-network/kroma/utils/service/txmgr"
+	"github.com/kroma-network/kroma/utils/service/txmgr/metrics"
+)
+
+// pendingTransaction is a SecurityCouncil transaction waiting for the confirmations of the guardians,
+// which is created as a result of a challenge or a forced output deletion request.
+type pendingTransaction struct {
+	TransactionID *big.Int               `json:"transaction_id"`
+	Destination   common.Address         `json:"destination"`
+	Method        string                 `json:"method"`
+	Args          map[string]interface{} `json:"args"`
+	Confirmations []common.Address       `json:"confirmations"`
+	Required      *big.Int               `json:"required"`
+}
+
+// outputValidation is the data for the council to validate the output of a forced deletion request.
+type outputValidation struct {
+	OutputIndex     *big.Int    `json:"output_index"`
+	L2BlockNumber   *big.Int    `json:"l2_block_number"`
+	OutputRoot      eth.Bytes32 `json:"output_root"`
+	LocalOutputRoot eth.Bytes32 `json:"local_output_root"`
+	Valid           bool        `json:"valid"`
+	Finalized       bool        `json:"finalized"`
+	DeleteRequested bool        `json:"delete_requested"`
+}
+
+// Pending prints the SecurityCouncil transactions that are not executed yet, with the decoded calls to the Colosseum.
+func Pending(ctx *cli.Context) error {
+	securityCouncil, err := newSecurityCouncil(ctx)
+	if err != nil {
+		return err
+	}
+
+	colosseumABI, err := bindings.ColosseumMetaData.GetAbi()
+	if err != nil {
+		return fmt.Errorf("failed to get Colosseum ABI: %w", err)
+	}
+
+	callOpts := utils.NewSimpleCallOpts(context.Background())
+	count, err := securityCouncil.GetTransactionCount(callOpts, true, false)
+	if err != nil {
+		return fmt.Errorf("failed to get pending transaction count: %w", err)
+	}
+
+	ids, err := securityCouncil.GetTransactionIds(callOpts, common.Big0, count, true, false)
+	if err != nil {
+		return fmt.Errorf("failed to get pending transaction ids: %w", err)
+	}
+
+	required, err := securityCouncil.NumConfirmationsRequired(callOpts)
+	if err != nil {
+		return fmt.Errorf("failed to get number of required confirmations: %w", err)
+	}
+
+	pending := make([]pendingTransaction, 0, len(ids))
+	for _, id := range ids {
+		tx, err := securityCouncil.Transactions(callOpts, id)
+		if err != nil {
+			return fmt.Errorf("failed to get transaction (transactionId: %d): %w", id, err)
+		}
+
+		confirmations, err := securityCouncil.GetConfirmations(callOpts, id)
+		if err != nil {
+			return fmt.Errorf("failed to get confirmations (transactionId: %d): %w", id, err)
+		}
+
+		method, args, err := decodeCall(colosseumABI, tx.Data)
+		if err != nil {
+			log.Warn("failed to decode transaction data", "err", err, "transactionId", id)
+		}
+
+		pending = append(pending, pendingTransaction{
+			TransactionID: id,
+			Destination:   tx.Destination,
+			Method:        method,
+			Args:          args,
+			Confirmations: confirmations,
+			Required:      required,
+		})
+	}
+
+	return printJSON(pending)
+}
+
+// Inspect prints the data for the council to validate the forced deletion of the output,
+// comparing the submitted output root to the output root of the local rollup node.
+func Inspect(ctx *cli.Context) error {
+	outputIndex := new(big.Int).SetUint64(ctx.Uint64("output-index"))
+
+	l1Client, err := utils.DialEthClientWithTimeout(context.Background(), ctx.GlobalString(flags.L1EthRpcFlag.Name))
+	if err != nil {
+		return fmt.Errorf("failed to dial L1 client: %w", err)
+	}
+
+	rollupClient, err := utils.DialRollupClientWithTimeout(context.Background(), ctx.GlobalString(flags.RollupRpcFlag.Name))
+	if err != nil {
+		return fmt.Errorf("failed to dial rollup client: %w", err)
+	}
+
+	l2ooAddr, err := utils.ParseAddress(ctx.GlobalString(flags.L2OOAddressFlag.Name))
+	if err != nil {
+		return fmt.Errorf("failed to parse L2OutputOracle address: %w", err)
+	}
+
+	l2ooContract, err := bindings.NewL2OutputOracleCaller(l2ooAddr, l1Client)
+	if err != nil {
+		return err
+	}
+
+	securityCouncil, err := newSecurityCouncil(ctx)
+	if err != nil {
+		return err
+	}
+
+	callOpts := utils.NewSimpleCallOpts(context.Background())
+	output, err := l2ooContract.GetL2Output(callOpts, outputIndex)
+	if err != nil {
+		return fmt.Errorf("failed to get output from L2OutputOracle contract(outputIndex: %d): %w", outputIndex, err)
+	}
+
+	finalized, err := l2ooContract.IsFinalized(callOpts, outputIndex)
+	if err != nil {
+		return fmt.Errorf("failed to get if output is finalized. (outputIndex: %d): %w", outputIndex, err)
+	}
+
+	deleteRequested, err := securityCouncil.OutputsDeleteRequested(callOpts, outputIndex)
+	if err != nil {
+		return fmt.Errorf("failed to get if output deletion is requested. (outputIndex: %d): %w", outputIndex, err)
+	}
+
+	localOutput, err := rollupClient.OutputAtBlock(context.Background(), output.L2BlockNumber.Uint64())
+	if err != nil {
+		return fmt.Errorf("failed to get output root at block number %d: %w", output.L2BlockNumber, err)
+	}
+
+	return printJSON(outputValidation{
+		OutputIndex:     outputIndex,
+		L2BlockNumber:   output.L2BlockNumber,
+		OutputRoot:      output.OutputRoot,
+		LocalOutputRoot: localOutput.OutputRoot,
+		Valid:           bytes.Equal(output.OutputRoot[:], localOutput.OutputRoot[:]),
+		Finalized:       finalized,
+		DeleteRequested: deleteRequested,
+	})
+}
+
+// Confirm confirms the SecurityCouncil transaction with the key of the guardian.
+func Confirm(ctx *cli.Context) error {
+	transactionId := new(big.Int).SetUint64(ctx.Uint64("transaction-id"))
+
+	securityCouncil, err := newSecurityCouncil(ctx)
+	if err != nil {
+		return err
+	}
+
+	txMgrConfig := txmgr.ReadCLIConfig(ctx)
+	txManager, err := txmgr.NewSimpleTxManager("validator-guardian", log.New(), &metrics.NoopTxMetrics{}, txMgrConfig)
+	if err != nil {
+		return fmt.Errorf("failed to create tx manager: %w", err)
+	}
+
+	callOpts := utils.NewSimpleCallOpts(context.Background())
+	tx, err := securityCouncil.Transactions(callOpts, transactionId)
+	if err != nil {
+		return fmt.Errorf("failed to get transaction with transactionId %d: %w", transactionId, err)
+	}
+	if tx.Executed {
+		return fmt.Errorf("transaction is already executed (transactionId: %d)", transactionId)
+	}
+
+	confirmed, err := securityCouncil.Confirmations(callOpts, transactionId, txManager.From())
+	if err != nil {
+		return fmt.Errorf("failed to get confirmation. (transactionId: %d): %w", transactionId, err)
+	}
+	if confirmed {
+		return fmt.Errorf("transaction is already confirmed by %s (transactionId: %d)", txManager.From(), transactionId)
+	}
+
+	securityCouncilABI, err := bindings.SecurityCouncilMetaData.GetAbi()
+	if err != nil {
+		return fmt.Errorf("failed to get SecurityCouncil ABI: %w", err)
+	}
+
+	txData, err := securityCouncilABI.Pack("confirmTransaction", transactionId)
+	if err != nil {
+		return fmt.Errorf("failed to create confirm transaction data: %w", err)
+	}
+
+	securityCouncilAddr, err := utils.ParseAddress(ctx.GlobalString(flags.SecurityCouncilAddressFlag.Name))
+	if err != nil {
+		return fmt.Errorf("failed to parse SecurityCouncil address: %w", err)
+	}
+
+	receipt, err := txManager.Send(context.Background(), txmgr.TxCandidate{
+		TxData:   txData,
+		To:       &securityCouncilAddr,
+		GasLimit: 0,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to send confirm tx. (transactionId: %d): %w", transactionId, err)
+	}
+
+	log.Info("confirmed transaction", "transactionId", transactionId, "txHash", receipt.TxHash)
+
+	return nil
+}
+
+func newSecurityCouncil(ctx *cli.Context) (*bindings.SecurityCouncilCaller, error) {
+	l1Client, err := utils.DialEthClientWithTimeout(context.Background(), ctx.GlobalString(flags.L1EthRpcFlag.Name))
+	if err != nil {
+		return nil, fmt.Errorf("failed to dial L1 client: %w", err)
+	}
+
+	securityCouncilAddr, err := utils.ParseAddress(ctx.GlobalString(flags.SecurityCouncilAddressFlag.Name))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse SecurityCouncil address: %w", err)
+	}
+
+	return bindings.NewSecurityCouncilCaller(securityCouncilAddr, l1Client)
+}
+
+// decodeCall decodes the method name and the arguments of the call data with the given ABI.
+func decodeCall(contractABI *abi.ABI, data []byte) (string, map[string]interface{}, error) {
+	if len(data) < 4 {
+		return "", nil, errors.New("call data is too short")
+	}
+
+	method, err := contractABI.MethodById(data[:4])
+	if err != nil {
+		return "", nil, err
+	}
+
+	args := make(map[string]interface{})
+	if err := method.Inputs.UnpackIntoMap(args, data[4:]); err != nil {
+		return method.Name, nil, fmt.Errorf("failed to unpack arguments of %s: %w", method.Name, err)
+	}
+
+	return method.Name, args, nil
+}
+
+func printJSON(v interface{}) error {
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
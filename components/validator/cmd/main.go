@@ -9,6 +9,8 @@ import (
 
 	"github.com/kroma-network/kroma/components/validator"
 	"github.com/kroma-network/kroma/components/validator/cmd/balance"
+	"github.com/kroma-network/kroma/components/validator/cmd/challenge"
+	"github.com/kroma-network/kroma/components/validator/cmd/guardian"
 	"github.com/kroma-network/kroma/components/validator/flags"
 	klog "github.com/kroma-network/kroma/utils/service/log"
 )
@@ -59,6 +61,53 @@ func main() {
 			Usage:  "Attempt to unbond in ValidatorPool",
 			Action: balance.Unbond,
 		},
+		{
+			Name:  "challenge-dry-run",
+			Usage: "Simulate the challenge of an output locally without sending transactions, to validate the prover setup",
+			Flags: []cli.Flag{
+				cli.Uint64Flag{
+					Name:     "output-index",
+					Usage:    "Index of the output to simulate the challenge of",
+					Required: true,
+				},
+			},
+			Action: challenge.DryRun,
+		},
+		{
+			Name:  "guardian",
+			Usage: "Inspect and confirm the SecurityCouncil transactions as a guardian",
+			Subcommands: []cli.Command{
+				{
+					Name:   "pending",
+					Usage:  "List the pending SecurityCouncil transactions with the decoded challenge results",
+					Action: guardian.Pending,
+				},
+				{
+					Name:  "inspect",
+					Usage: "Print the data to validate the output of a forced deletion request against the rollup node",
+					Flags: []cli.Flag{
+						cli.Uint64Flag{
+							Name:     "output-index",
+							Usage:    "Index of the output to inspect",
+							Required: true,
+						},
+					},
+					Action: guardian.Inspect,
+				},
+				{
+					Name:  "confirm",
+					Usage: "Confirm the SecurityCouncil transaction",
+					Flags: []cli.Flag{
+						cli.Uint64Flag{
+							Name:     "transaction-id",
+							Usage:    "ID of the SecurityCouncil transaction to confirm",
+							Required: true,
+						},
+					},
+					Action: guardian.Confirm,
+				},
+			},
+		},
 	}
 
 	err := app.Run(os.Args)
@@ -3,11 +3,13 @@ package validator
 import (
 	"context"
 	"errors"
+	"math/big"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/params"
 	"github.com/urfave/cli"
 
 	"github.com/kroma-network/kroma/components/node/rollup"
@@ -15,12 +17,14 @@ import (
 	chal "github.com/kroma-network/kroma/components/validator/challenge"
 	"github.com/kroma-network/kroma/components/validator/flags"
 	"github.com/kroma-network/kroma/components/validator/metrics"
+	"github.com/kroma-network/kroma/components/validator/rpc"
 	"github.com/kroma-network/kroma/utils"
 	klog "github.com/kroma-network/kroma/utils/service/log"
 	kmetrics "github.com/kroma-network/kroma/utils/service/metrics"
 	kpprof "github.com/kroma-network/kroma/utils/service/pprof"
-	krpc "github.com/kroma-network/kroma/utils/service/rpc"
 	"github.com/kroma-network/kroma/utils/service/txmgr"
+	txmetrics "github.com/kroma-network/kroma/utils/service/txmgr/metrics"
+	"github.com/kroma-network/kroma/utils/signer/client"
 )
 
 // Config contains the well typed fields that are used to initialize the output submitter.
@@ -31,6 +35,7 @@ type Config struct {
 	SecurityCouncilAddr          common.Address
 	ValidatorPoolAddr            common.Address
 	ChallengerPollInterval       time.Duration
+	ChallengerMaxConcurrency     int
 	NetworkTimeout               time.Duration
 	TxManager                    *txmgr.BufferedTxManager
 	L1Client                     *ethclient.Client
@@ -41,9 +46,32 @@ type Config struct {
 	OutputSubmitterEnabled       bool
 	OutputSubmitterRetryInterval time.Duration
 	OutputSubmitterRoundBuffer   uint64
+	OutputSubmitterSchedule      SubmissionSchedule
 	ChallengerEnabled            bool
 	GuardianEnabled              bool
-	ProofFetcher                 ProofFetcher
+	// WatcherEnabled only verifies the submitted outputs, TxManager is nil then.
+	WatcherEnabled bool
+	// ProofBackend generates the proofs. Nil if no prover is configured.
+	ProofBackend chal.ProofBackend
+	// ProverMaxConcurrency is the max number of proofs to request from the provers concurrently.
+	ProverMaxConcurrency int
+	// ProofCache caches the witnesses and proofs on disk. Not cached if nil.
+	ProofCache *chal.ProofCache
+	// KeyRotationTxManager is the tx manager of the new key to rotate to. Not rotated if nil.
+	KeyRotationTxManager *txmgr.BufferedTxManager
+	// KeyRotationBlockNumber is the L2 block number of the first output to submit and challenge with the new key.
+	KeyRotationBlockNumber uint64
+	// PenaltyMonitorEnabled watches the penalties on the bonds of PenaltyMonitorAddrs.
+	PenaltyMonitorEnabled    bool
+	PenaltyMonitorAddrs      []common.Address
+	PenaltyMonitorWebhookURL string
+	// RewardSweeperEnabled claims the validator rewards and sweeps the balance above RewardSweeperThreshold.
+	RewardSweeperEnabled   bool
+	RewardSweeperColdAddr  common.Address
+	RewardSweeperThreshold *big.Int
+	RewardSweeperInterval  time.Duration
+	// RewardSweeperTxManager sends the reward claim transactions to L2.
+	RewardSweeperTxManager *txmgr.SimpleTxManager
 }
 
 // Check ensures that the [Config] is valid.
@@ -82,8 +110,23 @@ type CLIConfig struct {
 	// ChallengerPollInterval is how frequently to poll L2 for new finalized outputs.
 	ChallengerPollInterval time.Duration
 
-	// ProverRPC is the URL of prover jsonRPC server.
-	ProverRPC string
+	// ChallengerMaxConcurrency is the max number of challenges to take a step on concurrently.
+	ChallengerMaxConcurrency int
+
+	// ProverRPCs are the URLs of prover jsonRPC servers, requested in order with failover.
+	ProverRPCs []string
+
+	// ProverBackend is the name of the proof backend to request the proofs from the provers with.
+	ProverBackend string
+
+	// ProverMaxRetries is the number of times to retry all the provers if none of them could return a proof.
+	ProverMaxRetries int
+
+	// ProverMaxConcurrency is the max number of proofs to request from the provers concurrently.
+	ProverMaxConcurrency int
+
+	// ProofCacheDir is the directory to cache the generated witnesses and proofs in. Not cached if empty.
+	ProofCacheDir string
 
 	// AllowNonFinalized can be set to true to submit outputs
 	// for L2 blocks derived from non-finalized L1 data.
@@ -97,22 +140,97 @@ type CLIConfig struct {
 	// OutputSubmitterRoundBuffer is how many blocks before each round to start trying submission.
 	OutputSubmitterRoundBuffer uint64
 
+	// OutputSubmitterMaxBaseFeeGwei is the max L1 basefee in gwei to submit outputs at early in the submission window.
+	// 0 disables the deferral of the submission.
+	OutputSubmitterMaxBaseFeeGwei uint64
+
+	// OutputSubmitterUrgentProgress is the progress of the submission window from which the submission is not deferred anymore.
+	OutputSubmitterUrgentProgress float64
+
+	// OutputSubmitterMaxFeeMultiplier is the multiplier of the gas tip cap at the deadline of the submission window.
+	OutputSubmitterMaxFeeMultiplier float64
+
+	// OutputSubmitterFeeCurve is the curve to escalate the gas tip cap with over the submission window.
+	OutputSubmitterFeeCurve string
+
 	ChallengerEnabled bool
 
 	GuardianEnabled bool
 
+	// WatcherEnabled verifies the submitted outputs and reports invalid outputs, without submitting any transactions.
+	WatcherEnabled bool
+
 	FetchingProofTimeout time.Duration
 
+	// ProverAdaptiveTimeout adapts the timeout of the proof requests to the observed proving times,
+	// bounded by FetchingProofTimeout.
+	ProverAdaptiveTimeout bool
+
+	// KeyRotationPrivateKey is the new private key to rotate the validator key to. Not rotated if empty.
+	KeyRotationPrivateKey string
+
+	// KeyRotationBlockNumber is the L2 block number of the first output to submit and challenge with the new key.
+	KeyRotationBlockNumber uint64
+
+	// IdentitiesFile is the JSON file of the validator identities to run in this process.
+	// A single identity configured by the flags is run if empty.
+	IdentitiesFile string
+
+	// PenaltyMonitorEnabled watches the penalties on the bonds of the validator.
+	PenaltyMonitorEnabled bool
+
+	// PenaltyMonitorAddress is the validator address to monitor the penalties of. Defaults to the validator keys if empty.
+	PenaltyMonitorAddress string
+
+	// PenaltyMonitorWebhookURL is the URL of the webhook to post penalty alerts to. Not pushed if empty.
+	PenaltyMonitorWebhookURL string
+
+	// RewardSweeperEnabled claims the accrued validator rewards and transfers the balance above the threshold to the cold address.
+	RewardSweeperEnabled bool
+
+	// RewardSweeperColdAddress is the address to transfer the balance above the threshold to.
+	RewardSweeperColdAddress string
+
+	// RewardSweeperThresholdGwei is the L1 balance in gwei to keep for the transaction fees.
+	RewardSweeperThresholdGwei uint64
+
+	// RewardSweeperInterval is how frequently to claim the rewards and sweep the balance.
+	RewardSweeperInterval time.Duration
+
 	TxMgrConfig   txmgr.CLIConfig
-	RPCConfig     krpc.CLIConfig
+	RPCConfig     rpc.CLIConfig
 	LogConfig     klog.CLIConfig
 	MetricsConfig kmetrics.CLIConfig
 	PprofConfig   kpprof.CLIConfig
 }
 
 func (c CLIConfig) Check() error {
-	if !(c.OutputSubmitterEnabled || c.ChallengerEnabled || c.GuardianEnabled) {
-		return errors.New("one of output submitter, challenger, guardian should be enabled")
+	if c.WatcherEnabled {
+		if c.OutputSubmitterEnabled || c.ChallengerEnabled || c.GuardianEnabled {
+			return errors.New("watcher cannot be enabled with output submitter, challenger or guardian")
+		}
+	} else if !(c.OutputSubmitterEnabled || c.ChallengerEnabled || c.GuardianEnabled) {
+		return errors.New("one of output submitter, challenger, guardian, watcher should be enabled")
+	}
+	if c.KeyRotationPrivateKey != "" && c.WatcherEnabled {
+		return errors.New("key rotation cannot be enabled with watcher")
+	}
+	if c.PenaltyMonitorEnabled && c.WatcherEnabled && c.PenaltyMonitorAddress == "" {
+		return errors.New("penalty monitor address is required when penalty monitor enabled with watcher")
+	}
+	if c.RewardSweeperEnabled {
+		if c.WatcherEnabled {
+			return errors.New("reward sweeper cannot be enabled with watcher")
+		}
+		if c.RewardSweeperColdAddress == "" {
+			return errors.New("reward sweeper cold address is required when reward sweeper enabled")
+		}
+		if c.RewardSweeperInterval <= 0 {
+			return errors.New("reward sweeper interval must be positive")
+		}
+	}
+	if err := c.submissionSchedule().Check(); err != nil {
+		return err
 	}
 	if err := c.RPCConfig.Check(); err != nil {
 		return err
@@ -126,6 +244,10 @@ func (c CLIConfig) Check() error {
 	if err := c.PprofConfig.Check(); err != nil {
 		return err
 	}
+	// The watcher does not submit transactions, so it does not need a tx manager.
+	if c.WatcherEnabled {
+		return nil
+	}
 	if err := c.TxMgrConfig.Check(); err != nil {
 		return err
 	}
@@ -148,20 +270,54 @@ func NewCLIConfig(ctx *cli.Context) CLIConfig {
 		TxMgrConfig:            txmgr.ReadCLIConfig(ctx),
 
 		// Optional Flags
-		AllowNonFinalized:            ctx.GlobalBool(flags.AllowNonFinalizedFlag.Name),
-		OutputSubmitterRetryInterval: ctx.GlobalDuration(flags.OutputSubmitterRetryIntervalFlag.Name),
-		OutputSubmitterRoundBuffer:   ctx.GlobalUint64(flags.OutputSubmitterRoundBufferFlag.Name),
-		SecurityCouncilAddress:       ctx.GlobalString(flags.SecurityCouncilAddressFlag.Name),
-		ProverRPC:                    ctx.GlobalString(flags.ProverRPCFlag.Name),
-		GuardianEnabled:              ctx.GlobalBool(flags.GuardianEnabledFlag.Name),
-		FetchingProofTimeout:         ctx.GlobalDuration(flags.FetchingProofTimeoutFlag.Name),
-		RPCConfig:                    krpc.ReadCLIConfig(ctx),
-		LogConfig:                    klog.ReadCLIConfig(ctx),
-		MetricsConfig:                kmetrics.ReadCLIConfig(ctx),
-		PprofConfig:                  kpprof.ReadCLIConfig(ctx),
+		AllowNonFinalized:               ctx.GlobalBool(flags.AllowNonFinalizedFlag.Name),
+		OutputSubmitterRetryInterval:    ctx.GlobalDuration(flags.OutputSubmitterRetryIntervalFlag.Name),
+		OutputSubmitterRoundBuffer:      ctx.GlobalUint64(flags.OutputSubmitterRoundBufferFlag.Name),
+		OutputSubmitterMaxBaseFeeGwei:   ctx.GlobalUint64(flags.OutputSubmitterMaxBaseFeeGweiFlag.Name),
+		OutputSubmitterUrgentProgress:   ctx.GlobalFloat64(flags.OutputSubmitterUrgentProgressFlag.Name),
+		OutputSubmitterMaxFeeMultiplier: ctx.GlobalFloat64(flags.OutputSubmitterMaxFeeMultiplierFlag.Name),
+		OutputSubmitterFeeCurve:         ctx.GlobalString(flags.OutputSubmitterFeeCurveFlag.Name),
+		SecurityCouncilAddress:          ctx.GlobalString(flags.SecurityCouncilAddressFlag.Name),
+		ProverRPCs:                      ctx.GlobalStringSlice(flags.ProverRPCFlag.Name),
+		ProverMaxRetries:                ctx.GlobalInt(flags.ProverMaxRetriesFlag.Name),
+		ProverBackend:                   ctx.GlobalString(flags.ProverBackendFlag.Name),
+		ProverMaxConcurrency:            ctx.GlobalInt(flags.ProverMaxConcurrencyFlag.Name),
+		ChallengerMaxConcurrency:        ctx.GlobalInt(flags.ChallengerMaxConcurrencyFlag.Name),
+		ProofCacheDir:                   ctx.GlobalString(flags.ProofCacheDirFlag.Name),
+		GuardianEnabled:                 ctx.GlobalBool(flags.GuardianEnabledFlag.Name),
+		WatcherEnabled:                  ctx.GlobalBool(flags.WatcherEnabledFlag.Name),
+		FetchingProofTimeout:            ctx.GlobalDuration(flags.FetchingProofTimeoutFlag.Name),
+		ProverAdaptiveTimeout:           ctx.GlobalBool(flags.ProverAdaptiveTimeoutFlag.Name),
+		KeyRotationPrivateKey:           ctx.GlobalString(flags.KeyRotationPrivateKeyFlag.Name),
+		KeyRotationBlockNumber:          ctx.GlobalUint64(flags.KeyRotationBlockNumberFlag.Name),
+		IdentitiesFile:                  ctx.GlobalString(flags.IdentitiesFileFlag.Name),
+		PenaltyMonitorEnabled:           ctx.GlobalBool(flags.PenaltyMonitorEnabledFlag.Name),
+		PenaltyMonitorAddress:           ctx.GlobalString(flags.PenaltyMonitorAddressFlag.Name),
+		PenaltyMonitorWebhookURL:        ctx.GlobalString(flags.PenaltyMonitorWebhookURLFlag.Name),
+		RewardSweeperEnabled:            ctx.GlobalBool(flags.RewardSweeperEnabledFlag.Name),
+		RewardSweeperColdAddress:        ctx.GlobalString(flags.RewardSweeperColdAddressFlag.Name),
+		RewardSweeperThresholdGwei:      ctx.GlobalUint64(flags.RewardSweeperThresholdGweiFlag.Name),
+		RewardSweeperInterval:           ctx.GlobalDuration(flags.RewardSweeperIntervalFlag.Name),
+		RPCConfig:                       rpc.ReadCLIConfig(ctx),
+		LogConfig:                       klog.ReadCLIConfig(ctx),
+		MetricsConfig:                   kmetrics.ReadCLIConfig(ctx),
+		PprofConfig:                     kpprof.ReadCLIConfig(ctx),
 	}
 }
 
+// submissionSchedule creates the SubmissionSchedule of the output submission.
+func (c CLIConfig) submissionSchedule() SubmissionSchedule {
+	schedule := SubmissionSchedule{
+		UrgentProgress:   c.OutputSubmitterUrgentProgress,
+		MaxFeeMultiplier: c.OutputSubmitterMaxFeeMultiplier,
+		FeeCurve:         FeeCurve(c.OutputSubmitterFeeCurve),
+	}
+	if c.OutputSubmitterMaxBaseFeeGwei != 0 {
+		schedule.MaxBaseFee = new(big.Int).Mul(new(big.Int).SetUint64(c.OutputSubmitterMaxBaseFeeGwei), big.NewInt(params.GWei))
+	}
+	return schedule
+}
+
 // NewValidatorConfig creates a validator config with given the CLIConfig
 func NewValidatorConfig(cfg CLIConfig, l log.Logger, m metrics.Metricer) (*Config, error) {
 	l2ooAddress, err := utils.ParseAddress(cfg.L2OOAddress)
@@ -187,18 +343,81 @@ func NewValidatorConfig(cfg CLIConfig, l log.Logger, m metrics.Metricer) (*Confi
 		return nil, err
 	}
 
-	txManager, err := txmgr.NewBufferedTxManager("validator", l, m, cfg.TxMgrConfig)
-	if err != nil {
-		return nil, err
+	var txManager *txmgr.BufferedTxManager
+	if !cfg.WatcherEnabled {
+		txManager, err = txmgr.NewBufferedTxManager("validator", l, m, cfg.TxMgrConfig)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var keyRotationTxManager *txmgr.BufferedTxManager
+	if cfg.KeyRotationPrivateKey != "" {
+		newKeyTxMgrConfig := cfg.TxMgrConfig
+		newKeyTxMgrConfig.PrivateKey = cfg.KeyRotationPrivateKey
+		newKeyTxMgrConfig.Mnemonic = ""
+		newKeyTxMgrConfig.HDPath = ""
+		newKeyTxMgrConfig.SignerCLIConfig = client.CLIConfig{}
+		keyRotationTxManager, err = txmgr.NewBufferedTxManager("validator-rotation", l, &txmetrics.NoopTxMetrics{}, newKeyTxMgrConfig)
+		if err != nil {
+			return nil, err
+		}
+		l.Info("rotating validator key", "from", txManager.From(), "to", keyRotationTxManager.From(), "blockNumber", cfg.KeyRotationBlockNumber)
 	}
 
-	if cfg.ChallengerEnabled && len(cfg.ProverRPC) == 0 {
+	var penaltyMonitorAddrs []common.Address
+	if cfg.PenaltyMonitorEnabled {
+		if cfg.PenaltyMonitorAddress != "" {
+			addr, err := utils.ParseAddress(cfg.PenaltyMonitorAddress)
+			if err != nil {
+				return nil, err
+			}
+			penaltyMonitorAddrs = append(penaltyMonitorAddrs, addr)
+		} else {
+			penaltyMonitorAddrs = append(penaltyMonitorAddrs, txManager.From())
+			if keyRotationTxManager != nil {
+				penaltyMonitorAddrs = append(penaltyMonitorAddrs, keyRotationTxManager.From())
+			}
+		}
+	}
+
+	var rewardSweeperColdAddr common.Address
+	var rewardSweeperTxManager *txmgr.SimpleTxManager
+	if cfg.RewardSweeperEnabled {
+		rewardSweeperColdAddr, err = utils.ParseAddress(cfg.RewardSweeperColdAddress)
+		if err != nil {
+			return nil, err
+		}
+
+		// The rewards are claimed on L2 with the same account.
+		l2TxMgrConfig := cfg.TxMgrConfig
+		l2TxMgrConfig.L1RPCURL = cfg.L2EthRpc
+		rewardSweeperTxManager, err = txmgr.NewSimpleTxManager("validator-reward", l, &txmetrics.NoopTxMetrics{}, l2TxMgrConfig)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if cfg.ChallengerEnabled && len(cfg.ProverRPCs) == 0 {
 		return nil, errors.New("ProverRPC is required when challenger enabled, but given empty")
 	}
 
-	var fetcher ProofFetcher
-	if len(cfg.ProverRPC) > 0 {
-		fetcher, err = chal.NewFetcher(cfg.ProverRPC, cfg.FetchingProofTimeout, l)
+	var proofBackend chal.ProofBackend
+	if len(cfg.ProverRPCs) > 0 {
+		proofBackend, err = chal.NewBackend(cfg.ProverBackend, chal.BackendConfig{
+			RPCURLs:         cfg.ProverRPCs,
+			Timeout:         cfg.FetchingProofTimeout,
+			AdaptiveTimeout: cfg.ProverAdaptiveTimeout,
+			MaxRetries:      cfg.ProverMaxRetries,
+		}, l, m)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var proofCache *chal.ProofCache
+	if cfg.ProofCacheDir != "" {
+		proofCache, err = chal.NewProofCache(cfg.ProofCacheDir)
 		if err != nil {
 			return nil, err
 		}
@@ -232,6 +451,7 @@ func NewValidatorConfig(cfg CLIConfig, l log.Logger, m metrics.Metricer) (*Confi
 		SecurityCouncilAddr:          securityCouncilAddress,
 		ValidatorPoolAddr:            valPoolAddress,
 		ChallengerPollInterval:       cfg.ChallengerPollInterval,
+		ChallengerMaxConcurrency:     cfg.ChallengerMaxConcurrency,
 		NetworkTimeout:               cfg.TxMgrConfig.NetworkTimeout,
 		TxManager:                    txManager,
 		L1Client:                     l1Client,
@@ -242,8 +462,22 @@ func NewValidatorConfig(cfg CLIConfig, l log.Logger, m metrics.Metricer) (*Confi
 		OutputSubmitterEnabled:       cfg.OutputSubmitterEnabled,
 		OutputSubmitterRetryInterval: cfg.OutputSubmitterRetryInterval,
 		OutputSubmitterRoundBuffer:   cfg.OutputSubmitterRoundBuffer,
+		OutputSubmitterSchedule:      cfg.submissionSchedule(),
 		ChallengerEnabled:            cfg.ChallengerEnabled,
 		GuardianEnabled:              cfg.GuardianEnabled,
-		ProofFetcher:                 fetcher,
+		WatcherEnabled:               cfg.WatcherEnabled,
+		ProofBackend:                 proofBackend,
+		ProverMaxConcurrency:         cfg.ProverMaxConcurrency,
+		ProofCache:                   proofCache,
+		KeyRotationTxManager:         keyRotationTxManager,
+		KeyRotationBlockNumber:       cfg.KeyRotationBlockNumber,
+		PenaltyMonitorEnabled:        cfg.PenaltyMonitorEnabled,
+		PenaltyMonitorAddrs:          penaltyMonitorAddrs,
+		PenaltyMonitorWebhookURL:     cfg.PenaltyMonitorWebhookURL,
+		RewardSweeperEnabled:         cfg.RewardSweeperEnabled,
+		RewardSweeperColdAddr:        rewardSweeperColdAddr,
+		RewardSweeperThreshold:       new(big.Int).Mul(new(big.Int).SetUint64(cfg.RewardSweeperThresholdGwei), big.NewInt(params.GWei)),
+		RewardSweeperInterval:        cfg.RewardSweeperInterval,
+		RewardSweeperTxManager:       rewardSweeperTxManager,
 	}, nil
 }
@@ -5,6 +5,8 @@ import (
 
 	"github.com/urfave/cli"
 
+	chal "github.com/kroma-network/kroma/components/validator/challenge"
+	"github.com/kroma-network/kroma/components/validator/rpc"
 	kservice "github.com/kroma-network/kroma/utils/service"
 	klog "github.com/kroma-network/kroma/utils/service/log"
 	kmetrics "github.com/kroma-network/kroma/utils/service/metrics"
@@ -92,11 +94,54 @@ var (
 		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "OUTPUT_SUBMITTER_ROUND_BUFFER"),
 		Value:  30,
 	}
-	ProverRPCFlag = cli.StringFlag{
-		Name:   "prover-rpc-url",
-		Usage:  "jsonRPC URL for kroma-prover.",
+	ChallengerMaxConcurrencyFlag = cli.IntFlag{
+		Name:   "challenger.max-concurrency",
+		Usage:  "Max number of related challenges to take a step on concurrently, e.g. bisecting or proving fault",
+		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "CHALLENGER_MAX_CONCURRENCY"),
+		Value:  8,
+	}
+	OutputSubmitterMaxBaseFeeGweiFlag = cli.Uint64Flag{
+		Name:   "output-submitter.max-basefee-gwei",
+		Usage:  "Max L1 basefee in gwei to submit outputs at before the urgent progress of the submission window. 0 disables the deferral",
+		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "OUTPUT_SUBMITTER_MAX_BASEFEE_GWEI"),
+	}
+	OutputSubmitterUrgentProgressFlag = cli.Float64Flag{
+		Name:   "output-submitter.urgent-progress",
+		Usage:  "Progress of the submission window in [0, 1] from which the output submission is not deferred because of the L1 basefee anymore",
+		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "OUTPUT_SUBMITTER_URGENT_PROGRESS"),
+		Value:  0.5,
+	}
+	OutputSubmitterMaxFeeMultiplierFlag = cli.Float64Flag{
+		Name:   "output-submitter.max-fee-multiplier",
+		Usage:  "Multiplier of the suggested gas tip cap at the deadline of the submission window. 1 disables the fee escalation",
+		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "OUTPUT_SUBMITTER_MAX_FEE_MULTIPLIER"),
+		Value:  1,
+	}
+	OutputSubmitterFeeCurveFlag = cli.StringFlag{
+		Name:   "output-submitter.fee-curve",
+		Usage:  "Curve to escalate the gas tip cap with over the submission window: linear, quadratic or exponential",
+		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "OUTPUT_SUBMITTER_FEE_CURVE"),
+		Value:  "linear",
+	}
+	ProverRPCFlag = cli.StringSliceFlag{
+		Name: "prover-rpc-url",
+		Usage: "jsonRPC URLs for kroma-prover. If multiple URLs are given, the provers are requested in the given order, " +
+			"failing over to the next prover if a prover is unreachable or fails.",
 		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "PROVER_RPC"),
 	}
+	ProverBackendFlag = cli.StringFlag{
+		Name: "prover.backend",
+		Usage: "Proof backend to request the proofs from the provers with. The default backend is the zkEVM " +
+			"kroma-prover; other backends can be registered to swap the prover",
+		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "PROVER_BACKEND"),
+		Value:  chal.DefaultBackend,
+	}
+	ProverMaxRetriesFlag = cli.IntFlag{
+		Name:   "prover.max-retries",
+		Usage:  "Number of times to retry all the provers if none of them could return a proof",
+		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "PROVER_MAX_RETRIES"),
+		Value:  3,
+	}
 	SecurityCouncilAddressFlag = cli.StringFlag{
 		Name:   "securitycouncil-address",
 		Usage:  "Address of the SecurityCouncil contract",
@@ -107,12 +152,91 @@ var (
 		Usage:  "Enable guardian",
 		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "GUARDIAN_ENABLED"),
 	}
+	WatcherEnabledFlag = cli.BoolFlag{
+		Name: "watcher.enabled",
+		Usage: "Enable watcher-only mode, which verifies the submitted outputs against the rollup node and " +
+			"reports invalid outputs without submitting any transactions. Cannot be combined with the other modes.",
+		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "WATCHER_ENABLED"),
+	}
+	ProofCacheDirFlag = cli.StringFlag{
+		Name:   "proof-cache-dir",
+		Usage:  "Directory to cache the generated witnesses and proofs in across restarts. Not cached if empty.",
+		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "PROOF_CACHE_DIR"),
+	}
 	FetchingProofTimeoutFlag = cli.DurationFlag{
 		Name:   "fetching-proof-timeout",
-		Usage:  "Duration we will wait to fetching proof",
+		Usage:  "Duration we will wait to fetching proof from a single prover",
 		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "FETCHING_PROOF_TIMEOUT"),
 		Value:  time.Hour * 2,
 	}
+	ProverMaxConcurrencyFlag = cli.IntFlag{
+		Name: "prover.max-concurrency",
+		Usage: "Max number of proofs to request from the provers concurrently. The waiting requests are served " +
+			"in the order of their challenge deadlines",
+		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "PROVER_MAX_CONCURRENCY"),
+		Value:  1,
+	}
+	ProverAdaptiveTimeoutFlag = cli.BoolFlag{
+		Name: "prover.adaptive-timeout",
+		Usage: "Adapt the timeout of the proof requests to twice the 95th percentile of the recent proving times, " +
+			"bounded by the fetching proof timeout",
+		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "PROVER_ADAPTIVE_TIMEOUT"),
+	}
+	KeyRotationPrivateKeyFlag = cli.StringFlag{
+		Name: "key-rotation.private-key",
+		Usage: "The new private key to rotate the validator key to. The old key is kept to handle " +
+			"the challenges in flight, and must be removed in a later restart",
+		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "KEY_ROTATION_PRIVATE_KEY"),
+	}
+	KeyRotationBlockNumberFlag = cli.Uint64Flag{
+		Name:   "key-rotation.block-number",
+		Usage:  "L2 block number of the first output to submit and challenge with the new key",
+		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "KEY_ROTATION_BLOCK_NUMBER"),
+	}
+	IdentitiesFileFlag = cli.StringFlag{
+		Name: "identities-file",
+		Usage: "JSON file of the validator identities to run in this process, each with its own name, private key " +
+			"and optional submission schedule. The admin API of each identity is served under the namespace admin<name>",
+		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "IDENTITIES_FILE"),
+	}
+	PenaltyMonitorEnabledFlag = cli.BoolFlag{
+		Name:   "penalty-monitor.enabled",
+		Usage:  "Enable penalty monitor, which watches the penalties on the bonds of the validator",
+		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "PENALTY_MONITOR_ENABLED"),
+	}
+	PenaltyMonitorAddressFlag = cli.StringFlag{
+		Name:   "penalty-monitor.address",
+		Usage:  "Validator address to monitor the penalties of. Defaults to the address of the validator key",
+		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "PENALTY_MONITOR_ADDRESS"),
+	}
+	PenaltyMonitorWebhookURLFlag = cli.StringFlag{
+		Name:   "penalty-monitor.webhook-url",
+		Usage:  "URL of the webhook to post penalty alerts to as JSON. Alerts are not pushed if empty",
+		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "PENALTY_MONITOR_WEBHOOK_URL"),
+	}
+	RewardSweeperEnabledFlag = cli.BoolFlag{
+		Name: "reward-sweeper.enabled",
+		Usage: "Enable reward sweeper, which claims the accrued validator rewards and " +
+			"transfers the balance above the threshold to the cold address",
+		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "REWARD_SWEEPER_ENABLED"),
+	}
+	RewardSweeperColdAddressFlag = cli.StringFlag{
+		Name:   "reward-sweeper.cold-address",
+		Usage:  "Address to transfer the balance of the validator above the threshold to",
+		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "REWARD_SWEEPER_COLD_ADDRESS"),
+	}
+	RewardSweeperThresholdGweiFlag = cli.Uint64Flag{
+		Name:   "reward-sweeper.threshold-gwei",
+		Usage:  "L1 balance of the validator in gwei to keep for the transaction fees, the balance above it is swept",
+		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "REWARD_SWEEPER_THRESHOLD_GWEI"),
+		Value:  1_000_000_000,
+	}
+	RewardSweeperIntervalFlag = cli.DurationFlag{
+		Name:   "reward-sweeper.interval",
+		Usage:  "How frequently to claim the rewards and sweep the balance",
+		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "REWARD_SWEEPER_INTERVAL"),
+		Value:  time.Hour,
+	}
 )
 
 var requiredFlags = []cli.Flag{
@@ -131,10 +255,31 @@ var optionalFlags = []cli.Flag{
 	AllowNonFinalizedFlag,
 	OutputSubmitterRetryIntervalFlag,
 	OutputSubmitterRoundBufferFlag,
+	OutputSubmitterMaxBaseFeeGweiFlag,
+	OutputSubmitterUrgentProgressFlag,
+	OutputSubmitterMaxFeeMultiplierFlag,
+	OutputSubmitterFeeCurveFlag,
+	ChallengerMaxConcurrencyFlag,
 	ProverRPCFlag,
+	ProverBackendFlag,
+	ProverMaxRetriesFlag,
+	ProofCacheDirFlag,
 	SecurityCouncilAddressFlag,
 	GuardianEnabledFlag,
+	WatcherEnabledFlag,
 	FetchingProofTimeoutFlag,
+	ProverMaxConcurrencyFlag,
+	ProverAdaptiveTimeoutFlag,
+	KeyRotationPrivateKeyFlag,
+	KeyRotationBlockNumberFlag,
+	IdentitiesFileFlag,
+	PenaltyMonitorEnabledFlag,
+	PenaltyMonitorAddressFlag,
+	PenaltyMonitorWebhookURLFlag,
+	RewardSweeperEnabledFlag,
+	RewardSweeperColdAddressFlag,
+	RewardSweeperThresholdGweiFlag,
+	RewardSweeperIntervalFlag,
 }
 
 func init() {
@@ -143,6 +288,7 @@ func init() {
 	optionalFlags = append(optionalFlags, klog.CLIFlags(envVarPrefix)...)
 	optionalFlags = append(optionalFlags, kmetrics.CLIFlags(envVarPrefix)...)
 	optionalFlags = append(optionalFlags, kpprof.CLIFlags(envVarPrefix)...)
+	optionalFlags = append(optionalFlags, rpc.CLIFlags(envVarPrefix)...)
 	optionalFlags = append(optionalFlags, txmgr.CLIFlags(envVarPrefix)...)
 
 	Flags = append(requiredFlags, optionalFlags...)
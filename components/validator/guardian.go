@@ -302,7 +302,7 @@ func (g *Guardian) inspectOutput(outputIndex, fromBlock, toBlock *big.Int) {
 
 				tx, err := g.RequestDeletion(g.ctx, outputIndex)
 				if err != nil {
-					g.log.Error("failed to create tx for output deletion", "err", err, "outputIndex", outputIndex)
+					g.log.Error("failed to create tx for output deletion", "err", bindings.WrapRevertError(err), "outputIndex", outputIndex)
 					return true
 				}
 
@@ -410,7 +410,7 @@ func (g *Guardian) tryConfirmRequestValidationTx(event *bindings.SecurityCouncil
 
 		tx, err := g.ConfirmTransaction(g.ctx, event.TransactionId)
 		if err != nil {
-			return fmt.Errorf("failed to create confirm tx. (transactionId: %d): %w", event.TransactionId.Int64(), err)
+			return fmt.Errorf("failed to create confirm tx. (transactionId: %d): %w", event.TransactionId.Int64(), bindings.WrapRevertError(err))
 		}
 
 		if txResponse := g.cfg.TxManager.SendTransaction(g.ctx, tx); txResponse.Err != nil {
@@ -452,7 +452,7 @@ func (g *Guardian) tryConfirmRequestDeletionTx(event *bindings.SecurityCouncilDe
 
 	tx, err := g.ConfirmTransaction(g.ctx, event.TransactionId)
 	if err != nil {
-		return fmt.Errorf("failed to create confirm tx. (transactionId: %d): %w", event.TransactionId.Int64(), err)
+		return fmt.Errorf("failed to create confirm tx. (transactionId: %d): %w", event.TransactionId.Int64(), bindings.WrapRevertError(err))
 	}
 
 	if txResponse := g.cfg.TxManager.SendTransaction(g.ctx, tx); txResponse.Err != nil {
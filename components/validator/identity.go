@@ -0,0 +1,99 @@
+package validator
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+
+	"github.com/kroma-network/kroma/utils/signer/client"
+)
+
+// DefaultIdentityName is the name of the identity configured by the CLI flags, when no identities file is given.
+const DefaultIdentityName = "default"
+
+var identityNameRegexp = regexp.MustCompile(`^[a-z0-9]+$`)
+
+// Identity is a validator identity managed by the process, with its own key, bond and submission schedule.
+// The other configs are shared with the CLI flags. Each identity runs as a separate Validator,
+// so that a failing identity does not affect the others.
+type Identity struct {
+	// Name identifies the identity in the logs, the metrics namespace and the admin API namespace.
+	Name string `json:"name"`
+	// PrivateKey is the private key of the identity. The key of the CLI flags is used if empty.
+	PrivateKey string `json:"private_key"`
+
+	// The submission schedule of the identity, overriding the CLI flags if set.
+	MaxBaseFeeGwei   *uint64  `json:"max_basefee_gwei,omitempty"`
+	UrgentProgress   *float64 `json:"urgent_progress,omitempty"`
+	MaxFeeMultiplier *float64 `json:"max_fee_multiplier,omitempty"`
+	FeeCurve         *string  `json:"fee_curve,omitempty"`
+}
+
+// ReadIdentities reads the identities from the given JSON file.
+func ReadIdentities(path string) ([]Identity, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read identities file: %w", err)
+	}
+	var identities []Identity
+	if err := json.Unmarshal(data, &identities); err != nil {
+		return nil, fmt.Errorf("failed to decode identities file: %w", err)
+	}
+	if err := checkIdentities(identities); err != nil {
+		return nil, fmt.Errorf("invalid identities file: %w", err)
+	}
+	return identities, nil
+}
+
+func checkIdentities(identities []Identity) error {
+	if len(identities) == 0 {
+		return errors.New("no identities")
+	}
+	names := make(map[string]struct{})
+	for _, identity := range identities {
+		if !identityNameRegexp.MatchString(identity.Name) {
+			return fmt.Errorf("identity name must be lowercase alphanumeric, got %q", identity.Name)
+		}
+		if _, ok := names[identity.Name]; ok {
+			return fmt.Errorf("duplicate identity name %q", identity.Name)
+		}
+		names[identity.Name] = struct{}{}
+	}
+	return nil
+}
+
+// Apply returns the CLIConfig of the identity, overriding the given CLIConfig.
+func (i Identity) Apply(cfg CLIConfig) CLIConfig {
+	if i.PrivateKey != "" {
+		cfg.TxMgrConfig.PrivateKey = i.PrivateKey
+		cfg.TxMgrConfig.Mnemonic = ""
+		cfg.TxMgrConfig.HDPath = ""
+		cfg.TxMgrConfig.SignerCLIConfig = client.CLIConfig{}
+		// the key rotation and the monitored address of the CLI flags belong to the key of the CLI flags
+		cfg.KeyRotationPrivateKey = ""
+		cfg.PenaltyMonitorAddress = ""
+	}
+	if i.MaxBaseFeeGwei != nil {
+		cfg.OutputSubmitterMaxBaseFeeGwei = *i.MaxBaseFeeGwei
+	}
+	if i.UrgentProgress != nil {
+		cfg.OutputSubmitterUrgentProgress = *i.UrgentProgress
+	}
+	if i.MaxFeeMultiplier != nil {
+		cfg.OutputSubmitterMaxFeeMultiplier = *i.MaxFeeMultiplier
+	}
+	if i.FeeCurve != nil {
+		cfg.OutputSubmitterFeeCurve = *i.FeeCurve
+	}
+	return cfg
+}
+
+// AdminNamespace returns the namespace of the admin API of the identity.
+func (i Identity) AdminNamespace() string {
+	if i.Name == DefaultIdentityName {
+		return "admin"
+	}
+	return "admin" + i.Name
+}
@@ -0,0 +1,46 @@
+package validator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckIdentities(t *testing.T) {
+	require.Error(t, checkIdentities(nil))
+	require.NoError(t, checkIdentities([]Identity{{Name: "a"}, {Name: "b1"}}))
+	require.Error(t, checkIdentities([]Identity{{Name: "a"}, {Name: "a"}}))
+	require.Error(t, checkIdentities([]Identity{{Name: ""}}))
+	require.Error(t, checkIdentities([]Identity{{Name: "Validator-1"}}))
+}
+
+func TestIdentityApply(t *testing.T) {
+	cfg := CLIConfig{
+		KeyRotationPrivateKey:         "rotation",
+		PenaltyMonitorAddress:         "0x01",
+		OutputSubmitterMaxBaseFeeGwei: 100,
+		OutputSubmitterFeeCurve:       "linear",
+	}
+	cfg.TxMgrConfig.PrivateKey = "flags"
+	cfg.TxMgrConfig.Mnemonic = "mnemonic"
+
+	// an identity without overrides keeps the CLI flags
+	require.Equal(t, cfg, Identity{Name: DefaultIdentityName}.Apply(cfg))
+
+	maxBaseFee := uint64(50)
+	applied := Identity{Name: "a", PrivateKey: "identity", MaxBaseFeeGwei: &maxBaseFee}.Apply(cfg)
+	require.Equal(t, "identity", applied.TxMgrConfig.PrivateKey)
+	require.Empty(t, applied.TxMgrConfig.Mnemonic)
+	require.Empty(t, applied.KeyRotationPrivateKey)
+	require.Empty(t, applied.PenaltyMonitorAddress)
+	require.Equal(t, uint64(50), applied.OutputSubmitterMaxBaseFeeGwei)
+	require.Equal(t, "linear", applied.OutputSubmitterFeeCurve)
+
+	// the given config is not modified
+	require.Equal(t, "flags", cfg.TxMgrConfig.PrivateKey)
+}
+
+func TestIdentityAdminNamespace(t *testing.T) {
+	require.Equal(t, "admin", Identity{Name: DefaultIdentityName}.AdminNamespace())
+	require.Equal(t, "adminvalidator1", Identity{Name: "validator1"}.AdminNamespace())
+}
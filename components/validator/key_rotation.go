@@ -0,0 +1,40 @@
+package validator
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/kroma-network/kroma/utils/service/txmgr"
+)
+
+// txManagerAt returns the tx manager of the key to submit the output at the given L2 block number with.
+// The outputs from KeyRotationBlockNumber on are submitted with the new key.
+func (c *Config) txManagerAt(blockNumber uint64) *txmgr.BufferedTxManager {
+	if c.KeyRotationTxManager != nil && blockNumber >= c.KeyRotationBlockNumber {
+		return c.KeyRotationTxManager
+	}
+	return c.TxManager
+}
+
+// txManagerOf returns the tx manager of the given address, or nil if the address is not a key of the validator.
+// Both the old and the new key are kept during a key rotation, so that the challenges in flight are handled
+// with the key that they were created with.
+func (c *Config) txManagerOf(addr common.Address) *txmgr.BufferedTxManager {
+	for _, txMgr := range c.txManagers() {
+		if txMgr.From() == addr {
+			return txMgr
+		}
+	}
+	return nil
+}
+
+// txManagers returns the tx managers of all the keys of the validator.
+func (c *Config) txManagers() []*txmgr.BufferedTxManager {
+	var txMgrs []*txmgr.BufferedTxManager
+	if c.TxManager != nil {
+		txMgrs = append(txMgrs, c.TxManager)
+	}
+	if c.KeyRotationTxManager != nil {
+		txMgrs = append(txMgrs, c.KeyRotationTxManager)
+	}
+	return txMgrs
+}
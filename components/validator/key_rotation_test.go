@@ -0,0 +1,39 @@
+package validator
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kroma-network/kroma/utils/service/txmgr"
+)
+
+func newTestTxManager(from common.Address) *txmgr.BufferedTxManager {
+	return &txmgr.BufferedTxManager{
+		SimpleTxManager: txmgr.SimpleTxManager{
+			Config: txmgr.Config{From: from},
+		},
+	}
+}
+
+func TestKeyRotation(t *testing.T) {
+	oldKey := common.HexToAddress("0x1")
+	newKey := common.HexToAddress("0x2")
+	cfg := Config{TxManager: newTestTxManager(oldKey)}
+
+	require.Equal(t, oldKey, cfg.txManagerAt(100).From())
+	require.Nil(t, cfg.txManagerOf(newKey))
+	require.Len(t, cfg.txManagers(), 1)
+
+	cfg.KeyRotationTxManager = newTestTxManager(newKey)
+	cfg.KeyRotationBlockNumber = 100
+
+	require.Equal(t, oldKey, cfg.txManagerAt(99).From())
+	require.Equal(t, newKey, cfg.txManagerAt(100).From())
+	require.Equal(t, newKey, cfg.txManagerAt(101).From())
+	require.Equal(t, oldKey, cfg.txManagerOf(oldKey).From(), "expected the old key to be kept for challenges in flight")
+	require.Equal(t, newKey, cfg.txManagerOf(newKey).From())
+	require.Nil(t, cfg.txManagerOf(common.HexToAddress("0x3")))
+	require.Len(t, cfg.txManagers(), 2)
+}
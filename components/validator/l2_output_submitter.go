@@ -5,9 +5,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math"
 	"math/big"
 	_ "net/http/pprof"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/ethereum/go-ethereum/accounts/abi"
@@ -43,12 +45,19 @@ type L2OutputSubmitter struct {
 	l2ooABI         *abi.ABI
 	valpoolContract *bindings.ValidatorPoolCaller
 
+	submissionInterval  *big.Int
 	singleRoundInterval *big.Int
 	l2BlockTime         *big.Int
 	requiredBondAmount  *big.Int
 
 	submitChan chan struct{}
 
+	// outputGap is the number of outputs not submitted yet at the last attempt, only accessed by the submission loop
+	outputGap uint64
+
+	// paused is set while the output submission is paused through the admin API
+	paused atomic.Bool
+
 	wg sync.WaitGroup
 }
 
@@ -98,6 +107,7 @@ func NewL2OutputSubmitter(ctx context.Context, cfg Config, l log.Logger, m metri
 		l2ooContract:        l2ooContract,
 		l2ooABI:             parsed,
 		valpoolContract:     valpoolContract,
+		submissionInterval:  submissionInterval,
 		singleRoundInterval: singleRoundInterval,
 		l2BlockTime:         l2BlockTime,
 		requiredBondAmount:  requiredBondAmount,
@@ -135,6 +145,21 @@ func (l *L2OutputSubmitter) loop() {
 	}
 }
 
+// Pause pauses the output submission.
+func (l *L2OutputSubmitter) Pause() {
+	l.paused.Store(true)
+}
+
+// Resume resumes the output submission after a pause.
+func (l *L2OutputSubmitter) Resume() {
+	l.paused.Store(false)
+}
+
+// Paused returns true if the output submission is paused.
+func (l *L2OutputSubmitter) Paused() bool {
+	return l.paused.Load()
+}
+
 func (l *L2OutputSubmitter) retryAfter(d time.Duration) {
 	l.wg.Add(1)
 
@@ -156,17 +181,34 @@ func (l *L2OutputSubmitter) repeatSubmitL2Output(ctx context.Context) {
 // If it needs to wait, it will calculate how long the validator should wait and
 // try again after the delay.
 func (l *L2OutputSubmitter) trySubmitL2Output(ctx context.Context) (time.Duration, error) {
+	if l.paused.Load() {
+		l.log.Debug("output submission is paused")
+		return l.cfg.OutputSubmitterRetryInterval, nil
+	}
+
 	nextBlockNumber, err := l.FetchNextBlockNumber(ctx)
 	if err != nil {
 		return l.cfg.OutputSubmitterRetryInterval, err
 	}
 
+	if err = l.detectOutputGap(ctx, nextBlockNumber); err != nil {
+		return l.cfg.OutputSubmitterRetryInterval, err
+	}
+
 	calculatedWaitTime := l.CalculateWaitTime(ctx, nextBlockNumber)
 	if calculatedWaitTime > 0 {
 		return calculatedWaitTime, nil
 	}
 
-	if err = l.doSubmitL2Output(ctx, nextBlockNumber); err != nil {
+	feeMultiplier, deferred, err := l.scheduleSubmission(ctx, nextBlockNumber)
+	if err != nil {
+		return l.cfg.OutputSubmitterRetryInterval, err
+	}
+	if deferred {
+		return l.cfg.OutputSubmitterRetryInterval, nil
+	}
+
+	if err = l.doSubmitL2Output(ctx, nextBlockNumber, feeMultiplier); err != nil {
 		return l.cfg.OutputSubmitterRetryInterval, err
 	}
 
@@ -174,8 +216,97 @@ func (l *L2OutputSubmitter) trySubmitL2Output(ctx context.Context) (time.Duratio
 	return 0, nil
 }
 
+// detectOutputGap detects the outputs that are not submitted yet, although their L2 blocks are available,
+// e.g. after a downtime of the validators. The gap is backfilled in order from the next block number,
+// whenever the round permits the validator to submit, and it is reported when it grows.
+func (l *L2OutputSubmitter) detectOutputGap(ctx context.Context, nextBlockNumber *big.Int) error {
+	currentBlockNumber, err := l.FetchCurrentBlockNumber(ctx)
+	if err != nil {
+		return err
+	}
+
+	gap := outputGap(nextBlockNumber.Uint64(), currentBlockNumber.Uint64(), l.submissionInterval.Uint64())
+	l.metr.RecordOutputGap(gap)
+	if gap > 1 && gap > l.outputGap {
+		l.log.Warn("found outputs not submitted, backfilling", "gap", gap, "nextBlockNumber", nextBlockNumber, "currentBlockNumber", currentBlockNumber)
+	} else if gap <= 1 && l.outputGap > 1 {
+		l.log.Info("backfilled outputs not submitted", "nextBlockNumber", nextBlockNumber)
+	}
+	l.outputGap = gap
+
+	return nil
+}
+
+// outputGap returns the number of outputs that can be submitted at the current L2 block number.
+// The output at a block number can be submitted from the block number plus 1, because of next block hash inclusion.
+func outputGap(nextBlockNumber, currentBlockNumber, submissionInterval uint64) uint64 {
+	if submissionInterval == 0 || currentBlockNumber <= nextBlockNumber {
+		return 0
+	}
+	return (currentBlockNumber-nextBlockNumber-1)/submissionInterval + 1
+}
+
+// scheduleSubmission applies the submission schedule at the current progress of the submission window.
+// It returns the multiplier of the gas tip cap to submit with, or true if the submission should be deferred.
+func (l *L2OutputSubmitter) scheduleSubmission(ctx context.Context, nextBlockNumber *big.Int) (float64, bool, error) {
+	schedule := l.cfg.OutputSubmitterSchedule
+	if !schedule.Enabled() {
+		return 1, false, nil
+	}
+
+	progress, err := l.submissionWindowProgress(ctx, nextBlockNumber)
+	if err != nil {
+		return 0, false, err
+	}
+
+	cCtx, cCancel := context.WithTimeout(ctx, l.cfg.NetworkTimeout)
+	defer cCancel()
+	head, err := l.cfg.L1Client.HeaderByNumber(cCtx, nil)
+	if err != nil {
+		return 0, false, fmt.Errorf("failed to get L1 basefee: %w", err)
+	}
+
+	if schedule.ShouldDefer(head.BaseFee, progress) {
+		l.log.Info("deferring output submission because of high L1 basefee", "baseFee", head.BaseFee,
+			"maxBaseFee", schedule.MaxBaseFee, "progress", progress)
+		return 0, true, nil
+	}
+
+	feeMultiplier := schedule.FeeMultiplier(progress)
+	l.log.Info("scheduled output submission", "baseFee", head.BaseFee, "progress", progress, "feeMultiplier", feeMultiplier)
+	return feeMultiplier, false, nil
+}
+
+// submissionWindowProgress returns the progress in [0, 1] of the submission window of the output, from the first block
+// the output can be submitted at until the end of the priority round. It is 1 in the public round,
+// as the output is submitted by any validator then.
+func (l *L2OutputSubmitter) submissionWindowProgress(ctx context.Context, nextBlockNumber *big.Int) (float64, error) {
+	roundInfo, err := l.fetchCurrentRound(ctx, l.cfg.txManagerAt(nextBlockNumber.Uint64()).From())
+	if err != nil {
+		return 0, err
+	}
+	if roundInfo.isPublicRound {
+		return 1, nil
+	}
+
+	currentBlockNumber, err := l.FetchCurrentBlockNumber(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	// the output can be submitted from the next block number plus 1, because of next block hash inclusion
+	start := new(big.Int).Add(nextBlockNumber, common.Big1)
+	elapsed := new(big.Int).Sub(currentBlockNumber, start)
+	window := new(big.Int).Sub(l.singleRoundInterval, common.Big1)
+	if elapsed.Sign() <= 0 || window.Sign() <= 0 {
+		return 0, nil
+	}
+	progress, _ := new(big.Float).Quo(new(big.Float).SetInt(elapsed), new(big.Float).SetInt(window)).Float64()
+	return math.Min(progress, 1), nil
+}
+
 // doSubmitL2Output submits l2 Output submission transaction.
-func (l *L2OutputSubmitter) doSubmitL2Output(ctx context.Context, nextBlockNumber *big.Int) error {
+func (l *L2OutputSubmitter) doSubmitL2Output(ctx context.Context, nextBlockNumber *big.Int, feeMultiplier float64) error {
 	output, err := l.FetchOutput(ctx, nextBlockNumber)
 	if err != nil {
 		return err
@@ -186,12 +317,13 @@ func (l *L2OutputSubmitter) doSubmitL2Output(ctx context.Context, nextBlockNumbe
 		return fmt.Errorf("failed to create submit l2 output transaction data: %w", err)
 	}
 
-	if txResponse := l.submitL2OutputTx(data); txResponse.Err != nil {
+	txMgr := l.cfg.txManagerAt(nextBlockNumber.Uint64())
+	if txResponse := l.submitL2OutputTx(txMgr, data, feeMultiplier); txResponse.Err != nil {
 		return txResponse.Err
 	}
 
 	// Successfully submitted
-	l.log.Info("L2output successfully submitted", "blockNumber", output.BlockRef.Number)
+	l.log.Info("L2output successfully submitted", "blockNumber", output.BlockRef.Number, "from", txMgr.From())
 	l.metr.RecordL2OutputSubmitted(output.BlockRef)
 	// go to try next submission immediately
 	return nil
@@ -207,7 +339,9 @@ func (l *L2OutputSubmitter) CalculateWaitTime(ctx context.Context, nextBlockNumb
 		return defaultWaitTime
 	}
 
-	hasEnoughDeposit, err := l.HasEnoughDeposit(ctx)
+	// the outputs from the key rotation block number on are submitted with the new key
+	from := l.cfg.txManagerAt(nextBlockNumber.Uint64()).From()
+	hasEnoughDeposit, err := l.HasEnoughDeposit(ctx, from)
 	if err != nil {
 		return defaultWaitTime
 	}
@@ -227,7 +361,7 @@ func (l *L2OutputSubmitter) CalculateWaitTime(ctx context.Context, nextBlockNumb
 	}
 
 	// Check if it's a public round, or selected for priority validator
-	roundInfo, err := l.fetchCurrentRound(ctx)
+	roundInfo, err := l.fetchCurrentRound(ctx, from)
 	if err != nil {
 		return defaultWaitTime
 	}
@@ -243,11 +377,10 @@ func (l *L2OutputSubmitter) CalculateWaitTime(ctx context.Context, nextBlockNumb
 	return 0
 }
 
-// HasEnoughDeposit checks if validator has enough deposit to bond when trying output submission.
-func (l *L2OutputSubmitter) HasEnoughDeposit(ctx context.Context) (bool, error) {
+// HasEnoughDeposit checks if the validator key of the given address has enough deposit to bond when trying output submission.
+func (l *L2OutputSubmitter) HasEnoughDeposit(ctx context.Context, from common.Address) (bool, error) {
 	cCtx, cCancel := context.WithTimeout(ctx, l.cfg.NetworkTimeout)
 	defer cCancel()
-	from := l.cfg.TxManager.From()
 	balance, err := l.valpoolContract.BalanceOf(utils.NewSimpleCallOpts(cCtx), from)
 	if err != nil {
 		return false, fmt.Errorf("failed to fetch deposit amount: %w", err)
@@ -327,8 +460,8 @@ func (r *roundInfo) canJoinRound() bool {
 }
 
 // fetchCurrentRound fetches next validator address from ValidatorPool contract.
-// It returns if current round is public round, and if selected for priority validator if it's a priority round.
-func (l *L2OutputSubmitter) fetchCurrentRound(ctx context.Context) (roundInfo, error) {
+// It returns if current round is public round, and if the given address is selected for priority validator if it's a priority round.
+func (l *L2OutputSubmitter) fetchCurrentRound(ctx context.Context, from common.Address) (roundInfo, error) {
 	cCtx, cCancel := context.WithTimeout(ctx, l.cfg.NetworkTimeout)
 	defer cCancel()
 	nextValidator, err := l.valpoolContract.NextValidator(utils.NewSimpleCallOpts(cCtx))
@@ -350,7 +483,7 @@ func (l *L2OutputSubmitter) fetchCurrentRound(ctx context.Context) (roundInfo, e
 		}, nil
 	}
 
-	if nextValidator == l.cfg.TxManager.From() {
+	if nextValidator == from {
 		l.log.Info("current round is priority round, and selected for priority validator")
 		return roundInfo{
 			isPublicRound:       false,
@@ -399,7 +532,8 @@ func SubmitL2OutputTxData(abi *abi.ABI, output *eth.OutputResponse) ([]byte, err
 }
 
 // submitL2OutputTx creates l2 output submit tx candidate and sends it to txCandidates channel to process validator's tx candidates in order.
-func (l *L2OutputSubmitter) submitL2OutputTx(data []byte) *txmgr.TxResponse {
+// The suggested gas tip cap is multiplied by the given fee multiplier.
+func (l *L2OutputSubmitter) submitL2OutputTx(txMgr *txmgr.BufferedTxManager, data []byte, feeMultiplier float64) *txmgr.TxResponse {
 	layout, err := bindings.GetStorageLayout("ValidatorPool")
 	if err != nil {
 		return &txmgr.TxResponse{
@@ -428,11 +562,13 @@ func (l *L2OutputSubmitter) submitL2OutputTx(data []byte) *txmgr.TxResponse {
 		},
 	}
 
-	return l.cfg.TxManager.SendTxCandidate(l.ctx, &txmgr.TxCandidate{
+	return txMgr.SendTxCandidate(l.ctx, &txmgr.TxCandidate{
 		TxData:     data,
 		To:         &l.cfg.L2OutputOracleAddr,
 		GasLimit:   0,
 		AccessList: accessList,
+
+		GasTipCapMultiplier: feeMultiplier,
 	})
 }
 
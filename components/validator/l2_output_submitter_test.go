@@ -0,0 +1,26 @@
+package validator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestOutputGap(t *testing.T) {
+	tests := []struct {
+		next     uint64
+		current  uint64
+		expected uint64
+	}{
+		{next: 100, current: 90, expected: 0},
+		{next: 100, current: 100, expected: 0},
+		{next: 100, current: 101, expected: 1},
+		{next: 100, current: 200, expected: 1},
+		{next: 100, current: 201, expected: 2},
+		{next: 100, current: 1000, expected: 9},
+	}
+	for _, test := range tests {
+		require.Equal(t, test.expected, outputGap(test.next, test.current, 100), "next: %d, current: %d", test.next, test.current)
+	}
+	require.Zero(t, outputGap(100, 1000, 0))
+}
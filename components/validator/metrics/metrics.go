@@ -3,6 +3,7 @@ package metrics
 import (
 	"context"
 	"math/big"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/ethclient"
@@ -10,6 +11,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 
 	"github.com/kroma-network/kroma/components/node/eth"
+	chal "github.com/kroma-network/kroma/components/validator/challenge"
 	kmetrics "github.com/kroma-network/kroma/utils/service/metrics"
 	txmetrics "github.com/kroma-network/kroma/utils/service/txmgr/metrics"
 )
@@ -29,10 +31,21 @@ type Metricer interface {
 	// Record Tx metrics
 	txmetrics.TxMetricer
 
+	// Record proof generation metrics
+	chal.Metricer
+
 	RecordL2OutputSubmitted(l2ref eth.L2BlockRef)
 	RecordDepositAmount(amount *big.Int)
 	RecordNextValidator(address common.Address)
 	RecordChallengeCheckpoint(outputIndex *big.Int)
+	RecordOutputValidated(outputIndex *big.Int)
+	RecordInvalidOutput(outputIndex *big.Int)
+	RecordOutputGap(gap uint64)
+	RecordPenalty(kind string, amount *big.Int)
+	RecordRewardBalance(amount *big.Int)
+	RecordRewardClaimed(amount *big.Int)
+	RecordRewardSwept(amount *big.Int)
+	RecordChallengeQueueWait(wait time.Duration)
 }
 
 type Metrics struct {
@@ -48,17 +61,35 @@ type Metrics struct {
 	DepositAmount       prometheus.Gauge
 	NextValidator       prometheus.GaugeVec
 	ChallengeCheckpoint prometheus.Gauge
+	ValidatedOutput     prometheus.Gauge
+	InvalidOutput       prometheus.Gauge
+	InvalidOutputs      prometheus.Counter
+	OutputGap           prometheus.Gauge
+	Penalties           prometheus.CounterVec
+	PenaltyAmount       prometheus.CounterVec
+	RewardBalance       prometheus.Gauge
+	RewardClaimed       prometheus.Counter
+	RewardSwept         prometheus.Counter
+	ProverStepDuration  prometheus.HistogramVec
+	ProverFailures      prometheus.CounterVec
+	ProverTimeout       prometheus.Gauge
+	ChallengeQueueWait  prometheus.Histogram
 }
 
 var _ Metricer = (*Metrics)(nil)
 
 func NewMetrics(procName string) *Metrics {
+	return NewMetricsWithRegistry(procName, kmetrics.NewRegistry())
+}
+
+// NewMetricsWithRegistry creates the metrics of the process in the given registry,
+// so that the metrics of multiple validator identities are served together.
+func NewMetricsWithRegistry(procName string, registry *prometheus.Registry) *Metrics {
 	if procName == "" {
 		procName = "default"
 	}
 	ns := Namespace + "_" + procName
 
-	registry := kmetrics.NewRegistry()
 	factory := kmetrics.With(registry)
 
 	return &Metrics{
@@ -98,6 +129,81 @@ func NewMetrics(procName string) *Metrics {
 			Name:      "challenge_checkpoint",
 			Help:      "The output index that the challenge function last checked",
 		}),
+		ValidatedOutput: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: ns,
+			Name:      "validated_output",
+			Help:      "The output index that was last confirmed to be valid",
+		}),
+		InvalidOutput: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: ns,
+			Name:      "invalid_output",
+			Help:      "The output index that was last found to be invalid",
+		}),
+		InvalidOutputs: factory.NewCounter(prometheus.CounterOpts{
+			Namespace: ns,
+			Name:      "invalid_outputs_total",
+			Help:      "The number of invalid outputs found",
+		}),
+		OutputGap: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: ns,
+			Name:      "output_gap",
+			Help:      "The number of outputs whose L2 blocks are available but not submitted yet",
+		}),
+		Penalties: *factory.NewCounterVec(prometheus.CounterOpts{
+			Namespace: ns,
+			Name:      "penalties_total",
+			Help:      "The number of penalties on the bonds of the validator",
+		}, []string{
+			"type",
+		}),
+		PenaltyAmount: *factory.NewCounterVec(prometheus.CounterOpts{
+			Namespace: ns,
+			Name:      "penalty_amount_total",
+			Help:      "The amount of the bonds of the validator lost by penalties",
+		}, []string{
+			"type",
+		}),
+		RewardBalance: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: ns,
+			Name:      "reward_balance",
+			Help:      "The unclaimed reward balance in the ValidatorRewardVault contract",
+		}),
+		RewardClaimed: factory.NewCounter(prometheus.CounterOpts{
+			Namespace: ns,
+			Name:      "reward_claimed_total",
+			Help:      "The amount of rewards claimed from the ValidatorRewardVault contract",
+		}),
+		RewardSwept: factory.NewCounter(prometheus.CounterOpts{
+			Namespace: ns,
+			Name:      "reward_swept_total",
+			Help:      "The amount transferred to the cold address",
+		}),
+		ProverStepDuration: *factory.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: ns,
+			Name:      "prover_step_duration_seconds",
+			Help:      "The duration of each step of the proof generation",
+			Buckets:   prometheus.ExponentialBuckets(0.1, 4, 10),
+		}, []string{
+			"step",
+		}),
+		ProverFailures: *factory.NewCounterVec(prometheus.CounterOpts{
+			Namespace: ns,
+			Name:      "prover_failures_total",
+			Help:      "The number of failed proof requests by reason",
+		}, []string{
+			"reason",
+		}),
+		ProverTimeout: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: ns,
+			Name:      "prover_timeout_seconds",
+			Help:      "The timeout of the last proof request",
+		}),
+		ChallengeQueueWait: factory.NewHistogram(prometheus.HistogramOpts{
+			Namespace: ns,
+			Name:      "challenge_queue_wait_seconds",
+			Help:      "The time a challenge step waits for a worker before it is taken",
+			Buckets:   prometheus.ExponentialBuckets(0.01, 4, 10),
+		}),
 	}
 }
 
@@ -142,3 +248,60 @@ func (m *Metrics) RecordNextValidator(address common.Address) {
 func (m *Metrics) RecordChallengeCheckpoint(outputIndex *big.Int) {
 	m.ChallengeCheckpoint.Set(float64(outputIndex.Uint64()))
 }
+
+// RecordOutputValidated sets the output index that was last confirmed to be valid.
+func (m *Metrics) RecordOutputValidated(outputIndex *big.Int) {
+	m.ValidatedOutput.Set(float64(outputIndex.Uint64()))
+}
+
+// RecordInvalidOutput sets the output index that was last found to be invalid, and counts the invalid outputs.
+func (m *Metrics) RecordInvalidOutput(outputIndex *big.Int) {
+	m.InvalidOutput.Set(float64(outputIndex.Uint64()))
+	m.InvalidOutputs.Inc()
+}
+
+// RecordOutputGap sets the number of outputs whose L2 blocks are available but not submitted yet.
+func (m *Metrics) RecordOutputGap(gap uint64) {
+	m.OutputGap.Set(float64(gap))
+}
+
+// RecordPenalty counts a penalty on the bonds of the validator and the amount lost by it.
+func (m *Metrics) RecordPenalty(kind string, amount *big.Int) {
+	m.Penalties.WithLabelValues(kind).Inc()
+	m.PenaltyAmount.WithLabelValues(kind).Add(kmetrics.WeiToEther(amount))
+}
+
+// RecordRewardBalance sets the unclaimed reward balance in the ValidatorRewardVault contract.
+func (m *Metrics) RecordRewardBalance(amount *big.Int) {
+	m.RewardBalance.Set(kmetrics.WeiToEther(amount))
+}
+
+// RecordRewardClaimed counts the amount of rewards claimed from the ValidatorRewardVault contract.
+func (m *Metrics) RecordRewardClaimed(amount *big.Int) {
+	m.RewardClaimed.Add(kmetrics.WeiToEther(amount))
+}
+
+// RecordRewardSwept counts the amount transferred to the cold address.
+func (m *Metrics) RecordRewardSwept(amount *big.Int) {
+	m.RewardSwept.Add(kmetrics.WeiToEther(amount))
+}
+
+// RecordProverStep observes the duration of a step of the proof generation.
+func (m *Metrics) RecordProverStep(step string, duration time.Duration) {
+	m.ProverStepDuration.WithLabelValues(step).Observe(duration.Seconds())
+}
+
+// RecordProverFailure counts a failed proof request by reason.
+func (m *Metrics) RecordProverFailure(reason string) {
+	m.ProverFailures.WithLabelValues(reason).Inc()
+}
+
+// RecordProverTimeout sets the timeout of the last proof request.
+func (m *Metrics) RecordProverTimeout(timeout time.Duration) {
+	m.ProverTimeout.Set(timeout.Seconds())
+}
+
+// RecordChallengeQueueWait observes the time a challenge step waits for a worker.
+func (m *Metrics) RecordChallengeQueueWait(wait time.Duration) {
+	m.ChallengeQueueWait.Observe(wait.Seconds())
+}
@@ -2,6 +2,7 @@ package metrics
 
 import (
 	"math/big"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 
@@ -20,7 +21,18 @@ var NoopMetrics Metricer = new(noopMetrics)
 func (*noopMetrics) RecordInfo(version string) {}
 func (*noopMetrics) RecordUp()                 {}
 
-func (*noopMetrics) RecordL2OutputSubmitted(l2ref eth.L2BlockRef)   {}
-func (*noopMetrics) RecordDepositAmount(amount *big.Int)            {}
-func (*noopMetrics) RecordNextValidator(address common.Address)     {}
-func (*noopMetrics) RecordChallengeCheckpoint(outputIndex *big.Int) {}
+func (*noopMetrics) RecordL2OutputSubmitted(l2ref eth.L2BlockRef)         {}
+func (*noopMetrics) RecordDepositAmount(amount *big.Int)                  {}
+func (*noopMetrics) RecordNextValidator(address common.Address)           {}
+func (*noopMetrics) RecordChallengeCheckpoint(outputIndex *big.Int)       {}
+func (*noopMetrics) RecordOutputValidated(outputIndex *big.Int)           {}
+func (*noopMetrics) RecordInvalidOutput(outputIndex *big.Int)             {}
+func (*noopMetrics) RecordOutputGap(gap uint64)                           {}
+func (*noopMetrics) RecordPenalty(kind string, amount *big.Int)           {}
+func (*noopMetrics) RecordRewardBalance(amount *big.Int)                  {}
+func (*noopMetrics) RecordRewardClaimed(amount *big.Int)                  {}
+func (*noopMetrics) RecordRewardSwept(amount *big.Int)                    {}
+func (*noopMetrics) RecordProverStep(step string, duration time.Duration) {}
+func (*noopMetrics) RecordProverFailure(reason string)                    {}
+func (*noopMetrics) RecordProverTimeout(timeout time.Duration)            {}
+func (*noopMetrics) RecordChallengeQueueWait(wait time.Duration)          {}
@@ -0,0 +1,254 @@
+package validator
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/kroma-network/kroma/bindings/bindings"
+	"github.com/kroma-network/kroma/components/validator/metrics"
+	"github.com/kroma-network/kroma/utils"
+	"github.com/kroma-network/kroma/utils/service/watcher"
+)
+
+const (
+	// PenaltyOutputReplaced is the penalty of the asserter when its output is proven to be invalid.
+	// The bond of the output is taken by the challenger.
+	PenaltyOutputReplaced = "output_replaced"
+	// PenaltyPendingBondForfeited is the penalty of the challenger when it is timed out or its challenge is dismissed.
+	// The pending bond of the challenger is added to the bond of the output, after tax.
+	PenaltyPendingBondForfeited = "pending_bond_forfeited"
+)
+
+// penaltyAlert is the payload of the alert pushed to the webhook.
+type penaltyAlert struct {
+	Type        string         `json:"type"`
+	Validator   common.Address `json:"validator"`
+	OutputIndex uint64         `json:"output_index"`
+	Amount      string         `json:"amount"`
+	BlockNumber uint64         `json:"block_number"`
+	TxHash      common.Hash    `json:"tx_hash"`
+}
+
+// PenaltyMonitor watches the penalties on the bonds of the monitored validator addresses,
+// records them as metrics and pushes alerts to the webhook if configured.
+type PenaltyMonitor struct {
+	log    log.Logger
+	cfg    Config
+	metr   metrics.Metricer
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+
+	l2ooContract    *bindings.L2OutputOracleFilterer
+	valpoolContract *bindings.ValidatorPoolFilterer
+	httpClient      *http.Client
+
+	requiredBondAmount        *big.Int
+	finalizationPeriodSeconds *big.Int
+
+	outputReplacedWatcher *watcher.Watcher[bindings.L2OutputOracleOutputReplaced]
+	bondIncreasedWatcher  *watcher.Watcher[bindings.ValidatorPoolBondIncreased]
+	outputReplacedChan    chan *bindings.L2OutputOracleOutputReplaced
+	bondIncreasedChan     chan *bindings.ValidatorPoolBondIncreased
+}
+
+// NewPenaltyMonitor creates a new PenaltyMonitor.
+func NewPenaltyMonitor(ctx context.Context, cfg Config, l log.Logger, m metrics.Metricer) (*PenaltyMonitor, error) {
+	l2ooContract, err := bindings.NewL2OutputOracle(cfg.L2OutputOracleAddr, cfg.L1Client)
+	if err != nil {
+		return nil, err
+	}
+
+	valpoolContract, err := bindings.NewValidatorPool(cfg.ValidatorPoolAddr, cfg.L1Client)
+	if err != nil {
+		return nil, err
+	}
+
+	cCtx, cCancel := context.WithTimeout(ctx, cfg.NetworkTimeout)
+	defer cCancel()
+	requiredBondAmount, err := valpoolContract.REQUIREDBONDAMOUNT(utils.NewSimpleCallOpts(cCtx))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get required bond amount: %w", err)
+	}
+
+	cCtx, cCancel = context.WithTimeout(ctx, cfg.NetworkTimeout)
+	defer cCancel()
+	finalizationPeriodSeconds, err := l2ooContract.FINALIZATIONPERIODSECONDS(utils.NewSimpleCallOpts(cCtx))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get finalization period seconds: %w", err)
+	}
+
+	p := &PenaltyMonitor{
+		log:                       l.New("service", "penalty-monitor"),
+		cfg:                       cfg,
+		metr:                      m,
+		l2ooContract:              &l2ooContract.L2OutputOracleFilterer,
+		valpoolContract:           &valpoolContract.ValidatorPoolFilterer,
+		httpClient:                &http.Client{Timeout: cfg.NetworkTimeout},
+		requiredBondAmount:        requiredBondAmount,
+		finalizationPeriodSeconds: finalizationPeriodSeconds,
+		outputReplacedChan:        make(chan *bindings.L2OutputOracleOutputReplaced),
+		bondIncreasedChan:         make(chan *bindings.ValidatorPoolBondIncreased),
+	}
+	if err := p.initWatchers(); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+func (p *PenaltyMonitor) Start(ctx context.Context) error {
+	p.ctx, p.cancel = context.WithCancel(ctx)
+
+	p.outputReplacedWatcher.Start(p.ctx)
+	p.bondIncreasedWatcher.Start(p.ctx)
+
+	p.wg.Add(1)
+	go p.loop()
+
+	return nil
+}
+
+func (p *PenaltyMonitor) Stop() error {
+	p.outputReplacedWatcher.Stop()
+	p.bondIncreasedWatcher.Stop()
+
+	p.cancel()
+	p.wg.Wait()
+
+	close(p.outputReplacedChan)
+	close(p.bondIncreasedChan)
+
+	return nil
+}
+
+// initWatchers creates the watchers of the penalty events, which also deliver the events
+// emitted while the subscriptions were down.
+func (p *PenaltyMonitor) initWatchers() error {
+	l2ooABI, err := bindings.L2OutputOracleMetaData.GetAbi()
+	if err != nil {
+		return err
+	}
+	p.outputReplacedWatcher, err = watcher.NewWatcher(p.log, p.cfg.L1Client, watcher.Config{},
+		watcher.Filter{Address: p.cfg.L2OutputOracleAddr, ABI: l2ooABI, Event: "OutputReplaced"},
+		p.l2ooContract.ParseOutputReplaced, p.outputReplacedChan)
+	if err != nil {
+		return err
+	}
+
+	valpoolABI, err := bindings.ValidatorPoolMetaData.GetAbi()
+	if err != nil {
+		return err
+	}
+	var challengerRule []interface{}
+	for _, addr := range p.cfg.PenaltyMonitorAddrs {
+		challengerRule = append(challengerRule, addr)
+	}
+	p.bondIncreasedWatcher, err = watcher.NewWatcher(p.log, p.cfg.L1Client, watcher.Config{},
+		watcher.Filter{Address: p.cfg.ValidatorPoolAddr, ABI: valpoolABI, Event: "BondIncreased", Query: [][]interface{}{nil, challengerRule}},
+		p.valpoolContract.ParseBondIncreased, p.bondIncreasedChan)
+	return err
+}
+
+func (p *PenaltyMonitor) loop() {
+	defer p.wg.Done()
+
+	for {
+		select {
+		case ev := <-p.outputReplacedChan:
+			if ev.Raw.Removed {
+				p.log.Warn("OutputReplaced event is reorged out", "outputIndex", ev.OutputIndex, "txHash", ev.Raw.TxHash)
+				continue
+			}
+			if err := p.handleOutputReplaced(ev); err != nil {
+				p.log.Error("failed to handle OutputReplaced event", "err", err, "outputIndex", ev.OutputIndex)
+			}
+		case ev := <-p.bondIncreasedChan:
+			if ev.Raw.Removed {
+				p.log.Warn("BondIncreased event is reorged out", "outputIndex", ev.OutputIndex, "txHash", ev.Raw.TxHash)
+				continue
+			}
+			p.reportPenalty(PenaltyPendingBondForfeited, ev.Challenger, ev.OutputIndex, p.requiredBondAmount, ev.Raw.BlockNumber, ev.Raw.TxHash)
+		case <-p.ctx.Done():
+			return
+		}
+	}
+}
+
+// handleOutputReplaced reports the penalty if the replaced output was submitted by a monitored address.
+// The submitter of the output is found by the Bonded event of the output, which is emitted when the output is submitted
+// within the finalization period before the replacement.
+func (p *PenaltyMonitor) handleOutputReplaced(ev *bindings.L2OutputOracleOutputReplaced) error {
+	// TODO(0xHansLee): add L1BlockTime to rollup config and change to use it
+	finalizationBlocks := new(big.Int).Div(p.finalizationPeriodSeconds, big.NewInt(12)).Uint64()
+	var start uint64
+	if ev.Raw.BlockNumber > finalizationBlocks {
+		start = ev.Raw.BlockNumber - finalizationBlocks
+	}
+	end := ev.Raw.BlockNumber
+
+	cCtx, cCancel := context.WithTimeout(p.ctx, p.cfg.NetworkTimeout)
+	defer cCancel()
+	it, err := p.valpoolContract.FilterBonded(&bind.FilterOpts{Start: start, End: &end, Context: cCtx}, p.cfg.PenaltyMonitorAddrs, []*big.Int{ev.OutputIndex})
+	if err != nil {
+		return fmt.Errorf("failed to filter Bonded events: %w", err)
+	}
+	defer it.Close()
+
+	for it.Next() {
+		p.reportPenalty(PenaltyOutputReplaced, it.Event.Submitter, ev.OutputIndex, it.Event.Amount, ev.Raw.BlockNumber, ev.Raw.TxHash)
+	}
+	return it.Error()
+}
+
+func (p *PenaltyMonitor) reportPenalty(kind string, validator common.Address, outputIndex *big.Int, amount *big.Int, blockNumber uint64, txHash common.Hash) {
+	p.log.Error("validator penalized", "type", kind, "validator", validator, "outputIndex", outputIndex, "amount", amount, "txHash", txHash)
+	p.metr.RecordPenalty(kind, amount)
+
+	if p.cfg.PenaltyMonitorWebhookURL == "" {
+		return
+	}
+	alert := penaltyAlert{
+		Type:        kind,
+		Validator:   validator,
+		OutputIndex: outputIndex.Uint64(),
+		Amount:      amount.String(),
+		BlockNumber: blockNumber,
+		TxHash:      txHash,
+	}
+	if err := p.pushAlert(alert); err != nil {
+		p.log.Error("failed to push penalty alert", "err", err, "type", kind, "outputIndex", outputIndex)
+	}
+}
+
+// pushAlert posts the alert to the webhook as JSON.
+func (p *PenaltyMonitor) pushAlert(alert penaltyAlert) error {
+	body, err := json.Marshal(alert)
+	if err != nil {
+		return fmt.Errorf("failed to encode alert: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(p.ctx, http.MethodPost, p.cfg.PenaltyMonitorWebhookURL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create alert request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send alert: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		return fmt.Errorf("webhook responded with status %d", resp.StatusCode)
+	}
+	return nil
+}
@@ -0,0 +1,46 @@
+package validator
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPenaltyMonitorPushAlert(t *testing.T) {
+	alerts := make(chan penaltyAlert, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		require.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		var alert penaltyAlert
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&alert))
+		alerts <- alert
+	}))
+	defer server.Close()
+
+	p := &PenaltyMonitor{
+		cfg:        Config{PenaltyMonitorWebhookURL: server.URL},
+		ctx:        context.Background(),
+		httpClient: &http.Client{Timeout: time.Second},
+	}
+	alert := penaltyAlert{
+		Type:        PenaltyOutputReplaced,
+		Validator:   common.HexToAddress("0x1"),
+		OutputIndex: 3,
+		Amount:      "100",
+		BlockNumber: 10,
+		TxHash:      common.HexToHash("0x2"),
+	}
+	require.NoError(t, p.pushAlert(alert))
+	require.Equal(t, alert, <-alerts)
+
+	server.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+	require.Error(t, p.pushAlert(alert))
+}
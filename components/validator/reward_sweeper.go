@@ -0,0 +1,179 @@
+package validator
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/kroma-network/kroma/bindings/bindings"
+	"github.com/kroma-network/kroma/bindings/predeploys"
+	"github.com/kroma-network/kroma/components/validator/metrics"
+	"github.com/kroma-network/kroma/utils"
+	"github.com/kroma-network/kroma/utils/service/txmgr"
+)
+
+// RewardSweeper periodically claims the validator rewards accrued in the ValidatorRewardVault on L2,
+// which bridges them to the validator account on L1, and transfers the L1 balance of the validator
+// above the configured threshold to the cold address.
+// The bridged rewards are transferred once the withdrawal is finalized on L1.
+type RewardSweeper struct {
+	log    log.Logger
+	cfg    Config
+	metr   metrics.Metricer
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+
+	vaultContract *bindings.ValidatorRewardVaultCaller
+	vaultABI      *abi.ABI
+
+	minWithdrawalAmount *big.Int
+}
+
+// NewRewardSweeper creates a new RewardSweeper.
+func NewRewardSweeper(ctx context.Context, cfg Config, l log.Logger, m metrics.Metricer) (*RewardSweeper, error) {
+	vaultContract, err := bindings.NewValidatorRewardVaultCaller(predeploys.ValidatorRewardVaultAddr, cfg.L2Client)
+	if err != nil {
+		return nil, err
+	}
+
+	vaultABI, err := bindings.ValidatorRewardVaultMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+
+	cCtx, cCancel := context.WithTimeout(ctx, cfg.NetworkTimeout)
+	defer cCancel()
+	minWithdrawalAmount, err := vaultContract.MINWITHDRAWALAMOUNT(utils.NewSimpleCallOpts(cCtx))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get min withdrawal amount: %w", err)
+	}
+
+	return &RewardSweeper{
+		log:                 l.New("service", "reward-sweeper"),
+		cfg:                 cfg,
+		metr:                m,
+		vaultContract:       vaultContract,
+		vaultABI:            vaultABI,
+		minWithdrawalAmount: minWithdrawalAmount,
+	}, nil
+}
+
+func (s *RewardSweeper) Start(ctx context.Context) error {
+	s.ctx, s.cancel = context.WithCancel(ctx)
+
+	s.wg.Add(1)
+	go s.loop()
+
+	return nil
+}
+
+func (s *RewardSweeper) Stop() error {
+	s.cancel()
+	s.wg.Wait()
+
+	return nil
+}
+
+func (s *RewardSweeper) loop() {
+	defer s.wg.Done()
+
+	ticker := time.NewTicker(s.cfg.RewardSweeperInterval)
+	defer ticker.Stop()
+
+	for ; ; <-ticker.C {
+		select {
+		case <-s.ctx.Done():
+			return
+		default:
+			if err := s.claimRewards(); err != nil {
+				s.log.Error("failed to claim rewards", "err", err)
+			}
+			if err := s.sweepBalance(); err != nil {
+				s.log.Error("failed to sweep balance", "err", err)
+			}
+		}
+	}
+}
+
+// claimRewards withdraws the accrued rewards from the ValidatorRewardVault, if they reach the min withdrawal amount.
+func (s *RewardSweeper) claimRewards() error {
+	from := s.cfg.RewardSweeperTxManager.From()
+
+	cCtx, cCancel := context.WithTimeout(s.ctx, s.cfg.NetworkTimeout)
+	defer cCancel()
+	reward, err := s.vaultContract.BalanceOf(utils.NewSimpleCallOpts(cCtx), from)
+	if err != nil {
+		return fmt.Errorf("failed to get reward balance: %w", err)
+	}
+	s.metr.RecordRewardBalance(reward)
+
+	if reward.Sign() == 0 || reward.Cmp(s.minWithdrawalAmount) == -1 {
+		s.log.Debug("reward is less than min withdrawal amount", "reward", reward, "min", s.minWithdrawalAmount)
+		return nil
+	}
+
+	data, err := s.vaultABI.Pack("withdraw")
+	if err != nil {
+		return fmt.Errorf("failed to create withdraw transaction data: %w", err)
+	}
+
+	receipt, err := s.cfg.RewardSweeperTxManager.Send(s.ctx, txmgr.TxCandidate{
+		TxData:   data,
+		To:       &predeploys.ValidatorRewardVaultAddr,
+		GasLimit: 0,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to send withdraw transaction: %w", err)
+	}
+
+	s.metr.RecordRewardClaimed(reward)
+	s.log.Info("claimed rewards", "amount", reward, "txHash", receipt.TxHash)
+
+	return nil
+}
+
+// sweepBalance transfers the L1 balance of the validator above the threshold to the cold address.
+func (s *RewardSweeper) sweepBalance() error {
+	from := s.cfg.TxManager.From()
+
+	cCtx, cCancel := context.WithTimeout(s.ctx, s.cfg.NetworkTimeout)
+	defer cCancel()
+	balance, err := s.cfg.L1Client.BalanceAt(cCtx, from, nil)
+	if err != nil {
+		return fmt.Errorf("failed to get balance: %w", err)
+	}
+
+	amount := sweepAmount(balance, s.cfg.RewardSweeperThreshold)
+	if amount == nil {
+		s.log.Debug("balance does not exceed threshold", "balance", balance, "threshold", s.cfg.RewardSweeperThreshold)
+		return nil
+	}
+
+	txResponse := s.cfg.TxManager.SendTxCandidate(s.ctx, &txmgr.TxCandidate{
+		To:       &s.cfg.RewardSweeperColdAddr,
+		GasLimit: 0,
+		Value:    amount,
+	})
+	if txResponse.Err != nil {
+		return fmt.Errorf("failed to send transfer transaction: %w", txResponse.Err)
+	}
+
+	s.metr.RecordRewardSwept(amount)
+	s.log.Info("swept balance to cold address", "amount", amount, "to", s.cfg.RewardSweeperColdAddr, "txHash", txResponse.Receipt.TxHash)
+
+	return nil
+}
+
+// sweepAmount returns the amount of the balance above the threshold, or nil if the balance does not exceed it.
+func sweepAmount(balance, threshold *big.Int) *big.Int {
+	if balance.Cmp(threshold) <= 0 {
+		return nil
+	}
+	return new(big.Int).Sub(balance, threshold)
+}
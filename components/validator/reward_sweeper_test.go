@@ -0,0 +1,17 @@
+package validator
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSweepAmount(t *testing.T) {
+	threshold := big.NewInt(100)
+
+	require.Nil(t, sweepAmount(big.NewInt(99), threshold))
+	require.Nil(t, sweepAmount(big.NewInt(100), threshold))
+	require.Equal(t, big.NewInt(1), sweepAmount(big.NewInt(101), threshold))
+	require.Equal(t, big.NewInt(100), sweepAmount(big.NewInt(100), new(big.Int)))
+}
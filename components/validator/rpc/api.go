@@ -0,0 +1,97 @@
+package rpc
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// ValidatorStatus reports the output submission and challenge progress of the validator.
+type ValidatorStatus struct {
+	// OutputSubmissionPaused is true if the output submission is paused.
+	OutputSubmissionPaused bool `json:"output_submission_paused"`
+	// ChallengePaused is true if the participation in challenges is paused.
+	ChallengePaused bool `json:"challenge_paused"`
+	// NextBlockNumber is the L2 block number of the next output to submit.
+	NextBlockNumber uint64 `json:"next_block_number"`
+	// NextValidator is the priority validator of the current round, or the public round address.
+	NextValidator common.Address `json:"next_validator"`
+	// IsPublicRound is true if the current round is the public round.
+	IsPublicRound bool `json:"is_public_round"`
+	// Deposit is the deposit of the validator in the ValidatorPool, if the validator has an account.
+	Deposit *hexutil.Big `json:"deposit,omitempty"`
+	// RequiredBond is the bond amount required to submit an output or to create a challenge.
+	RequiredBond *hexutil.Big `json:"required_bond"`
+	// ActiveChallenges are the related challenges that are handled.
+	ActiveChallenges []ChallengeStatus `json:"active_challenges"`
+	// PendingProofs is the number of proofs that are requested from the provers and not returned yet.
+	PendingProofs int `json:"pending_proofs"`
+	// ProofQueue are the running proof requests, then the waiting ones in the order of priority.
+	ProofQueue []ProofRequestStatus `json:"proof_queue"`
+}
+
+// ChallengeStatus reports a related challenge that is handled by the validator.
+type ChallengeStatus struct {
+	OutputIndex uint64         `json:"output_index"`
+	Asserter    common.Address `json:"asserter"`
+	Challenger  common.Address `json:"challenger"`
+	// Status is the challenge status observed last, see the Colosseum contract.
+	Status uint8 `json:"status"`
+}
+
+// ProofRequestStatus reports a proof request in the proof queue of the validator.
+type ProofRequestStatus struct {
+	OutputIndex uint64 `json:"output_index"`
+	BlockNumber uint64 `json:"block_number"`
+	// Deadline is the unix time by which the proof must be submitted, or zero if the request has no deadline.
+	Deadline    uint64 `json:"deadline"`
+	Speculative bool   `json:"speculative"`
+	// EnqueuedAt is the unix time the request is queued at.
+	EnqueuedAt uint64 `json:"enqueued_at"`
+	Running    bool   `json:"running"`
+}
+
+type validatorClient interface {
+	PauseOutputSubmitter() error
+	ResumeOutputSubmitter() error
+	PauseChallenger() error
+	ResumeChallenger() error
+	Status(ctx context.Context) (*ValidatorStatus, error)
+}
+
+type adminAPI struct {
+	v validatorClient
+}
+
+func NewAdminAPI(v validatorClient) *adminAPI {
+	return &adminAPI{
+		v: v,
+	}
+}
+
+// PauseOutputSubmitter pauses the submission of outputs, e.g. during an L1 incident.
+func (a *adminAPI) PauseOutputSubmitter(_ context.Context) error {
+	return a.v.PauseOutputSubmitter()
+}
+
+// ResumeOutputSubmitter resumes the submission of outputs after a pause.
+func (a *adminAPI) ResumeOutputSubmitter(_ context.Context) error {
+	return a.v.ResumeOutputSubmitter()
+}
+
+// PauseChallenger pauses the creation of challenges and the steps on the related challenges.
+// Invalid outputs are still detected, to be challenged once the challenger is resumed.
+func (a *adminAPI) PauseChallenger(_ context.Context) error {
+	return a.v.PauseChallenger()
+}
+
+// ResumeChallenger resumes the participation in challenges after a pause.
+func (a *adminAPI) ResumeChallenger(_ context.Context) error {
+	return a.v.ResumeChallenger()
+}
+
+// Status returns the output submission and challenge progress of the validator.
+func (a *adminAPI) Status(ctx context.Context) (*ValidatorStatus, error) {
+	return a.v.Status(ctx)
+}
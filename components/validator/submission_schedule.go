@@ -0,0 +1,91 @@
+package validator
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+)
+
+// FeeCurve is the curve to escalate the fee of an output submission with, as the submission deadline approaches.
+type FeeCurve string
+
+const (
+	// LinearFeeCurve escalates the fee linearly over the submission window.
+	LinearFeeCurve FeeCurve = "linear"
+	// QuadraticFeeCurve escalates the fee slowly early in the submission window, and aggressively near the deadline.
+	QuadraticFeeCurve FeeCurve = "quadratic"
+	// ExponentialFeeCurve multiplies the fee by the same factor for each equal part of the submission window.
+	ExponentialFeeCurve FeeCurve = "exponential"
+)
+
+var FeeCurves = []FeeCurve{
+	LinearFeeCurve,
+	QuadraticFeeCurve,
+	ExponentialFeeCurve,
+}
+
+func ValidFeeCurve(value FeeCurve) bool {
+	for _, c := range FeeCurves {
+		if c == value {
+			return true
+		}
+	}
+	return false
+}
+
+// SubmissionSchedule schedules the output submission within the submission window of the validator:
+// the submission is deferred while the L1 basefee is high early in the window,
+// and the fee is escalated as the deadline of the window approaches.
+type SubmissionSchedule struct {
+	// MaxBaseFee is the max L1 basefee to submit at before UrgentProgress of the window. Nil disables the deferral.
+	MaxBaseFee *big.Int
+	// UrgentProgress is the progress of the window in [0, 1] from which the submission is not deferred anymore.
+	UrgentProgress float64
+	// MaxFeeMultiplier is the multiplier of the gas tip cap at the deadline of the window. 1 disables the escalation.
+	MaxFeeMultiplier float64
+	// FeeCurve is the curve to escalate the gas tip cap with, from 1 at the start to MaxFeeMultiplier at the deadline.
+	FeeCurve FeeCurve
+}
+
+// Check ensures that the [SubmissionSchedule] is valid.
+func (s SubmissionSchedule) Check() error {
+	if s.UrgentProgress < 0 || s.UrgentProgress > 1 {
+		return fmt.Errorf("urgent progress must be in [0, 1], got %f", s.UrgentProgress)
+	}
+	if s.MaxFeeMultiplier < 1 {
+		return fmt.Errorf("max fee multiplier must be at least 1, got %f", s.MaxFeeMultiplier)
+	}
+	if !ValidFeeCurve(s.FeeCurve) {
+		return fmt.Errorf("unknown fee curve: %q", s.FeeCurve)
+	}
+	return nil
+}
+
+// Enabled returns true if the submission is deferred or escalated at all.
+func (s SubmissionSchedule) Enabled() bool {
+	return s.MaxBaseFee != nil || s.MaxFeeMultiplier > 1
+}
+
+// ShouldDefer returns true if the submission should be deferred at the given L1 basefee and progress of the window.
+func (s SubmissionSchedule) ShouldDefer(baseFee *big.Int, progress float64) bool {
+	if s.MaxBaseFee == nil || baseFee == nil || progress >= s.UrgentProgress {
+		return false
+	}
+	return baseFee.Cmp(s.MaxBaseFee) > 0
+}
+
+// FeeMultiplier returns the multiplier of the gas tip cap at the given progress of the window.
+func (s SubmissionSchedule) FeeMultiplier(progress float64) float64 {
+	if s.MaxFeeMultiplier <= 1 {
+		return 1
+	}
+	progress = math.Max(0, math.Min(1, progress))
+	switch s.FeeCurve {
+	case QuadraticFeeCurve:
+		return 1 + (s.MaxFeeMultiplier-1)*progress*progress
+	case ExponentialFeeCurve:
+		return math.Pow(s.MaxFeeMultiplier, progress)
+	default:
+		return 1 + (s.MaxFeeMultiplier-1)*progress
+	}
+}
@@ -0,0 +1,53 @@
+package validator
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubmissionScheduleShouldDefer(t *testing.T) {
+	s := SubmissionSchedule{MaxBaseFee: big.NewInt(100), UrgentProgress: 0.5, MaxFeeMultiplier: 1, FeeCurve: LinearFeeCurve}
+	require.NoError(t, s.Check())
+	require.True(t, s.Enabled())
+
+	require.True(t, s.ShouldDefer(big.NewInt(101), 0))
+	require.False(t, s.ShouldDefer(big.NewInt(100), 0))
+	require.False(t, s.ShouldDefer(big.NewInt(101), 0.5), "expected no deferral from the urgent progress")
+
+	s.MaxBaseFee = nil
+	require.False(t, s.ShouldDefer(big.NewInt(101), 0))
+	require.False(t, s.Enabled())
+}
+
+func TestSubmissionScheduleFeeMultiplier(t *testing.T) {
+	tests := []struct {
+		curve    FeeCurve
+		progress float64
+		expected float64
+	}{
+		{LinearFeeCurve, 0, 1},
+		{LinearFeeCurve, 0.5, 2},
+		{LinearFeeCurve, 1, 3},
+		{LinearFeeCurve, 2, 3},
+		{QuadraticFeeCurve, 0.5, 1.5},
+		{QuadraticFeeCurve, 1, 3},
+		{ExponentialFeeCurve, 0, 1},
+		{ExponentialFeeCurve, 1, 3},
+	}
+	for _, test := range tests {
+		s := SubmissionSchedule{UrgentProgress: 1, MaxFeeMultiplier: 3, FeeCurve: test.curve}
+		require.NoError(t, s.Check())
+		require.InDelta(t, test.expected, s.FeeMultiplier(test.progress), 1e-9, "%s at %f", test.curve, test.progress)
+	}
+
+	s := SubmissionSchedule{MaxFeeMultiplier: 1, FeeCurve: LinearFeeCurve}
+	require.Equal(t, float64(1), s.FeeMultiplier(1))
+}
+
+func TestSubmissionScheduleCheck(t *testing.T) {
+	require.Error(t, SubmissionSchedule{UrgentProgress: 2, MaxFeeMultiplier: 1, FeeCurve: LinearFeeCurve}.Check())
+	require.Error(t, SubmissionSchedule{MaxFeeMultiplier: 0.5, FeeCurve: LinearFeeCurve}.Check())
+	require.Error(t, SubmissionSchedule{MaxFeeMultiplier: 1, FeeCurve: "cubic"}.Check())
+}
@@ -2,18 +2,24 @@ package validator
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math/big"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/log"
+	gethrpc "github.com/ethereum/go-ethereum/rpc"
 	"github.com/urfave/cli"
 
 	"github.com/kroma-network/kroma/bindings/bindings"
 	"github.com/kroma-network/kroma/components/validator/metrics"
+	"github.com/kroma-network/kroma/components/validator/rpc"
 	"github.com/kroma-network/kroma/utils"
 	"github.com/kroma-network/kroma/utils/monitoring"
 	klog "github.com/kroma-network/kroma/utils/service/log"
+	kmetrics "github.com/kroma-network/kroma/utils/service/metrics"
 	krpc "github.com/kroma-network/kroma/utils/service/rpc"
 )
 
@@ -26,21 +32,70 @@ func Main(version string, cliCtx *cli.Context) error {
 	}
 
 	l := klog.NewLogger(cliCfg.LogConfig)
-	m := metrics.NewMetrics("default")
 	l.Info("initializing Validator")
 
-	validatorCfg, err := NewValidatorConfig(cliCfg, l, m)
-	if err != nil {
-		l.Error("Unable to create validator config", "err", err)
-		return err
+	identities := []Identity{{Name: DefaultIdentityName}}
+	if cliCfg.IdentitiesFile != "" {
+		var err error
+		identities, err = ReadIdentities(cliCfg.IdentitiesFile)
+		if err != nil {
+			return err
+		}
 	}
+	// With multiple identities, a failing identity is skipped, so that it does not affect the others.
+	multi := len(identities) > 1
 
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
 	monitoring.MaybeStartPprof(ctx, cliCfg.PprofConfig, l)
-	monitoring.MaybeStartMetrics(ctx, cliCfg.MetricsConfig, l, m, validatorCfg.L1Client, validatorCfg.TxManager.From())
-	server, err := monitoring.StartRPC(cliCfg.RPCConfig, version, krpc.WithLogger(l))
+
+	registry := kmetrics.NewRegistry()
+	var (
+		validators []*Validator
+		apis       []gethrpc.API
+		m          *metrics.Metrics
+	)
+	for _, identity := range identities {
+		il := l
+		if multi {
+			il = l.New("identity", identity.Name)
+		}
+		m = metrics.NewMetricsWithRegistry(identity.Name, registry)
+
+		validator, err := newIdentityValidator(ctx, identity.Apply(cliCfg), il, m)
+		if err != nil {
+			il.Error("Unable to create validator", "err", err)
+			if multi {
+				continue
+			}
+			return err
+		}
+
+		// The watcher has no account to track the balance of.
+		if cliCfg.MetricsConfig.Enabled && validator.cfg.TxManager != nil {
+			m.StartBalanceMetrics(ctx, il, validator.cfg.L1Client, validator.cfg.TxManager.From())
+		}
+		m.RecordInfo(version)
+		m.RecordUp()
+
+		validators = append(validators, validator)
+		apis = append(apis, gethrpc.API{
+			Namespace: identity.AdminNamespace(),
+			Service:   rpc.NewAdminAPI(validator),
+		})
+	}
+	if len(validators) == 0 {
+		return errors.New("no validator identity could be created")
+	}
+	// The metrics of all the identities are served from the shared registry.
+	monitoring.MaybeStartMetrics(ctx, cliCfg.MetricsConfig, l, m, nil, common.Address{})
+
+	rpcOpts := []krpc.ServerOption{krpc.WithLogger(l)}
+	if cliCfg.RPCConfig.EnableAdmin {
+		rpcOpts = append(rpcOpts, krpc.WithAPIs(apis))
+	}
+	server, err := monitoring.StartRPC(cliCfg.RPCConfig.ToServiceCLIConfig(), version, rpcOpts...)
 	if err != nil {
 		return err
 	}
@@ -50,25 +105,43 @@ func Main(version string, cliCtx *cli.Context) error {
 		}
 	}()
 
-	m.RecordInfo(version)
-	m.RecordUp()
-
-	validator, err := NewValidator(ctx, *validatorCfg, l, m)
-	if err != nil {
-		return err
+	var started []*Validator
+	for _, validator := range validators {
+		if err := validator.Start(); err != nil {
+			validator.l.Error("failed to start validator", "err", err)
+			if multi {
+				continue
+			}
+			return err
+		}
+		started = append(started, validator)
 	}
-
-	if err := validator.Start(); err != nil {
-		l.Error("failed to start validator", "err", err)
-		return err
+	if len(started) == 0 {
+		return errors.New("no validator identity could be started")
 	}
 	<-utils.WaitInterrupt()
-	if err := validator.Stop(); err != nil {
-		l.Error("failed to stop validator", "err", err)
-		return err
+	for _, validator := range started {
+		if stopErr := validator.Stop(); stopErr != nil {
+			validator.l.Error("failed to stop validator", "err", stopErr)
+			err = stopErr
+		}
 	}
 
-	return nil
+	return err
+}
+
+// newIdentityValidator creates the Validator of an identity with the CLIConfig of the identity.
+func newIdentityValidator(ctx context.Context, cfg CLIConfig, l log.Logger, m metrics.Metricer) (*Validator, error) {
+	if err := cfg.Check(); err != nil {
+		return nil, fmt.Errorf("invalid config: %w", err)
+	}
+
+	validatorCfg, err := NewValidatorConfig(cfg, l, m)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create validator config: %w", err)
+	}
+
+	return NewValidator(ctx, *validatorCfg, l, m)
 }
 
 type Validator struct {
@@ -81,6 +154,8 @@ type Validator struct {
 	l2os       *L2OutputSubmitter
 	challenger *Challenger
 	guardian   *Guardian
+	sweeper    *RewardSweeper
+	penalties  *PenaltyMonitor
 
 	l2ooContract *bindings.L2OutputOracleCaller
 }
@@ -113,6 +188,22 @@ func NewValidator(ctx context.Context, cfg Config, l log.Logger, m metrics.Metri
 		}
 	}
 
+	var penalties *PenaltyMonitor
+	if cfg.PenaltyMonitorEnabled {
+		penalties, err = NewPenaltyMonitor(ctx, cfg, l, m)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var sweeper *RewardSweeper
+	if cfg.RewardSweeperEnabled {
+		sweeper, err = NewRewardSweeper(ctx, cfg, l, m)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	l2ooContract, err := bindings.NewL2OutputOracleCaller(cfg.L2OutputOracleAddr, cfg.L1Client)
 	if err != nil {
 		return nil, err
@@ -125,19 +216,23 @@ func NewValidator(ctx context.Context, cfg Config, l log.Logger, m metrics.Metri
 		l2os:         l2os,
 		challenger:   challenger,
 		guardian:     guardian,
+		sweeper:      sweeper,
+		penalties:    penalties,
 		l2ooContract: l2ooContract,
 	}, nil
 }
 
 func (v *Validator) Start() error {
 	v.ctx, v.cancel = context.WithCancel(context.Background())
-	v.l.Info("starting Validator", "outputSubmitter", v.cfg.OutputSubmitterEnabled, "challenger", v.cfg.ChallengerEnabled, "guardian", v.cfg.GuardianEnabled)
+	v.l.Info("starting Validator", "outputSubmitter", v.cfg.OutputSubmitterEnabled, "challenger", v.cfg.ChallengerEnabled, "guardian", v.cfg.GuardianEnabled, "watcher", v.cfg.WatcherEnabled, "rewardSweeper", v.cfg.RewardSweeperEnabled)
 
 	// wait for kroma node to sync completed
 	v.waitSyncCompleted()
 
-	if err := v.cfg.TxManager.Start(v.ctx); err != nil {
-		return fmt.Errorf("cannot start TxManager: %w", err)
+	for _, txMgr := range v.cfg.txManagers() {
+		if err := txMgr.Start(v.ctx); err != nil {
+			return fmt.Errorf("cannot start TxManager: %w", err)
+		}
 	}
 
 	if v.cfg.OutputSubmitterEnabled {
@@ -146,7 +241,7 @@ func (v *Validator) Start() error {
 		}
 	}
 
-	if v.cfg.OutputSubmitterEnabled || v.cfg.ChallengerEnabled {
+	if v.cfg.OutputSubmitterEnabled || v.cfg.ChallengerEnabled || v.cfg.WatcherEnabled {
 		if err := v.challenger.Start(v.ctx); err != nil {
 			return fmt.Errorf("cannot start challenger: %w", err)
 		}
@@ -158,13 +253,27 @@ func (v *Validator) Start() error {
 		}
 	}
 
+	if v.cfg.PenaltyMonitorEnabled {
+		if err := v.penalties.Start(v.ctx); err != nil {
+			return fmt.Errorf("cannot start penalty monitor: %w", err)
+		}
+	}
+
+	if v.cfg.RewardSweeperEnabled {
+		if err := v.sweeper.Start(v.ctx); err != nil {
+			return fmt.Errorf("cannot start reward sweeper: %w", err)
+		}
+	}
+
 	return nil
 }
 
 func (v *Validator) Stop() error {
 	v.l.Info("stopping Validator")
-	if err := v.cfg.TxManager.Stop(); err != nil {
-		return fmt.Errorf("failed to stop TxManager: %w", err)
+	for _, txMgr := range v.cfg.txManagers() {
+		if err := txMgr.Stop(); err != nil {
+			return fmt.Errorf("failed to stop TxManager: %w", err)
+		}
 	}
 
 	if v.cfg.OutputSubmitterEnabled {
@@ -173,7 +282,7 @@ func (v *Validator) Stop() error {
 		}
 	}
 
-	if v.cfg.OutputSubmitterEnabled || v.cfg.ChallengerEnabled {
+	if v.cfg.OutputSubmitterEnabled || v.cfg.ChallengerEnabled || v.cfg.WatcherEnabled {
 		if err := v.challenger.Stop(); err != nil {
 			return fmt.Errorf("failed to stop challenger: %w", err)
 		}
@@ -185,6 +294,18 @@ func (v *Validator) Stop() error {
 		}
 	}
 
+	if v.cfg.PenaltyMonitorEnabled {
+		if err := v.penalties.Stop(); err != nil {
+			return fmt.Errorf("failed to stop penalty monitor: %w", err)
+		}
+	}
+
+	if v.cfg.RewardSweeperEnabled {
+		if err := v.sweeper.Stop(); err != nil {
+			return fmt.Errorf("failed to stop reward sweeper: %w", err)
+		}
+	}
+
 	v.cancel()
 
 	return nil
@@ -251,3 +372,79 @@ func (v *Validator) fetchLatestBlockNumber() (*big.Int, error) {
 
 	return latestBlockNumber, nil
 }
+
+// PauseOutputSubmitter pauses the output submission.
+func (v *Validator) PauseOutputSubmitter() error {
+	if v.l2os == nil {
+		return errors.New("output submitter is not enabled")
+	}
+	v.l2os.Pause()
+	v.l.Info("output submission paused")
+	return nil
+}
+
+// ResumeOutputSubmitter resumes the output submission after a pause.
+func (v *Validator) ResumeOutputSubmitter() error {
+	if v.l2os == nil {
+		return errors.New("output submitter is not enabled")
+	}
+	v.l2os.Resume()
+	v.l.Info("output submission resumed")
+	return nil
+}
+
+// PauseChallenger pauses the creation of challenges and the steps on the related challenges.
+func (v *Validator) PauseChallenger() error {
+	if !v.cfg.ChallengerEnabled {
+		return errors.New("challenger is not enabled")
+	}
+	v.challenger.Pause()
+	v.l.Info("challenger paused")
+	return nil
+}
+
+// ResumeChallenger resumes the participation in challenges after a pause.
+func (v *Validator) ResumeChallenger() error {
+	if !v.cfg.ChallengerEnabled {
+		return errors.New("challenger is not enabled")
+	}
+	v.challenger.Resume()
+	v.l.Info("challenger resumed")
+	return nil
+}
+
+// Status returns the output submission and challenge progress of the validator.
+func (v *Validator) Status(ctx context.Context) (*rpc.ValidatorStatus, error) {
+	cCtx, cCancel := context.WithTimeout(ctx, v.cfg.NetworkTimeout)
+	defer cCancel()
+	opts := utils.NewSimpleCallOpts(cCtx)
+
+	nextBlockNumber, err := v.l2ooContract.NextBlockNumber(opts)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get next block number: %w", err)
+	}
+	nextValidator, err := v.challenger.valpoolContract.NextValidator(opts)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get next validator: %w", err)
+	}
+
+	status := &rpc.ValidatorStatus{
+		OutputSubmissionPaused: v.l2os != nil && v.l2os.Paused(),
+		ChallengePaused:        v.challenger.Paused(),
+		NextBlockNumber:        nextBlockNumber.Uint64(),
+		NextValidator:          nextValidator,
+		IsPublicRound:          nextValidator == PublicRoundAddress,
+		RequiredBond:           (*hexutil.Big)(v.challenger.requiredBondAmount),
+		ActiveChallenges:       v.challenger.ActiveChallenges(),
+		PendingProofs:          v.challenger.PendingProofs(),
+		ProofQueue:             v.challenger.ProofQueue(),
+	}
+	if v.cfg.TxManager != nil {
+		deposit, err := v.challenger.valpoolContract.BalanceOf(opts, v.cfg.TxManager.From())
+		if err != nil {
+			return nil, fmt.Errorf("unable to get deposit: %w", err)
+		}
+		status.Deposit = (*hexutil.Big)(deposit)
+	}
+	return status, nil
+}
@@ -30,7 +30,7 @@ func (v *L2Validator) ActCreateChallenge(t Testing, outputIndex *big.Int) common
 		return status == chal.StatusNone || status == chal.StatusChallengerTimeout
 	}, "challenge is already in progress")
 
-	hasEnoughDeposit, err := v.challenger.HasEnoughDeposit(t.Ctx())
+	hasEnoughDeposit, err := v.challenger.HasEnoughDeposit(t.Ctx(), v.address)
 	require.NoError(t, err, "unable to check challenger deposit")
 	require.True(t, hasEnoughDeposit, "challenger not enough deposit to create challenge")
 
@@ -66,7 +66,7 @@ func (v *L2Validator) ActBisect(t Testing, outputIndex *big.Int, challenger comm
 }
 
 func (v *L2Validator) ActCancelChallenge(t Testing, outputIndex *big.Int) common.Hash {
-	tx, err := v.challenger.CancelChallenge(t.Ctx(), outputIndex)
+	tx, err := v.challenger.CancelChallenge(t.Ctx(), outputIndex, v.address)
 	require.NoError(t, err, "unable to create cancel challenge tx")
 
 	err = v.l1.SendTransaction(t.Ctx(), tx)
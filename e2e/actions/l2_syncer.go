@@ -7,6 +7,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
 	"github.com/ethereum/go-ethereum/log"
 	gnode "github.com/ethereum/go-ethereum/node"
 	"github.com/ethereum/go-ethereum/rpc"
@@ -59,7 +60,7 @@ type L2API interface {
 
 func NewL2Syncer(t Testing, log log.Logger, l1 derive.L1Fetcher, eng L2API, cfg *rollup.Config) *L2Syncer {
 	metrics := &testutils.TestDerivationMetrics{}
-	pipeline := derive.NewDerivationPipeline(log, cfg, l1, eng, metrics)
+	pipeline := derive.NewDerivationPipeline(log, cfg, l1, nil, eng, metrics)
 	pipeline.Reset()
 
 	rollupNode := &L2Syncer{
@@ -130,6 +131,27 @@ func (s *l2SyncerBackend) StopProposer(ctx context.Context) (common.Hash, error)
 	return common.Hash{}, errors.New("stopping the L2Syncer proposer is not supported")
 }
 
+// SubscribeSyncStatus returns a subscription that never fires:
+// the L2Syncer is stepped by the test, and does not push sync status changes.
+func (s *l2SyncerBackend) SubscribeSyncStatus(ch chan<- *eth.SyncStatus) event.Subscription {
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		<-quit
+		return nil
+	})
+}
+
+// SubscribePayloadAttributes returns a subscription that never fires: the L2Syncer does not propose blocks.
+func (s *l2SyncerBackend) SubscribePayloadAttributes(ch chan<- *eth.PayloadAttributesEvent) event.Subscription {
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		<-quit
+		return nil
+	})
+}
+
+func (s *l2SyncerBackend) SubmitExternalPayload(ctx context.Context, payload *eth.ExecutionPayload) error {
+	return errors.New("submitting external payloads to the L2Syncer is not supported")
+}
+
 func (s *L2Syncer) L2Finalized() eth.L2BlockRef {
 	return s.derivation.Finalized()
 }
@@ -0,0 +1,32 @@
+package main
+
+import (
+	"os"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/urfave/cli/v2"
+
+	"github.com/kroma-network/kroma/e2e/devnet"
+	klog "github.com/kroma-network/kroma/utils/service/log"
+)
+
+func main() {
+	klog.SetupDefaults()
+
+	app := cli.NewApp()
+	app.Name = "kroma"
+	app.Usage = "Local development tools"
+	app.Commands = []*cli.Command{
+		{
+			Name:   "devnet",
+			Usage:  "Runs an in-memory devnet with L1, kroma-geth, kroma-node, batcher and validator in a single process",
+			Flags:  devnet.Flags,
+			Action: devnet.Main,
+		},
+	}
+
+	err := app.Run(os.Args)
+	if err != nil {
+		log.Crit("Application failed", "message", err)
+	}
+}
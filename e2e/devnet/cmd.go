@@ -0,0 +1,163 @@
+package devnet
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/ethereum/go-ethereum/eth/ethconfig"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/node"
+	"github.com/urfave/cli/v2"
+
+	"github.com/kroma-network/kroma/bindings/predeploys"
+	"github.com/kroma-network/kroma/e2e"
+	"github.com/kroma-network/kroma/e2e/e2eutils"
+	"github.com/kroma-network/kroma/utils"
+	klog "github.com/kroma-network/kroma/utils/service/log"
+)
+
+const envVarPrefix = "KROMA_DEVNET"
+
+var (
+	OutDirFlag = &cli.StringFlag{
+		Name:  "outdir",
+		Usage: "Directory to write the JWT secret, the L2 genesis, the rollup config and the contract addresses to",
+		Value: ".devnet",
+	}
+	L1PortFlag = &cli.IntFlag{
+		Name:  "l1.port",
+		Usage: "HTTP port of the L1 RPC. The websocket RPC listens on the next port",
+		Value: 8545,
+	}
+	L2PortFlag = &cli.IntFlag{
+		Name:  "l2.port",
+		Usage: "HTTP port of the L2 RPC. The websocket RPC listens on the next port",
+		Value: 9545,
+	}
+	RollupPortFlag = &cli.IntFlag{
+		Name:  "rollup.port",
+		Usage: "Port of the kroma-node RPC",
+		Value: 7545,
+	}
+	L1BlockTimeFlag = &cli.Uint64Flag{
+		Name:  "l1.block-time",
+		Usage: "Block time of L1 in seconds",
+		Value: 2,
+	}
+	L2BlockTimeFlag = &cli.Uint64Flag{
+		Name:  "l2.block-time",
+		Usage: "Block time of L2 in seconds",
+		Value: 1,
+	}
+	FinalizationPeriodFlag = &cli.Uint64Flag{
+		Name:  "finalization-period",
+		Usage: "Finalization period of the L2 outputs in seconds, after which the withdrawals can be finalized",
+		Value: 60,
+	}
+)
+
+var Flags = append([]cli.Flag{
+	OutDirFlag,
+	L1PortFlag,
+	L2PortFlag,
+	RollupPortFlag,
+	L1BlockTimeFlag,
+	L2BlockTimeFlag,
+	FinalizationPeriodFlag,
+}, klog.CLIFlagsV2(envVarPrefix)...)
+
+// Main starts an in-memory devnet in this process: an L1 geth node that
+// produces blocks with a fake beacon chain, and whose genesis has the L1
+// contracts deployed, a kroma-geth node with a kroma-node proposing on top of
+// it, a batcher, a validator submitting outputs and a challenger. The chains are
+// discarded when the devnet stops.
+func Main(ctx *cli.Context) error {
+	logCfg := klog.ReadCLIConfigV2(ctx)
+	if err := logCfg.Check(); err != nil {
+		return fmt.Errorf("invalid log config: %w", err)
+	}
+	logger := klog.NewLogger(logCfg)
+
+	outDir := ctx.String(OutDirFlag.Name)
+	if err := os.MkdirAll(outDir, 0o755); err != nil {
+		return fmt.Errorf("failed to create output directory: %w", err)
+	}
+	jwtPath, err := e2e.WriteDefaultJWT(outDir)
+	if err != nil {
+		return fmt.Errorf("failed to write JWT secret: %w", err)
+	}
+
+	cfg, err := e2e.NewDefaultSystemConfig(jwtPath, func(role string) log.Logger {
+		return logger.New("role", role)
+	})
+	if err != nil {
+		return err
+	}
+	cfg.DeployConfig.L1BlockTime = ctx.Uint64(L1BlockTimeFlag.Name)
+	cfg.DeployConfig.L2BlockTime = ctx.Uint64(L2BlockTimeFlag.Name)
+	cfg.DeployConfig.FinalizationPeriodSeconds = ctx.Uint64(FinalizationPeriodFlag.Name)
+	if err := cfg.DeployConfig.Check(); err != nil {
+		return fmt.Errorf("invalid deploy config: %w", err)
+	}
+
+	// a single proposer is enough for local development
+	delete(cfg.Nodes, "syncer")
+	cfg.Nodes["proposer"].RPC.ListenPort = ctx.Int(RollupPortFlag.Name)
+	cfg.GethOptions["l1"] = []e2e.GethOption{withPorts(ctx.Int(L1PortFlag.Name))}
+	cfg.GethOptions["proposer"] = []e2e.GethOption{withPorts(ctx.Int(L2PortFlag.Name))}
+
+	logger.Info("Starting devnet")
+	sys, err := cfg.Start()
+	if err != nil {
+		return fmt.Errorf("failed to start devnet: %w", err)
+	}
+	defer sys.Close()
+
+	if err := writeJSON(filepath.Join(outDir, "rollup.json"), sys.RollupConfig); err != nil {
+		return err
+	}
+	if err := writeJSON(filepath.Join(outDir, "genesis-l2.json"), sys.L2GenesisCfg); err != nil {
+		return err
+	}
+	if err := writeJSON(filepath.Join(outDir, "addresses.json"), predeploys.DevPredeploys); err != nil {
+		return err
+	}
+
+	logger.Info("Devnet started",
+		"l1", sys.Nodes["l1"].HTTPEndpoint(),
+		"l2", sys.Nodes["proposer"].HTTPEndpoint(),
+		"rollup", sys.RollupNodes["proposer"].HTTPEndpoint(),
+		"outdir", outDir,
+	)
+	logger.Info("Development accounts are funded on L1 and L2", "mnemonic", e2eutils.DefaultMnemonicConfig.Mnemonic)
+
+	<-utils.WaitInterrupt()
+	logger.Info("Stopping devnet")
+	return nil
+}
+
+// withPorts sets the ports of the HTTP and websocket RPCs of a geth node.
+func withPorts(port int) e2e.GethOption {
+	return func(_ *ethconfig.Config, nodeCfg *node.Config) error {
+		nodeCfg.HTTPPort = port
+		nodeCfg.WSPort = port + 1
+		return nil
+	}
+}
+
+func writeJSON(path string, v any) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("failed to create %s: %w", path, err)
+	}
+	defer f.Close()
+
+	enc := json.NewEncoder(f)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(v); err != nil {
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	return nil
+}
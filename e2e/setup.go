@@ -78,8 +78,21 @@ func newTxMgrConfig(l1Addr string, privKey *ecdsa.PrivateKey) txmgr.CLIConfig {
 }
 
 func DefaultSystemConfig(t *testing.T) SystemConfig {
-	secrets, err := e2eutils.DefaultMnemonicConfig.Secrets()
+	cfg, err := NewDefaultSystemConfig(writeDefaultJWT(t), func(role string) log.Logger {
+		return testlog.Logger(t, log.LvlInfo).New("role", role)
+	})
 	require.NoError(t, err)
+	return cfg
+}
+
+// NewDefaultSystemConfig returns the default configuration of the system, whose
+// geth nodes read the JWT secret from jwtPath, and whose services log with the
+// loggers created by newLogger for each role.
+func NewDefaultSystemConfig(jwtPath string, newLogger func(role string) log.Logger) (SystemConfig, error) {
+	secrets, err := e2eutils.DefaultMnemonicConfig.Secrets()
+	if err != nil {
+		return SystemConfig{}, err
+	}
 	addresses := secrets.Addresses()
 
 	deployConfig := &genesis.DeployConfig{
@@ -163,7 +176,7 @@ func DefaultSystemConfig(t *testing.T) SystemConfig {
 	}
 
 	if err := deployConfig.InitDeveloperDeployedAddresses(); err != nil {
-		panic(err)
+		return SystemConfig{}, err
 	}
 
 	return SystemConfig{
@@ -173,7 +186,7 @@ func DefaultSystemConfig(t *testing.T) SystemConfig {
 
 		DeployConfig:           deployConfig,
 		L1InfoPredeployAddress: predeploys.L1BlockAddr,
-		JWTFilePath:            writeDefaultJWT(t),
+		JWTFilePath:            jwtPath,
 		JWTSecret:              testingJWTSecret,
 		Nodes: map[string]*rollupNode.Config{
 			"syncer": {
@@ -200,27 +213,37 @@ func DefaultSystemConfig(t *testing.T) SystemConfig {
 			},
 		},
 		Loggers: map[string]log.Logger{
-			"syncer":     testlog.Logger(t, log.LvlInfo).New("role", "syncer"),
-			"proposer":   testlog.Logger(t, log.LvlInfo).New("role", "proposer"),
-			"batcher":    testlog.Logger(t, log.LvlInfo).New("role", "batcher"),
-			"validator":  testlog.Logger(t, log.LvlInfo).New("role", "validator"),
-			"challenger": testlog.Logger(t, log.LvlInfo).New("role", "challenger"),
+			"syncer":     newLogger("syncer"),
+			"proposer":   newLogger("proposer"),
+			"batcher":    newLogger("batcher"),
+			"validator":  newLogger("validator"),
+			"challenger": newLogger("challenger"),
 		},
 		GethOptions:         map[string][]GethOption{},
 		P2PTopology:         nil, // no P2P connectivity by default
 		NonFinalizedOutputs: false,
-	}
+	}, nil
 }
 
 func writeDefaultJWT(t *testing.T) string {
-	// Sadly the geth node config cannot load JWT secret from memory, it has to be a file
-	jwtPath := path.Join(t.TempDir(), "jwt_secret")
-	if err := os.WriteFile(jwtPath, []byte(hexutil.Encode(testingJWTSecret[:])), 0o600); err != nil {
+	jwtPath, err := WriteDefaultJWT(t.TempDir())
+	if err != nil {
 		t.Fatalf("failed to prepare jwt file for geth: %v", err)
 	}
 	return jwtPath
 }
 
+// WriteDefaultJWT writes the JWT secret of the default system configuration to
+// the directory, and returns the path of the file.
+func WriteDefaultJWT(dir string) (string, error) {
+	// Sadly the geth node config cannot load JWT secret from memory, it has to be a file
+	jwtPath := path.Join(dir, "jwt_secret")
+	if err := os.WriteFile(jwtPath, []byte(hexutil.Encode(testingJWTSecret[:])), 0o600); err != nil {
+		return "", err
+	}
+	return jwtPath, nil
+}
+
 type DepositContractConfig struct {
 	L2Oracle           common.Address
 	FinalizationPeriod *big.Int
@@ -663,7 +686,7 @@ func (cfg SystemConfig) Start(_opts ...SystemConfigOption) (*System, error) {
 		ColosseumAddress:       predeploys.DevColosseumAddr.String(),
 		ValPoolAddress:         predeploys.DevValidatorPoolAddr.String(),
 		ChallengerPollInterval: 500 * time.Millisecond,
-		ProverRPC:              "http://0.0.0.0:0",
+		ProverRPCs:             []string{"http://0.0.0.0:0"},
 		TxMgrConfig:            newTxMgrConfig(sys.Nodes["l1"].WSEndpoint(), cfg.Secrets.Challenger1),
 		OutputSubmitterEnabled: false,
 		ChallengerEnabled:      true,
@@ -696,7 +719,7 @@ func (cfg SystemConfig) Start(_opts ...SystemConfigOption) (*System, error) {
 	}
 
 	// Replace to mock fetcher
-	challengerCfg.ProofFetcher = e2eutils.NewFetcher(sys.cfg.Loggers["challenger"], "./testdata/proof")
+	challengerCfg.ProofBackend = e2eutils.NewFetcher(sys.cfg.Loggers["challenger"], "./testdata/proof")
 	sys.Challenger, err = validator.NewValidator(context.Background(), *challengerCfg, sys.cfg.Loggers["challenger"], validatormetrics.NoopMetrics)
 	if err != nil {
 		return nil, fmt.Errorf("unable to setup challenger: %w", err)
@@ -0,0 +1,179 @@
+package crossdomain
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/kroma-network/kroma/bindings/predeploys"
+)
+
+// A FailedMessage represents a cross domain message that failed to be
+// relayed by the messenger of the other domain, and can be replayed
+type FailedMessage struct {
+	CrossDomainMessage `json:"message"`
+	MsgHash            common.Hash `json:"msgHash"`
+	// TransactionHash is the transaction that sent the message
+	TransactionHash common.Hash `json:"transactionHash"`
+}
+
+// ReplayTx represents the transaction to replay a failed message with,
+// by calling "relayMessage" on the messenger that failed to relay it. The
+// replay is sent without value, as the value of the message is held by the
+// messenger since the failed relay
+type ReplayTx struct {
+	To   common.Address `json:"to"`
+	Data []byte         `json:"data"`
+	Gas  uint64         `json:"gas"`
+}
+
+// messengerCaller is implemented by the callers of both the
+// L1CrossDomainMessenger and the L2CrossDomainMessenger
+type messengerCaller interface {
+	FailedMessages(opts *bind.CallOpts, arg0 [32]byte) (bool, error)
+	SuccessfulMessages(opts *bind.CallOpts, arg0 [32]byte) (bool, error)
+	BaseGas(opts *bind.CallOpts, _message []byte, _minGasLimit uint32) (uint64, error)
+}
+
+// GetFailedDeposits will fetch the L1 to L2 messages that failed to be
+// relayed on L2, by getting L1CrossDomainMessenger `SentMessage` events and
+// then checking to see if the cross domain message hash has failed and not
+// been relayed successfully since on L2.
+func GetFailedDeposits(messengers *Messengers, start, end uint64) ([]FailedMessage, error) {
+	messages, err := messengers.L1.FilterSentMessage(filterOpts(start, end), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer messages.Close()
+
+	var sent []FailedMessage
+	for messages.Next() {
+		event := messages.Event
+		sent = append(sent, FailedMessage{
+			CrossDomainMessage: *NewCrossDomainMessage(
+				event.MessageNonce,
+				event.Sender,
+				event.Target,
+				event.Value,
+				event.GasLimit,
+				event.Message,
+			),
+			TransactionHash: event.Raw.TxHash,
+		})
+	}
+	if err := messages.Error(); err != nil {
+		return nil, err
+	}
+	return filterFailed(&messengers.L2.L2CrossDomainMessengerCaller, sent)
+}
+
+// GetFailedWithdrawals will fetch the L2 to L1 messages that failed to be
+// relayed on L1, by getting L2CrossDomainMessenger `SentMessage` events and
+// then checking to see if the cross domain message hash has failed and not
+// been relayed successfully since on L1.
+func GetFailedWithdrawals(messengers *Messengers, start, end uint64) ([]FailedMessage, error) {
+	messages, err := messengers.L2.FilterSentMessage(filterOpts(start, end), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer messages.Close()
+
+	var sent []FailedMessage
+	for messages.Next() {
+		event := messages.Event
+		sent = append(sent, FailedMessage{
+			CrossDomainMessage: *NewCrossDomainMessage(
+				event.MessageNonce,
+				event.Sender,
+				event.Target,
+				event.Value,
+				event.GasLimit,
+				event.Message,
+			),
+			TransactionHash: event.Raw.TxHash,
+		})
+	}
+	if err := messages.Error(); err != nil {
+		return nil, err
+	}
+	return filterFailed(&messengers.L1.L1CrossDomainMessengerCaller, sent)
+}
+
+// NewDepositReplayTx constructs the transaction to replay the failed L1 to L2
+// message with on the L2CrossDomainMessenger.
+func NewDepositReplayTx(messengers *Messengers, msg *CrossDomainMessage) (*ReplayTx, error) {
+	return newReplayTx(&messengers.L2.L2CrossDomainMessengerCaller, predeploys.L2CrossDomainMessengerAddr, msg)
+}
+
+// NewWithdrawalReplayTx constructs the transaction to replay the failed L2 to
+// L1 message with on the L1CrossDomainMessenger at the given address.
+func NewWithdrawalReplayTx(messengers *Messengers, l1CrossDomainMessenger common.Address, msg *CrossDomainMessage) (*ReplayTx, error) {
+	return newReplayTx(&messengers.L1.L1CrossDomainMessengerCaller, l1CrossDomainMessenger, msg)
+}
+
+func filterFailed(messenger messengerCaller, sent []FailedMessage) ([]FailedMessage, error) {
+	failed := make([]FailedMessage, 0)
+	for _, msg := range sent {
+		hash, err := msg.CrossDomainMessage.Hash()
+		if err != nil {
+			return nil, err
+		}
+
+		isFailed, err := messenger.FailedMessages(&bind.CallOpts{}, hash)
+		if err != nil {
+			return nil, err
+		}
+		if !isFailed {
+			continue
+		}
+		// A failed message stays marked as failed once it is replayed successfully
+		relayed, err := messenger.SuccessfulMessages(&bind.CallOpts{}, hash)
+		if err != nil {
+			return nil, err
+		}
+		if relayed {
+			continue
+		}
+
+		msg.MsgHash = hash
+		failed = append(failed, msg)
+	}
+	return failed, nil
+}
+
+// newReplayTx constructs the "relayMessage" call of the message. The gas is
+// the base gas of the message computed by the messenger, which is the gas that
+// the sending messenger provides to relay the message with, so that the
+// target is called with at least the min gas limit of the message.
+func newReplayTx(messenger messengerCaller, to common.Address, msg *CrossDomainMessage) (*ReplayTx, error) {
+	if !msg.GasLimit.IsUint64() || msg.GasLimit.Uint64() > math.MaxUint32 {
+		return nil, fmt.Errorf("invalid min gas limit %d", msg.GasLimit)
+	}
+	data, err := msg.Encode()
+	if err != nil {
+		return nil, err
+	}
+	gas, err := messenger.BaseGas(&bind.CallOpts{}, msg.Data, uint32(msg.GasLimit.Uint64()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get base gas: %w", err)
+	}
+	return &ReplayTx{
+		To:   to,
+		Data: data,
+		Gas:  gas,
+	}, nil
+}
+
+// filterOpts returns the options to filter the events of the block range
+// with. When end is zero, the filter will extend to the latest block.
+func filterOpts(start, end uint64) *bind.FilterOpts {
+	opts := &bind.FilterOpts{
+		Start: start,
+	}
+	if end != 0 {
+		opts.End = &end
+	}
+	return opts
+}
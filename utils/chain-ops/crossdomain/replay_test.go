@@ -0,0 +1,88 @@
+package crossdomain_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kroma-network/kroma/bindings/bindings"
+	"github.com/kroma-network/kroma/bindings/predeploys"
+	"github.com/kroma-network/kroma/utils/chain-ops/crossdomain"
+	"github.com/kroma-network/kroma/utils/chain-ops/state"
+)
+
+// TestGetFailedWithdrawals tests that the withdrawals that failed to be
+// relayed on L1 are fetched, and that their replay transactions are built
+func TestGetFailedWithdrawals(t *testing.T) {
+	L2db := state.NewMemoryStateDB(nil)
+	L2db.CreateAccount(testAccount)
+	L2db.AddBalance(testAccount, big.NewInt(10000000000000000))
+	require.NoError(t, setL2ToL1MessagePasser(L2db))
+	require.NoError(t, setL2CrossDomainMessenger(L2db))
+
+	L2 := backends.NewSimulatedBackend(L2db.Genesis().Alloc, 15000000)
+	L2CrossDomainMessenger, err := bindings.NewL2CrossDomainMessenger(predeploys.L2CrossDomainMessengerAddr, L2)
+	require.NoError(t, err)
+
+	msgs := []*sendMessageArgs{
+		{Target: common.Address{0x01}, Message: []byte{0x01}, MinGasLimit: 100},
+		{Target: common.Address{0x02}, Message: []byte{0xaa, 0xbb}, MinGasLimit: 10000},
+		{Target: common.Address{0x03}, Message: []byte{}, MinGasLimit: 70511},
+		{Target: common.Address{0x04}, Message: []byte{0x04}, MinGasLimit: 0},
+	}
+	sent := make([]*crossdomain.CrossDomainMessage, len(msgs))
+	hashes := make([]common.Hash, len(msgs))
+	for i, msg := range msgs {
+		sent[i] = sendCrossDomainMessage(L2CrossDomainMessenger, L2, msg, t)
+		hashes[i], err = sent[i].Hash()
+		require.NoError(t, err)
+	}
+
+	// The first 3 messages failed to be relayed on L1, and the first one is
+	// replayed successfully since. The last one is relayed successfully.
+	L1db := state.NewMemoryStateDB(nil)
+	bytecode, err := bindings.GetDeployedBytecode("L1CrossDomainMessenger")
+	require.NoError(t, err)
+	L1db.CreateAccount(predeploys.DevL1CrossDomainMessengerAddr)
+	L1db.SetCode(predeploys.DevL1CrossDomainMessengerAddr, bytecode)
+	err = state.SetStorage(
+		"L1CrossDomainMessenger",
+		predeploys.DevL1CrossDomainMessengerAddr,
+		state.StorageValues{
+			"failedMessages":     map[any]any{hashes[0]: true, hashes[1]: true, hashes[2]: true},
+			"successfulMessages": map[any]any{hashes[0]: true, hashes[3]: true},
+		},
+		L1db,
+	)
+	require.NoError(t, err)
+	L1 := backends.NewSimulatedBackend(L1db.Genesis().Alloc, 15000000)
+
+	messengers, err := crossdomain.NewMessengers(crossdomain.NewBackends(L1, L2), predeploys.DevL1CrossDomainMessengerAddr)
+	require.NoError(t, err)
+
+	failed, err := crossdomain.GetFailedWithdrawals(messengers, 0, 100)
+	require.NoError(t, err)
+	require.Len(t, failed, 2)
+	for i, msg := range failed {
+		require.Equal(t, hashes[i+1], msg.MsgHash)
+		require.Equal(t, msgs[i+1].Target, msg.Target)
+		require.Equal(t, msgs[i+1].Message, msg.Data)
+	}
+
+	replay, err := crossdomain.NewWithdrawalReplayTx(messengers, predeploys.DevL1CrossDomainMessengerAddr, &failed[0].CrossDomainMessage)
+	require.NoError(t, err)
+	require.Equal(t, predeploys.DevL1CrossDomainMessengerAddr, replay.To)
+
+	data, err := sent[1].Encode()
+	require.NoError(t, err)
+	require.Equal(t, data, replay.Data)
+
+	baseGas, err := messengers.L1.BaseGas(&bind.CallOpts{}, msgs[1].Message, msgs[1].MinGasLimit)
+	require.NoError(t, err)
+	require.Equal(t, baseGas, replay.Gas)
+	require.Greater(t, replay.Gas, uint64(msgs[1].MinGasLimit))
+}
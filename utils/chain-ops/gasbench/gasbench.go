@@ -0,0 +1,368 @@
+// Package gasbench measures the gas used by the key flows of the L1 contracts,
+// executed against a simulated backend that is initialized with the L1
+// developer genesis, so that gas regressions can be tracked across commits.
+package gasbench
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/crypto/poseidon"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/trie"
+	zkt "github.com/kroma-network/zktrie/types"
+
+	"github.com/kroma-network/kroma/bindings/bindings"
+	"github.com/kroma-network/kroma/bindings/predeploys"
+	"github.com/kroma-network/kroma/components/node/rollup"
+	"github.com/kroma-network/kroma/utils/chain-ops/crossdomain"
+	"github.com/kroma-network/kroma/utils/chain-ops/genesis"
+)
+
+func init() {
+	zkt.InitHashScheme(poseidon.HashFixed)
+}
+
+const gasLimit = 15_000_000
+
+var (
+	// the standard hardhat development accounts, which are funded in the developer genesis
+	validatorKey, _  = crypto.HexToECDSA("7c852118294e51e653712a81e05800f419141751be58f605c371e15141b007a6")
+	challengerKey, _ = crypto.HexToECDSA("5de4111afa1a4b94908f83103eb1f1706367c2e68ca870fc3fb9a804cdab365a")
+	userKey, _       = crypto.HexToECDSA("ac0974bec39a17e36ba4a6b4d238ff944bacb478cbed5efcae784d7bf4f2ff80")
+
+	depositValue = big.NewInt(params.Ether)
+)
+
+// Env is the simulated L1 that the flows are executed on.
+type Env struct {
+	Backend *backends.SimulatedBackend
+	Config  *genesis.DeployConfig
+
+	Validator  *bind.TransactOpts
+	Challenger *bind.TransactOpts
+	User       *bind.TransactOpts
+
+	Portal         *bindings.KromaPortal
+	L2OutputOracle *bindings.L2OutputOracle
+	ValidatorPool  *bindings.ValidatorPool
+	Colosseum      *bindings.Colosseum
+	Messenger      *bindings.L1CrossDomainMessenger
+	Bridge         *bindings.L1StandardBridge
+}
+
+// NewEnv builds the L1 developer genesis of the config and starts a simulated
+// backend with it. The validator account is set as the trusted validator, and
+// the L2 outputs start at the genesis timestamp of the simulated backend.
+func NewEnv(config *genesis.DeployConfig) (*Env, error) {
+	cfg := *config
+	cfg.ValidatorPoolTrustedValidator = crypto.PubkeyToAddress(validatorKey.PublicKey)
+	cfg.L1GenesisBlockTimestamp = 1
+	cfg.FundDevAccounts = true
+
+	l1Genesis, err := genesis.BuildL1DeveloperGenesis(&cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build L1 developer genesis: %w", err)
+	}
+	sim := backends.NewSimulatedBackend(l1Genesis.Alloc, gasLimit)
+
+	env := &Env{Backend: sim, Config: &cfg}
+	chainID := sim.Blockchain().Config().ChainID
+	for _, a := range []struct {
+		opts **bind.TransactOpts
+		key  *ecdsa.PrivateKey
+	}{
+		{&env.Validator, validatorKey},
+		{&env.Challenger, challengerKey},
+		{&env.User, userKey},
+	} {
+		if *a.opts, err = bind.NewKeyedTransactorWithChainID(a.key, chainID); err != nil {
+			return nil, err
+		}
+	}
+
+	if env.Portal, err = bindings.NewKromaPortal(predeploys.DevKromaPortalAddr, sim); err != nil {
+		return nil, err
+	}
+	if env.L2OutputOracle, err = bindings.NewL2OutputOracle(predeploys.DevL2OutputOracleAddr, sim); err != nil {
+		return nil, err
+	}
+	if env.ValidatorPool, err = bindings.NewValidatorPool(predeploys.DevValidatorPoolAddr, sim); err != nil {
+		return nil, err
+	}
+	if env.Colosseum, err = bindings.NewColosseum(predeploys.DevColosseumAddr, sim); err != nil {
+		return nil, err
+	}
+	if env.Messenger, err = bindings.NewL1CrossDomainMessenger(predeploys.DevL1CrossDomainMessengerAddr, sim); err != nil {
+		return nil, err
+	}
+	if env.Bridge, err = bindings.NewL1StandardBridge(predeploys.DevL1StandardBridgeAddr, sim); err != nil {
+		return nil, err
+	}
+	return env, nil
+}
+
+// Close stops the simulated backend.
+func (e *Env) Close() error {
+	return e.Backend.Close()
+}
+
+// Report is the gas used by each flow, keyed by the contract and method name.
+type Report map[string]uint64
+
+// Delta is the change of the gas used by a flow between two reports.
+type Delta struct {
+	Flow string
+	Old  uint64
+	New  uint64
+}
+
+func (d Delta) String() string {
+	switch {
+	case d.Old == 0:
+		return fmt.Sprintf("%s: %d (new)", d.Flow, d.New)
+	case d.New == 0:
+		return fmt.Sprintf("%s: %d (removed)", d.Flow, d.Old)
+	}
+	diff := int64(d.New) - int64(d.Old)
+	return fmt.Sprintf("%s: %d -> %d (%+d, %+.2f%%)", d.Flow, d.Old, d.New, diff, float64(diff)*100/float64(d.Old))
+}
+
+// Compare returns the deltas of the flows whose gas used differs between the
+// reports, sorted by the flow name.
+func Compare(old, new Report) []Delta {
+	var deltas []Delta
+	for flow, gas := range new {
+		if old[flow] != gas {
+			deltas = append(deltas, Delta{Flow: flow, Old: old[flow], New: gas})
+		}
+	}
+	for flow, gas := range old {
+		if _, ok := new[flow]; !ok {
+			deltas = append(deltas, Delta{Flow: flow, Old: gas})
+		}
+	}
+	sort.Slice(deltas, func(i, j int) bool { return deltas[i].Flow < deltas[j].Flow })
+	return deltas
+}
+
+// Run executes the flows on the environment in order, and reports the gas used
+// by each of them. The flows depend on the state left by the previous ones, so
+// the environment must be fresh.
+//
+// The fault proof of a challenge is not covered, since it requires a zkEVM
+// proof of an actual L2 block.
+func Run(env *Env) (Report, error) {
+	r := &runner{env: env, report: make(Report)}
+
+	// an L2 withdrawal whose output is submitted, proven and finalized below
+	user := env.User.From
+	withdrawal := crossdomain.NewWithdrawal(common.Big0, &user, &user, big.NewInt(1), big.NewInt(100_000), nil)
+	proof, err := proveWithdrawal(withdrawal)
+	if err != nil {
+		return nil, err
+	}
+
+	r.measure("KromaPortal.depositTransaction", func() (*types.Transaction, error) {
+		return env.Portal.DepositTransaction(withValue(env.User, depositValue), user, depositValue, 100_000, false, nil)
+	})
+	r.measure("L1StandardBridge.bridgeETH", func() (*types.Transaction, error) {
+		return env.Bridge.BridgeETH(withValue(env.User, depositValue), 200_000, nil)
+	})
+	r.measure("L1CrossDomainMessenger.sendMessage", func() (*types.Transaction, error) {
+		return env.Messenger.SendMessage(env.User, user, []byte("gasbench"), 200_000)
+	})
+	r.measure("ValidatorPool.deposit", func() (*types.Transaction, error) {
+		return env.ValidatorPool.Deposit(withValue(env.Validator, depositValue))
+	})
+
+	// the output 0 is the genesis output, which can't be challenged
+	r.submitOutput("", common.Hash{0x01})
+	r.submitOutput("L2OutputOracle.submitL2Output", proof.outputRoot)
+	r.submitOutput("", common.Hash{0x02})
+
+	// the challenger deposits after the outputs are submitted, so that the
+	// validator is the only one selected to submit them
+	r.measure("", func() (*types.Transaction, error) {
+		return env.ValidatorPool.Deposit(withValue(env.Challenger, depositValue))
+	})
+	challenged := big.NewInt(2)
+	segments := [][32]byte{proof.outputRoot, {0x11}, {0x12}}
+	r.measure("Colosseum.createChallenge", func() (*types.Transaction, error) {
+		return env.Colosseum.CreateChallenge(env.Challenger, challenged, common.Hash{}, common.Big0, segments)
+	})
+	r.measure("Colosseum.bisect", func() (*types.Transaction, error) {
+		return env.Colosseum.Bisect(env.Validator, challenged, env.Challenger.From, common.Big0,
+			[][32]byte{segments[0], {0x21}, {0x22}, {0x23}})
+	})
+
+	r.measure("KromaPortal.proveWithdrawalTransaction", func() (*types.Transaction, error) {
+		return env.Portal.ProveWithdrawalTransaction(env.User, withdrawal.WithdrawalTransaction(), common.Big1, proof.outputRootProof, proof.withdrawalProof)
+	})
+	if r.err == nil {
+		r.err = env.Backend.AdjustTime(time.Duration(env.Config.FinalizationPeriodSeconds+1) * time.Second)
+		env.Backend.Commit()
+	}
+	r.measure("KromaPortal.finalizeWithdrawalTransaction", func() (*types.Transaction, error) {
+		return env.Portal.FinalizeWithdrawalTransaction(env.User, withdrawal.WithdrawalTransaction())
+	})
+
+	if r.err != nil {
+		return nil, r.err
+	}
+	return r.report, nil
+}
+
+type runner struct {
+	env    *Env
+	report Report
+	err    error
+}
+
+// measure sends the transaction, mines it and records its gas used under the
+// flow name, unless the name is empty. Once a flow fails, the rest are skipped.
+func (r *runner) measure(flow string, send func() (*types.Transaction, error)) {
+	if r.err != nil {
+		return
+	}
+	name := flow
+	if name == "" {
+		name = "setup"
+	}
+	tx, err := send()
+	if err != nil {
+		r.err = fmt.Errorf("%s: %w", name, bindings.WrapRevertError(err))
+		return
+	}
+	r.env.Backend.Commit()
+	receipt, err := r.env.Backend.TransactionReceipt(context.Background(), tx.Hash())
+	if err != nil {
+		r.err = fmt.Errorf("%s: failed to get receipt: %w", name, err)
+		return
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		r.err = fmt.Errorf("%s: transaction %s reverted", name, tx.Hash())
+		return
+	}
+	if flow != "" {
+		r.report[flow] = receipt.GasUsed
+	}
+}
+
+// submitOutput submits the next output by the validator. The time is advanced
+// past the L2 timestamp of the output first, since outputs of the future are
+// rejected.
+func (r *runner) submitOutput(flow string, outputRoot common.Hash) {
+	if r.err != nil {
+		return
+	}
+	opts := &bind.CallOpts{}
+	next, err := r.env.L2OutputOracle.NextBlockNumber(opts)
+	if err != nil {
+		r.err = err
+		return
+	}
+	l2Timestamp, err := r.env.L2OutputOracle.ComputeL2Timestamp(opts, next)
+	if err != nil {
+		r.err = err
+		return
+	}
+	head, err := r.env.Backend.HeaderByNumber(context.Background(), nil)
+	if err != nil {
+		r.err = err
+		return
+	}
+	if now := head.Time; now <= l2Timestamp.Uint64() {
+		if r.err = r.env.Backend.AdjustTime(time.Duration(l2Timestamp.Uint64()-now+1) * time.Second); r.err != nil {
+			return
+		}
+		r.env.Backend.Commit()
+	}
+	r.measure(flow, func() (*types.Transaction, error) {
+		return r.env.L2OutputOracle.SubmitL2Output(r.env.Validator, outputRoot, next, common.Hash{}, common.Big0)
+	})
+}
+
+func withValue(opts *bind.TransactOpts, value *big.Int) *bind.TransactOpts {
+	withValue := *opts
+	withValue.Value = value
+	return &withValue
+}
+
+type withdrawalProof struct {
+	outputRoot      common.Hash
+	outputRootProof bindings.TypesOutputRootProof
+	withdrawalProof [][]byte
+}
+
+// proofList implements ethdb.KeyValueWriter to collect the nodes of a proof.
+type proofList [][]byte
+
+func (n *proofList) Put(key []byte, value []byte) error {
+	*n = append(*n, value)
+	return nil
+}
+
+func (n *proofList) Delete(key []byte) error {
+	return errors.New("not supported")
+}
+
+// proveWithdrawal builds an L2 state where only the withdrawal is sent from
+// the L2ToL1MessagePasser, and returns the proof of the withdrawal in it.
+func proveWithdrawal(withdrawal *crossdomain.Withdrawal) (*withdrawalProof, error) {
+	slot, err := withdrawal.StorageSlot()
+	if err != nil {
+		return nil, err
+	}
+
+	storage, err := trie.NewZkTrie(types.EmptyRootHash(true), trie.NewZktrieDatabase(rawdb.NewMemoryDatabase()))
+	if err != nil {
+		return nil, err
+	}
+	if err := storage.TryUpdate(slot.Bytes(), []byte{0x01}); err != nil {
+		return nil, err
+	}
+	world, err := trie.NewZkTrie(types.EmptyRootHash(true), trie.NewZktrieDatabase(rawdb.NewMemoryDatabase()))
+	if err != nil {
+		return nil, err
+	}
+	account := types.StateAccount{Balance: common.Big0, Root: storage.Hash()}
+	if err := world.TryUpdateAccount(predeploys.L2ToL1MessagePasserAddr, &account); err != nil {
+		return nil, err
+	}
+
+	key, err := zkt.ToSecureKeyBytes(slot.Bytes())
+	if err != nil {
+		return nil, err
+	}
+	var proof proofList
+	if err := storage.Prove(key.Bytes(), 0, &proof); err != nil {
+		return nil, fmt.Errorf("failed to prove withdrawal: %w", err)
+	}
+
+	outputRootProof := bindings.TypesOutputRootProof{
+		Version:                  rollup.V0,
+		StateRoot:                world.Hash(),
+		MessagePasserStorageRoot: storage.Hash(),
+	}
+	outputRoot, err := rollup.ComputeL2OutputRoot(&outputRootProof)
+	if err != nil {
+		return nil, err
+	}
+	return &withdrawalProof{
+		outputRoot:      common.Hash(outputRoot),
+		outputRootProof: outputRootProof,
+		withdrawalProof: proof,
+	}, nil
+}
@@ -0,0 +1,84 @@
+package gasbench
+
+import (
+	"encoding/json"
+	"flag"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/kroma-network/kroma/utils/chain-ops/genesis"
+)
+
+const snapshotPath = "testdata/gas-snapshot.json"
+
+var update = flag.Bool("update", false, "update the gas snapshot")
+
+func newEnv(tb testing.TB) *Env {
+	b, err := os.ReadFile("testdata/deploy-config.json")
+	require.NoError(tb, err)
+	config := new(genesis.DeployConfig)
+	require.NoError(tb, json.Unmarshal(b, config))
+
+	env, err := NewEnv(config)
+	require.NoError(tb, err)
+	tb.Cleanup(func() { _ = env.Close() })
+	return env
+}
+
+// TestGasSnapshot compares the gas used by the flows with the snapshot, which
+// is updated by running the test with -update.
+func TestGasSnapshot(t *testing.T) {
+	report, err := Run(newEnv(t))
+	require.NoError(t, err)
+
+	if *update {
+		b, err := json.MarshalIndent(report, "", "  ")
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(snapshotPath, append(b, '\n'), 0o644))
+		return
+	}
+
+	b, err := os.ReadFile(snapshotPath)
+	require.NoError(t, err)
+	var snapshot Report
+	require.NoError(t, json.Unmarshal(b, &snapshot))
+
+	deltas := Compare(snapshot, report)
+	for _, d := range deltas {
+		t.Log(d)
+	}
+	require.Empty(t, deltas, "gas usage changed, run the test with -update to update the snapshot")
+}
+
+func TestCompare(t *testing.T) {
+	deltas := Compare(
+		Report{"a": 100, "b": 200, "c": 300},
+		Report{"a": 100, "b": 150, "d": 400},
+	)
+	require.Equal(t, []Delta{
+		{Flow: "b", Old: 200, New: 150},
+		{Flow: "c", Old: 300},
+		{Flow: "d", New: 400},
+	}, deltas)
+	require.Equal(t, "b: 200 -> 150 (-50, -25.00%)", deltas[0].String())
+}
+
+// BenchmarkFlows reports the gas used by each flow as a metric, so that the
+// results of two commits can be compared with benchstat.
+func BenchmarkFlows(b *testing.B) {
+	var report Report
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		env := newEnv(b)
+		b.StartTimer()
+
+		var err error
+		report, err = Run(env)
+		require.NoError(b, err)
+	}
+	for flow, gas := range report {
+		b.ReportMetric(float64(gas), flow+"-gas")
+	}
+}
@@ -0,0 +1,111 @@
+package layout
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/kroma-network/kroma/bindings/hardhat"
+	"github.com/kroma-network/kroma/bindings/solc"
+	"github.com/kroma-network/kroma/utils/chain-ops/genesis"
+)
+
+// spacerPrefix is the prefix of the labels of the variables that reserve the
+// storage of removed variables.
+const spacerPrefix = "spacer_"
+
+// An Incompatibility represents a variable of the deployed storage layout
+// that is not kept by the current storage layout, so that upgrading to the
+// current contract would misinterpret the existing storage.
+type Incompatibility struct {
+	Contract string `json:"contract"`
+	Label    string `json:"label"`
+	Slot     uint   `json:"slot"`
+	Offset   uint   `json:"offset"`
+	Reason   string `json:"reason"`
+}
+
+func (i Incompatibility) String() string {
+	return fmt.Sprintf("%s: %s at slot %d offset %d %s", i.Contract, i.Label, i.Slot, i.Offset, i.Reason)
+}
+
+// StorageReader reads the storage of the contracts on a live network.
+type StorageReader interface {
+	StorageAt(ctx context.Context, account common.Address, key common.Hash, blockNumber *big.Int) ([]byte, error)
+}
+
+// Compare compares the deployed storage layout of the contract with the
+// current one. Every variable of the deployed layout must be at the same slot
+// and offset, with the same type, in the current layout. A variable can be
+// renamed, or replaced by a spacer of the same size. Variables that are only
+// in the current layout must be appended after the deployed ones, otherwise
+// they overlap with a deployed variable, which is then reported.
+func Compare(name string, deployed, current *solc.StorageLayout) []Incompatibility {
+	type position struct{ slot, offset uint }
+	entries := make(map[position]solc.StorageLayoutEntry)
+	for _, entry := range current.Storage {
+		entries[position{entry.Slot, entry.Offset}] = entry
+	}
+
+	var incompatibilities []Incompatibility
+	for _, old := range deployed.Storage {
+		incompatible := func(reason string, args ...any) {
+			incompatibilities = append(incompatibilities, Incompatibility{
+				Contract: name,
+				Label:    old.Label,
+				Slot:     old.Slot,
+				Offset:   old.Offset,
+				Reason:   fmt.Sprintf(reason, args...),
+			})
+		}
+
+		entry, ok := entries[position{old.Slot, old.Offset}]
+		if !ok {
+			incompatible("is removed without a spacer")
+			continue
+		}
+		oldType, newType := deployed.Types[old.Type], current.Types[entry.Type]
+		if oldType.NumberOfBytes != newType.NumberOfBytes {
+			incompatible("changes size from %d to %d bytes as %s", oldType.NumberOfBytes, newType.NumberOfBytes, entry.Label)
+			continue
+		}
+		// A spacer only reserves the storage, so its type does not matter
+		if strings.HasPrefix(entry.Label, spacerPrefix) {
+			continue
+		}
+		if oldType.Label != newType.Label || oldType.Encoding != newType.Encoding {
+			incompatible("changes type from %s to %s as %s", oldType.Label, newType.Label, entry.Label)
+		}
+	}
+	return incompatibilities
+}
+
+// CheckDeployment compares the storage layout of the implementation of the
+// proxy "<name>Proxy" on the live network with the current storage layout of
+// the contract. The storage layout of the implementation is read from the
+// deployment "<name>", which must be the implementation that the proxy
+// points to.
+func CheckDeployment(ctx context.Context, reader StorageReader, hh *hardhat.Hardhat, name string, current *solc.StorageLayout) ([]Incompatibility, error) {
+	proxy, err := hh.GetDeployment(name + "Proxy")
+	if err != nil {
+		return nil, err
+	}
+	impl, err := hh.GetDeployment(name)
+	if err != nil {
+		return nil, err
+	}
+
+	slot, err := reader.StorageAt(ctx, proxy.Address, genesis.ImplementationSlot, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read implementation of %s: %w", name, err)
+	}
+	implAddr := common.BytesToAddress(slot)
+	if implAddr != impl.Address {
+		return nil, fmt.Errorf("implementation of %s is %s on the network, but the deployment is %s", name, implAddr, impl.Address)
+	}
+
+	return Compare(name, &impl.StorageLayout, current), nil
+}
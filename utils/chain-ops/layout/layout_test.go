@@ -0,0 +1,108 @@
+package layout
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/kroma-network/kroma/bindings/solc"
+)
+
+func testLayout(entries ...solc.StorageLayoutEntry) *solc.StorageLayout {
+	return &solc.StorageLayout{
+		Storage: entries,
+		Types: map[string]solc.StorageLayoutType{
+			"t_address": {Encoding: "inplace", Label: "address", NumberOfBytes: 20},
+			"t_bool":    {Encoding: "inplace", Label: "bool", NumberOfBytes: 1},
+			"t_uint8":   {Encoding: "inplace", Label: "uint8", NumberOfBytes: 1},
+			"t_uint256": {Encoding: "inplace", Label: "uint256", NumberOfBytes: 32},
+			"t_bytes32": {Encoding: "inplace", Label: "bytes32", NumberOfBytes: 32},
+			"t_mapping(t_bytes32,t_bool)": {
+				Encoding: "mapping", Label: "mapping(bytes32 => bool)", NumberOfBytes: 32,
+				Key: "t_bytes32", Value: "t_bool",
+			},
+		},
+	}
+}
+
+func TestCompare(t *testing.T) {
+	deployed := testLayout(
+		solc.StorageLayoutEntry{Label: "owner", Slot: 0, Offset: 0, Type: "t_address"},
+		solc.StorageLayoutEntry{Label: "paused", Slot: 0, Offset: 20, Type: "t_bool"},
+		solc.StorageLayoutEntry{Label: "nonce", Slot: 1, Offset: 0, Type: "t_uint256"},
+		solc.StorageLayoutEntry{Label: "relayed", Slot: 2, Offset: 0, Type: "t_mapping(t_bytes32,t_bool)"},
+	)
+
+	tests := []struct {
+		name    string
+		current *solc.StorageLayout
+		labels  []string
+	}{
+		{
+			name: "appended",
+			current: testLayout(append(deployed.Storage,
+				solc.StorageLayoutEntry{Label: "added", Slot: 3, Offset: 0, Type: "t_uint256"},
+			)...),
+		},
+		{
+			name: "renamed and spacer",
+			current: testLayout(
+				solc.StorageLayoutEntry{Label: "admin", Slot: 0, Offset: 0, Type: "t_address"},
+				solc.StorageLayoutEntry{Label: "paused", Slot: 0, Offset: 20, Type: "t_bool"},
+				solc.StorageLayoutEntry{Label: "spacer_1_0_32", Slot: 1, Offset: 0, Type: "t_bytes32"},
+				solc.StorageLayoutEntry{Label: "relayed", Slot: 2, Offset: 0, Type: "t_mapping(t_bytes32,t_bool)"},
+			),
+		},
+		{
+			name: "removed",
+			current: testLayout(
+				solc.StorageLayoutEntry{Label: "owner", Slot: 0, Offset: 0, Type: "t_address"},
+				solc.StorageLayoutEntry{Label: "paused", Slot: 0, Offset: 20, Type: "t_bool"},
+				solc.StorageLayoutEntry{Label: "relayed", Slot: 1, Offset: 0, Type: "t_mapping(t_bytes32,t_bool)"},
+			),
+			labels: []string{"nonce", "relayed"},
+		},
+		{
+			name: "inserted",
+			current: testLayout(
+				solc.StorageLayoutEntry{Label: "owner", Slot: 0, Offset: 0, Type: "t_address"},
+				solc.StorageLayoutEntry{Label: "version", Slot: 0, Offset: 20, Type: "t_uint8"},
+				solc.StorageLayoutEntry{Label: "paused", Slot: 0, Offset: 21, Type: "t_bool"},
+				solc.StorageLayoutEntry{Label: "nonce", Slot: 1, Offset: 0, Type: "t_uint256"},
+				solc.StorageLayoutEntry{Label: "relayed", Slot: 2, Offset: 0, Type: "t_mapping(t_bytes32,t_bool)"},
+			),
+			labels: []string{"paused"},
+		},
+		{
+			name: "type changed",
+			current: testLayout(
+				solc.StorageLayoutEntry{Label: "owner", Slot: 0, Offset: 0, Type: "t_address"},
+				solc.StorageLayoutEntry{Label: "paused", Slot: 0, Offset: 20, Type: "t_bool"},
+				solc.StorageLayoutEntry{Label: "nonce", Slot: 1, Offset: 0, Type: "t_bytes32"},
+				solc.StorageLayoutEntry{Label: "relayed", Slot: 2, Offset: 0, Type: "t_mapping(t_bytes32,t_bool)"},
+			),
+			labels: []string{"nonce"},
+		},
+		{
+			name: "spacer size changed",
+			current: testLayout(
+				solc.StorageLayoutEntry{Label: "spacer_0_0_1", Slot: 0, Offset: 0, Type: "t_bool"},
+				solc.StorageLayoutEntry{Label: "paused", Slot: 0, Offset: 20, Type: "t_bool"},
+				solc.StorageLayoutEntry{Label: "nonce", Slot: 1, Offset: 0, Type: "t_uint256"},
+				solc.StorageLayoutEntry{Label: "relayed", Slot: 2, Offset: 0, Type: "t_mapping(t_bytes32,t_bool)"},
+			),
+			labels: []string{"owner"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var labels []string
+			for _, incompatibility := range Compare("Test", deployed, test.current) {
+				require.Equal(t, "Test", incompatibility.Contract)
+				labels = append(labels, incompatibility.Label)
+			}
+			require.Equal(t, test.labels, labels)
+		})
+	}
+}
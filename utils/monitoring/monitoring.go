@@ -39,7 +39,10 @@ func MaybeStartMetrics(ctx context.Context, cfg metrics.CLIConfig, l log.Logger,
 				l.Error("failed to start metrics server", "err", err)
 			}
 		}()
-		m.StartBalanceMetrics(ctx, l, l1, wallet)
+		// The balance is not tracked for services without an account.
+		if wallet != (common.Address{}) {
+			m.StartBalanceMetrics(ctx, l, l1, wallet)
+		}
 	}
 }
 
@@ -16,6 +16,7 @@ import (
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/log"
 
+	"github.com/kroma-network/kroma/bindings/bindings"
 	"github.com/kroma-network/kroma/utils/service/txmgr/metrics"
 )
 
@@ -124,6 +125,9 @@ type TxCandidate struct {
 	AccessList types.AccessList
 	// Value is the value that is passed to the constructed tx.
 	Value *big.Int
+	// GasTipCapMultiplier is the multiplier of the suggested gas tip cap of the constructed tx.
+	// The suggested gas tip cap is used as is if it is 1 or less.
+	GasTipCapMultiplier float64
 }
 
 // Send is used to publish a transaction with incrementally higher gas prices
@@ -159,6 +163,9 @@ func (m *SimpleTxManager) craftTx(ctx context.Context, candidate TxCandidate) (*
 		m.metr.RPCError()
 		return nil, fmt.Errorf("failed to get gas price info: %w", err)
 	}
+	if candidate.GasTipCapMultiplier > 1 {
+		gasTipCap, _ = new(big.Float).Mul(new(big.Float).SetInt(gasTipCap), big.NewFloat(candidate.GasTipCapMultiplier)).Int(nil)
+	}
 	gasFeeCap := calcGasFeeCap(basefee, gasTipCap)
 
 	// Fetch the sender's nonce from the latest known block (nil `blockNumber`)
@@ -200,7 +207,7 @@ func (m *SimpleTxManager) craftTx(ctx context.Context, candidate TxCandidate) (*
 			Value:     candidate.Value,
 		})
 		if err != nil {
-			return nil, fmt.Errorf("failed to estimate gas: %w", err)
+			return nil, fmt.Errorf("failed to estimate gas: %w", bindings.WrapRevertError(err))
 		}
 		rawTx.Gas = gas
 	}
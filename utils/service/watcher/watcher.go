@@ -0,0 +1,333 @@
+package watcher
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+const (
+	defaultBatchSize     = 1000
+	defaultReorgDepth    = 64
+	defaultRetryInterval = 10 * time.Second
+)
+
+// Client is the client of the chain that the events are watched on.
+type Client interface {
+	bind.ContractFilterer
+	BlockNumber(ctx context.Context) (uint64, error)
+}
+
+// Config is the configuration of a Watcher.
+type Config struct {
+	// FromBlock is the first block to backfill the events from. If nil, only
+	// the events from the head at the start are watched.
+	FromBlock *big.Int
+	// BatchSize is the maximum number of blocks to filter the logs of at once.
+	BatchSize uint64
+	// ReorgDepth is the number of the latest blocks whose delivered logs are
+	// kept, to detect the reorgs that happened while the subscription was down.
+	ReorgDepth uint64
+	// RetryInterval is the interval to resubscribe after the subscription fails.
+	RetryInterval time.Duration
+}
+
+// Filter selects the event of a bound contract to watch.
+type Filter struct {
+	Address common.Address
+	ABI     *abi.ABI
+	Event   string
+	// Query is the values of the indexed arguments of the event to filter by,
+	// in the same form as the rules of the Watch methods of the bindings.
+	Query [][]interface{}
+}
+
+type logKey struct {
+	blockHash common.Hash
+	index     uint
+}
+
+func keyOf(l types.Log) logKey {
+	return logKey{l.BlockHash, l.Index}
+}
+
+// Watcher combines the backfill of the past events of a bound contract with a
+// live subscription. When the subscription fails, e.g. when the provider
+// restarts, it resubscribes and backfills the events that were missed in the
+// meantime. The events of blocks that are reorged out are delivered again with
+// Raw.Removed set, either from the subscription or when the reorg is detected
+// by the backfill, so that the consumers can revert their effects.
+type Watcher[T any] struct {
+	log    log.Logger
+	client Client
+	cfg    Config
+	query  ethereum.FilterQuery
+	parse  func(types.Log) (*T, error)
+	sink   chan<- *T
+
+	// started is whether the next block to backfill from is set
+	started bool
+	// next is the block that the logs are delivered up to, exclusive
+	next uint64
+	// recent is the delivered logs of the latest blocks
+	recent []types.Log
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewWatcher creates a new Watcher, which sends the events decoded with the
+// parse function of the bindings, e.g. ParseOutputSubmitted, to the sink.
+func NewWatcher[T any](l log.Logger, client Client, cfg Config, filter Filter, parse func(types.Log) (*T, error), sink chan<- *T) (*Watcher[T], error) {
+	event, ok := filter.ABI.Events[filter.Event]
+	if !ok {
+		return nil, fmt.Errorf("unknown event %s", filter.Event)
+	}
+	topics, err := abi.MakeTopics(append([][]interface{}{{event.ID}}, filter.Query...)...)
+	if err != nil {
+		return nil, fmt.Errorf("invalid query of %s: %w", filter.Event, err)
+	}
+
+	if cfg.BatchSize == 0 {
+		cfg.BatchSize = defaultBatchSize
+	}
+	if cfg.ReorgDepth == 0 {
+		cfg.ReorgDepth = defaultReorgDepth
+	}
+	if cfg.RetryInterval == 0 {
+		cfg.RetryInterval = defaultRetryInterval
+	}
+
+	w := &Watcher[T]{
+		log:    l.New("event", filter.Event),
+		client: client,
+		cfg:    cfg,
+		query: ethereum.FilterQuery{
+			Addresses: []common.Address{filter.Address},
+			Topics:    topics,
+		},
+		parse: parse,
+		sink:  sink,
+	}
+	if cfg.FromBlock != nil {
+		w.started = true
+		w.next = cfg.FromBlock.Uint64()
+	}
+	return w, nil
+}
+
+func (w *Watcher[T]) Start(ctx context.Context) {
+	w.ctx, w.cancel = context.WithCancel(ctx)
+
+	w.wg.Add(1)
+	go w.loop()
+}
+
+func (w *Watcher[T]) Stop() {
+	w.cancel()
+	w.wg.Wait()
+}
+
+func (w *Watcher[T]) loop() {
+	defer w.wg.Done()
+
+	for {
+		err := w.run()
+		if w.ctx.Err() != nil {
+			return
+		}
+		w.log.Warn("resubscribing after failed event subscription", "err", err)
+
+		select {
+		case <-time.After(w.cfg.RetryInterval):
+		case <-w.ctx.Done():
+			return
+		}
+	}
+}
+
+// run subscribes to the logs, backfills the logs up to the head, and then
+// delivers the logs of the subscription until it fails. The subscription is
+// made before the backfill, so that no log is missed in between.
+func (w *Watcher[T]) run() error {
+	logs := make(chan types.Log, 128)
+	sub, err := w.client.SubscribeFilterLogs(w.ctx, w.query, logs)
+	if err != nil {
+		return fmt.Errorf("failed to subscribe logs: %w", err)
+	}
+	defer sub.Unsubscribe()
+
+	head, err := w.client.BlockNumber(w.ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get head: %w", err)
+	}
+	if !w.started {
+		w.started = true
+		w.next = head + 1
+	}
+	if err := w.backfill(head); err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case l := <-logs:
+			if err := w.handleLog(l); err != nil {
+				return err
+			}
+		case err := <-sub.Err():
+			if err == nil {
+				err = errors.New("subscription closed")
+			}
+			return err
+		case <-w.ctx.Done():
+			return w.ctx.Err()
+		}
+	}
+}
+
+// backfill delivers the logs up to the head that are not delivered yet. The
+// logs of the latest blocks are filtered again, and the delivered logs that
+// are not on the chain anymore are delivered as removed.
+func (w *Watcher[T]) backfill(head uint64) error {
+	from := w.next
+	for _, l := range w.recent {
+		if l.BlockNumber < from {
+			from = l.BlockNumber
+		}
+	}
+	if from > head && len(w.recent) == 0 {
+		return nil
+	}
+
+	var fetched []types.Log
+	for start := from; start <= head; start += w.cfg.BatchSize {
+		end := start + w.cfg.BatchSize - 1
+		if end > head {
+			end = head
+		}
+		query := w.query
+		query.FromBlock = new(big.Int).SetUint64(start)
+		query.ToBlock = new(big.Int).SetUint64(end)
+		logs, err := w.client.FilterLogs(w.ctx, query)
+		if err != nil {
+			return fmt.Errorf("failed to filter logs from %d to %d: %w", start, end, err)
+		}
+		fetched = append(fetched, logs...)
+	}
+
+	canonical := make(map[logKey]bool, len(fetched))
+	for _, l := range fetched {
+		canonical[keyOf(l)] = true
+	}
+	delivered := make(map[logKey]bool, len(w.recent))
+	kept := w.recent[:0]
+	var removed []types.Log
+	for _, l := range w.recent {
+		if canonical[keyOf(l)] {
+			delivered[keyOf(l)] = true
+			kept = append(kept, l)
+		} else {
+			removed = append(removed, l)
+		}
+	}
+	w.recent = kept
+
+	// the removed logs are delivered in the reverse order to undo them
+	for i := len(removed) - 1; i >= 0; i-- {
+		l := removed[i]
+		l.Removed = true
+		w.log.Info("event is reorged out", "block", l.BlockNumber, "tx", l.TxHash)
+		if err := w.deliver(l); err != nil {
+			return err
+		}
+	}
+	for _, l := range fetched {
+		if delivered[keyOf(l)] {
+			continue
+		}
+		if err := w.deliver(l); err != nil {
+			return err
+		}
+		w.recent = append(w.recent, l)
+	}
+
+	// the head may be behind the next block after a reorg to a shorter chain
+	w.next = head + 1
+	w.prune()
+	return nil
+}
+
+// handleLog delivers the log of the subscription, unless it is delivered by
+// the backfill already.
+func (w *Watcher[T]) handleLog(l types.Log) error {
+	if l.Removed {
+		for i, r := range w.recent {
+			if keyOf(r) == keyOf(l) {
+				w.recent = append(w.recent[:i], w.recent[i+1:]...)
+				return w.deliver(l)
+			}
+		}
+		// the log is not delivered, e.g. it is before the first block to watch
+		return nil
+	}
+
+	if w.cfg.FromBlock != nil && l.BlockNumber < w.cfg.FromBlock.Uint64() {
+		return nil
+	}
+	for _, r := range w.recent {
+		if keyOf(r) == keyOf(l) {
+			return nil
+		}
+	}
+	if err := w.deliver(l); err != nil {
+		return err
+	}
+	w.recent = append(w.recent, l)
+	// the other logs of the block may not be delivered yet
+	if l.BlockNumber > w.next {
+		w.next = l.BlockNumber
+	}
+	w.prune()
+	return nil
+}
+
+func (w *Watcher[T]) deliver(l types.Log) error {
+	ev, err := w.parse(l)
+	if err != nil {
+		w.log.Error("failed to parse event", "block", l.BlockNumber, "tx", l.TxHash, "err", err)
+		return nil
+	}
+	select {
+	case w.sink <- ev:
+		return nil
+	case <-w.ctx.Done():
+		return w.ctx.Err()
+	}
+}
+
+// prune drops the delivered logs that are deeper than the reorg depth.
+func (w *Watcher[T]) prune() {
+	if w.next <= w.cfg.ReorgDepth {
+		return
+	}
+	oldest := w.next - w.cfg.ReorgDepth
+	kept := w.recent[:0]
+	for _, l := range w.recent {
+		if l.BlockNumber >= oldest {
+			kept = append(kept, l)
+		}
+	}
+	w.recent = kept
+}
@@ -0,0 +1,155 @@
+package watcher
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+const testABI = `[{"anonymous":false,"inputs":[{"indexed":true,"name":"index","type":"uint256"}],"name":"Emitted","type":"event"}]`
+
+type testSub struct {
+	err  chan error
+	once sync.Once
+}
+
+func (s *testSub) Unsubscribe()      { s.once.Do(func() { close(s.err) }) }
+func (s *testSub) Err() <-chan error { return s.err }
+
+type testClient struct {
+	mu   sync.Mutex
+	head uint64
+	logs []types.Log
+
+	subs chan chan<- types.Log
+	sub  *testSub
+}
+
+func (c *testClient) FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var logs []types.Log
+	for _, l := range c.logs {
+		if l.BlockNumber >= q.FromBlock.Uint64() && l.BlockNumber <= q.ToBlock.Uint64() {
+			logs = append(logs, l)
+		}
+	}
+	return logs, nil
+}
+
+func (c *testClient) SubscribeFilterLogs(ctx context.Context, q ethereum.FilterQuery, ch chan<- types.Log) (ethereum.Subscription, error) {
+	c.mu.Lock()
+	c.sub = &testSub{err: make(chan error, 1)}
+	sub := c.sub
+	c.mu.Unlock()
+	c.subs <- ch
+	return sub, nil
+}
+
+func (c *testClient) BlockNumber(ctx context.Context) (uint64, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.head, nil
+}
+
+func (c *testClient) setChain(head uint64, logs ...types.Log) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.head = head
+	c.logs = logs
+}
+
+func (c *testClient) failSub() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.sub.err <- errors.New("connection lost")
+}
+
+func testLog(block uint64, hash byte) types.Log {
+	return types.Log{BlockNumber: block, BlockHash: common.Hash{hash}, TxHash: common.Hash{hash}}
+}
+
+func TestWatcher(t *testing.T) {
+	parsed, err := abi.JSON(strings.NewReader(testABI))
+	require.NoError(t, err)
+
+	client := &testClient{subs: make(chan chan<- types.Log, 1)}
+	client.setChain(5, testLog(1, 0x1), testLog(3, 0x3))
+
+	sink := make(chan *types.Log)
+	w, err := NewWatcher(log.New(), client, Config{FromBlock: common.Big0, RetryInterval: time.Millisecond},
+		Filter{ABI: &parsed, Event: "Emitted"},
+		func(l types.Log) (*types.Log, error) { return &l, nil },
+		sink,
+	)
+	require.NoError(t, err)
+	w.Start(context.Background())
+	defer w.Stop()
+
+	live := <-client.subs
+	expect := func(l types.Log, removed bool) {
+		select {
+		case got := <-sink:
+			require.Equal(t, l.BlockHash, got.BlockHash)
+			require.Equal(t, removed, got.Removed)
+		case <-time.After(5 * time.Second):
+			t.Fatal("timed out waiting for event")
+		}
+	}
+
+	// the past events are backfilled
+	expect(testLog(1, 0x1), false)
+	expect(testLog(3, 0x3), false)
+
+	// the live events are delivered once, even if they are backfilled already
+	live <- testLog(3, 0x3)
+	live <- testLog(6, 0x6)
+	expect(testLog(6, 0x6), false)
+
+	// the block 6 is reorged out while the subscription is down
+	client.setChain(7, testLog(1, 0x1), testLog(3, 0x3), testLog(6, 0x16), testLog(7, 0x7))
+	client.failSub()
+	<-client.subs
+
+	expect(testLog(6, 0x6), true)
+	expect(testLog(6, 0x16), false)
+	expect(testLog(7, 0x7), false)
+	select {
+	case got := <-sink:
+		t.Fatalf("unexpected event %v", got)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestWatcherStartsFromHead(t *testing.T) {
+	parsed, err := abi.JSON(strings.NewReader(testABI))
+	require.NoError(t, err)
+
+	client := &testClient{subs: make(chan chan<- types.Log, 1)}
+	client.setChain(5, testLog(1, 0x1), testLog(3, 0x3))
+
+	sink := make(chan *types.Log, 1)
+	w, err := NewWatcher(log.New(), client, Config{}, Filter{ABI: &parsed, Event: "Emitted", Query: [][]interface{}{{big.NewInt(1)}}},
+		func(l types.Log) (*types.Log, error) { return &l, nil },
+		sink,
+	)
+	require.NoError(t, err)
+	w.Start(context.Background())
+	defer w.Stop()
+
+	live := <-client.subs
+	live <- testLog(6, 0x6)
+	got := <-sink
+	require.Equal(t, uint64(6), got.BlockNumber)
+}